@@ -0,0 +1,8 @@
+// Package openapi provides OpenAPI document of HTTP/JSON gateway. Document is generated from .proto files
+// together with gateway code, so it should not be edited manually.
+package openapi
+
+import _ "embed"
+
+//go:embed tickets.swagger.json
+var Document []byte
@@ -7,6 +7,7 @@
 package tickets
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -22,15 +23,120 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type RespondStatus int32
+
+const (
+	RespondStatus_RESPOND_STATUS_UNSPECIFIED RespondStatus = 0
+	RespondStatus_RESPOND_STATUS_PENDING     RespondStatus = 1
+	RespondStatus_RESPOND_STATUS_ACCEPTED    RespondStatus = 2
+	RespondStatus_RESPOND_STATUS_REJECTED    RespondStatus = 3
+	RespondStatus_RESPOND_STATUS_WITHDRAWN   RespondStatus = 4
+)
+
+// Enum value maps for RespondStatus.
+var (
+	RespondStatus_name = map[int32]string{
+		0: "RESPOND_STATUS_UNSPECIFIED",
+		1: "RESPOND_STATUS_PENDING",
+		2: "RESPOND_STATUS_ACCEPTED",
+		3: "RESPOND_STATUS_REJECTED",
+		4: "RESPOND_STATUS_WITHDRAWN",
+	}
+	RespondStatus_value = map[string]int32{
+		"RESPOND_STATUS_UNSPECIFIED": 0,
+		"RESPOND_STATUS_PENDING":     1,
+		"RESPOND_STATUS_ACCEPTED":    2,
+		"RESPOND_STATUS_REJECTED":    3,
+		"RESPOND_STATUS_WITHDRAWN":   4,
+	}
+)
+
+func (x RespondStatus) Enum() *RespondStatus {
+	p := new(RespondStatus)
+	*p = x
+	return p
+}
+
+func (x RespondStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RespondStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_tickets_responds_proto_enumTypes[0].Descriptor()
+}
+
+func (RespondStatus) Type() protoreflect.EnumType {
+	return &file_tickets_responds_proto_enumTypes[0]
+}
+
+func (x RespondStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RespondStatus.Descriptor instead.
+func (RespondStatus) EnumDescriptor() ([]byte, []int) {
+	return file_tickets_responds_proto_rawDescGZIP(), []int{0}
+}
+
+type RespondsSortField int32
+
+const (
+	RespondsSortField_RESPONDS_SORT_FIELD_UNSPECIFIED RespondsSortField = 0
+	RespondsSortField_RESPONDS_SORT_FIELD_PRICE       RespondsSortField = 1
+	RespondsSortField_RESPONDS_SORT_FIELD_CREATED_AT  RespondsSortField = 2 // newest Responds go first with descending direction
+)
+
+// Enum value maps for RespondsSortField.
+var (
+	RespondsSortField_name = map[int32]string{
+		0: "RESPONDS_SORT_FIELD_UNSPECIFIED",
+		1: "RESPONDS_SORT_FIELD_PRICE",
+		2: "RESPONDS_SORT_FIELD_CREATED_AT",
+	}
+	RespondsSortField_value = map[string]int32{
+		"RESPONDS_SORT_FIELD_UNSPECIFIED": 0,
+		"RESPONDS_SORT_FIELD_PRICE":       1,
+		"RESPONDS_SORT_FIELD_CREATED_AT":  2,
+	}
+)
+
+func (x RespondsSortField) Enum() *RespondsSortField {
+	p := new(RespondsSortField)
+	*p = x
+	return p
+}
+
+func (x RespondsSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RespondsSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_tickets_responds_proto_enumTypes[1].Descriptor()
+}
+
+func (RespondsSortField) Type() protoreflect.EnumType {
+	return &file_tickets_responds_proto_enumTypes[1]
+}
+
+func (x RespondsSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RespondsSortField.Descriptor instead.
+func (RespondsSortField) EnumDescriptor() ([]byte, []int) {
+	return file_tickets_responds_proto_rawDescGZIP(), []int{1}
+}
+
 type RespondToTicketIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   uint64  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	TicketID uint64  `protobuf:"varint,2,opt,name=ticketID,proto3" json:"ticketID,omitempty"`
-	Price    float32 `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
-	Comment  *string `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	UserID         uint64  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	TicketID       uint64  `protobuf:"varint,2,opt,name=ticketID,proto3" json:"ticketID,omitempty"`
+	Price          float32 `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
+	Comment        *string `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	IdempotencyKey *string `protobuf:"bytes,5,opt,name=idempotencyKey,proto3,oneof" json:"idempotencyKey,omitempty"` // retried request with the same key returns original respondID
 }
 
 func (x *RespondToTicketIn) Reset() {
@@ -93,6 +199,13 @@ func (x *RespondToTicketIn) GetComment() string {
 	return ""
 }
 
+func (x *RespondToTicketIn) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
 type RespondToTicketOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -199,6 +312,8 @@ type GetRespondOut struct {
 	Comment   *string                `protobuf:"bytes,5,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Status    RespondStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=responds.RespondStatus" json:"status,omitempty"`
+	Version   uint64                 `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"` // incremented on every Respond change
 }
 
 func (x *GetRespondOut) Reset() {
@@ -282,12 +397,29 @@ func (x *GetRespondOut) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GetRespondOut) GetStatus() RespondStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RespondStatus_RESPOND_STATUS_UNSPECIFIED
+}
+
+func (x *GetRespondOut) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type GetTicketRespondsIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TicketID uint64 `protobuf:"varint,1,opt,name=ticketID,proto3" json:"ticketID,omitempty"`
+	TicketID   uint64           `protobuf:"varint,1,opt,name=ticketID,proto3" json:"ticketID,omitempty"`
+	Pagination *Pagination      `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"` // cursor is not supported for Responds
+	Filters    *RespondsFilters `protobuf:"bytes,3,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	Sort       []*RespondsSort  `protobuf:"bytes,4,rep,name=sort,proto3" json:"sort,omitempty"` // sort keys in priority order, newest Responds go first by default
 }
 
 func (x *GetTicketRespondsIn) Reset() {
@@ -329,6 +461,27 @@ func (x *GetTicketRespondsIn) GetTicketID() uint64 {
 	return 0
 }
 
+func (x *GetTicketRespondsIn) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetTicketRespondsIn) GetFilters() *RespondsFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *GetTicketRespondsIn) GetSort() []*RespondsSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
 type GetRespondsOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -376,16 +529,16 @@ func (x *GetRespondsOut) GetResponds() []*GetRespondOut {
 	return nil
 }
 
-type GetUserRespondsIn struct {
+type GetRespondsByIDsIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	IDs []uint64 `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
 }
 
-func (x *GetUserRespondsIn) Reset() {
-	*x = GetUserRespondsIn{}
+func (x *GetRespondsByIDsIn) Reset() {
+	*x = GetRespondsByIDsIn{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tickets_responds_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -393,13 +546,13 @@ func (x *GetUserRespondsIn) Reset() {
 	}
 }
 
-func (x *GetUserRespondsIn) String() string {
+func (x *GetRespondsByIDsIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRespondsIn) ProtoMessage() {}
+func (*GetRespondsByIDsIn) ProtoMessage() {}
 
-func (x *GetUserRespondsIn) ProtoReflect() protoreflect.Message {
+func (x *GetRespondsByIDsIn) ProtoReflect() protoreflect.Message {
 	mi := &file_tickets_responds_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -411,30 +564,29 @@ func (x *GetUserRespondsIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRespondsIn.ProtoReflect.Descriptor instead.
-func (*GetUserRespondsIn) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRespondsByIDsIn.ProtoReflect.Descriptor instead.
+func (*GetRespondsByIDsIn) Descriptor() ([]byte, []int) {
 	return file_tickets_responds_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetUserRespondsIn) GetUserID() uint64 {
+func (x *GetRespondsByIDsIn) GetIDs() []uint64 {
 	if x != nil {
-		return x.UserID
+		return x.IDs
 	}
-	return 0
+	return nil
 }
 
-type UpdateRespondIn struct {
+type GetRespondsByIDsOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID      uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Price   *float32 `protobuf:"fixed32,2,opt,name=price,proto3,oneof" json:"price,omitempty"`
-	Comment *string  `protobuf:"bytes,3,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	Responds   []*GetRespondOut `protobuf:"bytes,1,rep,name=responds,proto3" json:"responds,omitempty"` // found Responds in order of requested IDs
+	MissingIDs []uint64         `protobuf:"varint,2,rep,packed,name=missingIDs,proto3" json:"missingIDs,omitempty"`
 }
 
-func (x *UpdateRespondIn) Reset() {
-	*x = UpdateRespondIn{}
+func (x *GetRespondsByIDsOut) Reset() {
+	*x = GetRespondsByIDsOut{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tickets_responds_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -442,13 +594,13 @@ func (x *UpdateRespondIn) Reset() {
 	}
 }
 
-func (x *UpdateRespondIn) String() string {
+func (x *GetRespondsByIDsOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRespondIn) ProtoMessage() {}
+func (*GetRespondsByIDsOut) ProtoMessage() {}
 
-func (x *UpdateRespondIn) ProtoReflect() protoreflect.Message {
+func (x *GetRespondsByIDsOut) ProtoReflect() protoreflect.Message {
 	mi := &file_tickets_responds_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,42 +612,38 @@ func (x *UpdateRespondIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRespondIn.ProtoReflect.Descriptor instead.
-func (*UpdateRespondIn) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRespondsByIDsOut.ProtoReflect.Descriptor instead.
+func (*GetRespondsByIDsOut) Descriptor() ([]byte, []int) {
 	return file_tickets_responds_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UpdateRespondIn) GetID() uint64 {
+func (x *GetRespondsByIDsOut) GetResponds() []*GetRespondOut {
 	if x != nil {
-		return x.ID
-	}
-	return 0
-}
-
-func (x *UpdateRespondIn) GetPrice() float32 {
-	if x != nil && x.Price != nil {
-		return *x.Price
+		return x.Responds
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateRespondIn) GetComment() string {
-	if x != nil && x.Comment != nil {
-		return *x.Comment
+func (x *GetRespondsByIDsOut) GetMissingIDs() []uint64 {
+	if x != nil {
+		return x.MissingIDs
 	}
-	return ""
+	return nil
 }
 
-type DeleteRespondIn struct {
+type GetUserRespondsIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID     uint64           `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Pagination *Pagination      `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"` // cursor is not supported for Responds
+	Filters    *RespondsFilters `protobuf:"bytes,3,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	Sort       []*RespondsSort  `protobuf:"bytes,4,rep,name=sort,proto3" json:"sort,omitempty"` // sort keys in priority order, newest Responds go first by default
 }
 
-func (x *DeleteRespondIn) Reset() {
-	*x = DeleteRespondIn{}
+func (x *GetUserRespondsIn) Reset() {
+	*x = GetUserRespondsIn{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tickets_responds_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -503,13 +651,13 @@ func (x *DeleteRespondIn) Reset() {
 	}
 }
 
-func (x *DeleteRespondIn) String() string {
+func (x *GetUserRespondsIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRespondIn) ProtoMessage() {}
+func (*GetUserRespondsIn) ProtoMessage() {}
 
-func (x *DeleteRespondIn) ProtoReflect() protoreflect.Message {
+func (x *GetUserRespondsIn) ProtoReflect() protoreflect.Message {
 	mi := &file_tickets_responds_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -521,200 +669,916 @@ func (x *DeleteRespondIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRespondIn.ProtoReflect.Descriptor instead.
-func (*DeleteRespondIn) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserRespondsIn.ProtoReflect.Descriptor instead.
+func (*GetUserRespondsIn) Descriptor() ([]byte, []int) {
 	return file_tickets_responds_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeleteRespondIn) GetID() uint64 {
+func (x *GetUserRespondsIn) GetUserID() uint64 {
 	if x != nil {
-		return x.ID
+		return x.UserID
 	}
 	return 0
 }
 
-var File_tickets_responds_proto protoreflect.FileDescriptor
+func (x *GetUserRespondsIn) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
 
-var file_tickets_responds_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x64, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x88, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a,
-	0x0a, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x32, 0x0a, 0x12, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x44, 0x22,
-	0x1e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x12,
-	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x22,
-	0x8c, 0x02, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4f, 0x75,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
-	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a,
-	0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
-	0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x38,
-	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x31,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x64, 0x73, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49,
-	0x44, 0x22, 0x45, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73,
-	0x4f, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x52, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x2b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x49, 0x6e, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x71, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88,
-	0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x0a, 0x0a, 0x08,
-	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x21, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x32, 0xca, 0x03, 0x0a, 0x0f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x4e, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x12, 0x1b, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x1a,
-	0x1c, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x64, 0x54, 0x6f, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12,
-	0x3f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x12, 0x16, 0x2e,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x1a, 0x17, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x22, 0x00,
-	0x12, 0x4e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1d, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x64, 0x73, 0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00,
-	0x12, 0x4a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x64, 0x73, 0x12, 0x1b, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x49, 0x6e,
-	0x1a, 0x18, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x12, 0x19, 0x2e,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x64, 0x12, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x4b, 0x68, 0x6f, 0x72, 0x6b, 0x6f, 0x76, 0x2f,
-	0x68, 0x6d, 0x74, 0x6d, 0x2d, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x3b, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+func (x *GetUserRespondsIn) GetFilters() *RespondsFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
 }
 
-var (
-	file_tickets_responds_proto_rawDescOnce sync.Once
-	file_tickets_responds_proto_rawDescData = file_tickets_responds_proto_rawDesc
-)
+func (x *GetUserRespondsIn) GetSort() []*RespondsSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
 
-func file_tickets_responds_proto_rawDescGZIP() []byte {
-	file_tickets_responds_proto_rawDescOnce.Do(func() {
-		file_tickets_responds_proto_rawDescData = protoimpl.X.CompressGZIP(file_tickets_responds_proto_rawDescData)
-	})
-	return file_tickets_responds_proto_rawDescData
+type CountTicketRespondsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TicketID uint64           `protobuf:"varint,1,opt,name=ticketID,proto3" json:"ticketID,omitempty"`
+	Filters  *RespondsFilters `protobuf:"bytes,2,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
 }
 
-var file_tickets_responds_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-var file_tickets_responds_proto_goTypes = []interface{}{
-	(*RespondToTicketIn)(nil),     // 0: responds.RespondToTicketIn
-	(*RespondToTicketOut)(nil),    // 1: responds.RespondToTicketOut
-	(*GetRespondIn)(nil),          // 2: responds.GetRespondIn
-	(*GetRespondOut)(nil),         // 3: responds.GetRespondOut
-	(*GetTicketRespondsIn)(nil),   // 4: responds.GetTicketRespondsIn
-	(*GetRespondsOut)(nil),        // 5: responds.GetRespondsOut
-	(*GetUserRespondsIn)(nil),     // 6: responds.GetUserRespondsIn
-	(*UpdateRespondIn)(nil),       // 7: responds.UpdateRespondIn
-	(*DeleteRespondIn)(nil),       // 8: responds.DeleteRespondIn
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 10: google.protobuf.Empty
+func (x *CountTicketRespondsIn) Reset() {
+	*x = CountTicketRespondsIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tickets_responds_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_tickets_responds_proto_depIdxs = []int32{
-	9,  // 0: responds.GetRespondOut.createdAt:type_name -> google.protobuf.Timestamp
-	9,  // 1: responds.GetRespondOut.updatedAt:type_name -> google.protobuf.Timestamp
-	3,  // 2: responds.GetRespondsOut.responds:type_name -> responds.GetRespondOut
-	0,  // 3: responds.RespondsService.RespondToTicket:input_type -> responds.RespondToTicketIn
-	2,  // 4: responds.RespondsService.GetRespond:input_type -> responds.GetRespondIn
-	4,  // 5: responds.RespondsService.GetTicketResponds:input_type -> responds.GetTicketRespondsIn
-	6,  // 6: responds.RespondsService.GetUserResponds:input_type -> responds.GetUserRespondsIn
-	7,  // 7: responds.RespondsService.UpdateRespond:input_type -> responds.UpdateRespondIn
-	8,  // 8: responds.RespondsService.DeleteRespond:input_type -> responds.DeleteRespondIn
-	1,  // 9: responds.RespondsService.RespondToTicket:output_type -> responds.RespondToTicketOut
-	3,  // 10: responds.RespondsService.GetRespond:output_type -> responds.GetRespondOut
-	5,  // 11: responds.RespondsService.GetTicketResponds:output_type -> responds.GetRespondsOut
-	5,  // 12: responds.RespondsService.GetUserResponds:output_type -> responds.GetRespondsOut
-	10, // 13: responds.RespondsService.UpdateRespond:output_type -> google.protobuf.Empty
-	10, // 14: responds.RespondsService.DeleteRespond:output_type -> google.protobuf.Empty
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+
+func (x *CountTicketRespondsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_tickets_responds_proto_init() }
-func file_tickets_responds_proto_init() {
-	if File_tickets_responds_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_tickets_responds_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RespondToTicketIn); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (*CountTicketRespondsIn) ProtoMessage() {}
+
+func (x *CountTicketRespondsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_tickets_responds_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_tickets_responds_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RespondToTicketOut); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountTicketRespondsIn.ProtoReflect.Descriptor instead.
+func (*CountTicketRespondsIn) Descriptor() ([]byte, []int) {
+	return file_tickets_responds_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CountTicketRespondsIn) GetTicketID() uint64 {
+	if x != nil {
+		return x.TicketID
+	}
+	return 0
+}
+
+func (x *CountTicketRespondsIn) GetFilters() *RespondsFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type CountUserRespondsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  uint64           `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Filters *RespondsFilters `protobuf:"bytes,2,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+}
+
+func (x *CountUserRespondsIn) Reset() {
+	*x = CountUserRespondsIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tickets_responds_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountUserRespondsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountUserRespondsIn) ProtoMessage() {}
+
+func (x *CountUserRespondsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_tickets_responds_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_tickets_responds_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRespondIn); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountUserRespondsIn.ProtoReflect.Descriptor instead.
+func (*CountUserRespondsIn) Descriptor() ([]byte, []int) {
+	return file_tickets_responds_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CountUserRespondsIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *CountUserRespondsIn) GetFilters() *RespondsFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type RespondsSort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field     RespondsSortField `protobuf:"varint,1,opt,name=field,proto3,enum=responds.RespondsSortField" json:"field,omitempty"`
+	Direction SortDirection     `protobuf:"varint,2,opt,name=direction,proto3,enum=tickets.SortDirection" json:"direction,omitempty"`
+}
+
+func (x *RespondsSort) Reset() {
+	*x = RespondsSort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tickets_responds_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondsSort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondsSort) ProtoMessage() {}
+
+func (x *RespondsSort) ProtoReflect() protoreflect.Message {
+	mi := &file_tickets_responds_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondsSort.ProtoReflect.Descriptor instead.
+func (*RespondsSort) Descriptor() ([]byte, []int) {
+	return file_tickets_responds_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RespondsSort) GetField() RespondsSortField {
+	if x != nil {
+		return x.Field
+	}
+	return RespondsSortField_RESPONDS_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *RespondsSort) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+type RespondsFilters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PriceCeil     *float32               `protobuf:"fixed32,1,opt,name=priceCeil,proto3,oneof" json:"priceCeil,omitempty"`   // max price
+	PriceFloor    *float32               `protobuf:"fixed32,2,opt,name=priceFloor,proto3,oneof" json:"priceFloor,omitempty"` // min price
+	Statuses      []RespondStatus        `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=responds.RespondStatus" json:"statuses,omitempty"`
+	CreatedAtFrom *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAtFrom,proto3" json:"createdAtFrom,omitempty"` // inclusive
+	CreatedAtTo   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAtTo,proto3" json:"createdAtTo,omitempty"`     // exclusive
+}
+
+func (x *RespondsFilters) Reset() {
+	*x = RespondsFilters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tickets_responds_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondsFilters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondsFilters) ProtoMessage() {}
+
+func (x *RespondsFilters) ProtoReflect() protoreflect.Message {
+	mi := &file_tickets_responds_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondsFilters.ProtoReflect.Descriptor instead.
+func (*RespondsFilters) Descriptor() ([]byte, []int) {
+	return file_tickets_responds_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RespondsFilters) GetPriceCeil() float32 {
+	if x != nil && x.PriceCeil != nil {
+		return *x.PriceCeil
+	}
+	return 0
+}
+
+func (x *RespondsFilters) GetPriceFloor() float32 {
+	if x != nil && x.PriceFloor != nil {
+		return *x.PriceFloor
+	}
+	return 0
+}
+
+func (x *RespondsFilters) GetStatuses() []RespondStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *RespondsFilters) GetCreatedAtFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtFrom
+	}
+	return nil
+}
+
+func (x *RespondsFilters) GetCreatedAtTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTo
+	}
+	return nil
+}
+
+type UpdateRespondIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID              uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Price           *float32 `protobuf:"fixed32,2,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	Comment         *string  `protobuf:"bytes,3,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	UserID          uint64   `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty"`
+	ExpectedVersion *uint64  `protobuf:"varint,5,opt,name=expectedVersion,proto3,oneof" json:"expectedVersion,omitempty"` // update is aborted, if Respond has another version
+}
+
+func (x *UpdateRespondIn) Reset() {
+	*x = UpdateRespondIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tickets_responds_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRespondIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRespondIn) ProtoMessage() {}
+
+func (x *UpdateRespondIn) ProtoReflect() protoreflect.Message {
+	mi := &file_tickets_responds_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRespondIn.ProtoReflect.Descriptor instead.
+func (*UpdateRespondIn) Descriptor() ([]byte, []int) {
+	return file_tickets_responds_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateRespondIn) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *UpdateRespondIn) GetPrice() float32 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *UpdateRespondIn) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *UpdateRespondIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *UpdateRespondIn) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type DeleteRespondIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID     uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *DeleteRespondIn) Reset() {
+	*x = DeleteRespondIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tickets_responds_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRespondIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRespondIn) ProtoMessage() {}
+
+func (x *DeleteRespondIn) ProtoReflect() protoreflect.Message {
+	mi := &file_tickets_responds_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRespondIn.ProtoReflect.Descriptor instead.
+func (*DeleteRespondIn) Descriptor() ([]byte, []int) {
+	return file_tickets_responds_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteRespondIn) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *DeleteRespondIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type AcceptRespondIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID     uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *AcceptRespondIn) Reset() {
+	*x = AcceptRespondIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tickets_responds_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptRespondIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptRespondIn) ProtoMessage() {}
+
+func (x *AcceptRespondIn) ProtoReflect() protoreflect.Message {
+	mi := &file_tickets_responds_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptRespondIn.ProtoReflect.Descriptor instead.
+func (*AcceptRespondIn) Descriptor() ([]byte, []int) {
+	return file_tickets_responds_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AcceptRespondIn) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *AcceptRespondIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type RejectRespondIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID     uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *RejectRespondIn) Reset() {
+	*x = RejectRespondIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tickets_responds_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectRespondIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRespondIn) ProtoMessage() {}
+
+func (x *RejectRespondIn) ProtoReflect() protoreflect.Message {
+	mi := &file_tickets_responds_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRespondIn.ProtoReflect.Descriptor instead.
+func (*RejectRespondIn) Descriptor() ([]byte, []int) {
+	return file_tickets_responds_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RejectRespondIn) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *RejectRespondIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+var File_tickets_responds_proto protoreflect.FileDescriptor
+
+var file_tickets_responds_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc8, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x54, 0x6f, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0e,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x88, 0x01,
+	0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79,
+	0x22, 0x32, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x49, 0x44, 0x22, 0x1e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x44, 0x22, 0xd7, 0x02, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xec,
+	0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x73, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x49, 0x44, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x07,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64,
+	0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x01, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f,
+	0x72, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x45, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x4f, 0x75, 0x74, 0x12,
+	0x33, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x73, 0x22, 0x26, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x49, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x6a, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73,
+	0x4f, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x73, 0x22, 0xe6, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x49, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x38, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x64, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x01, 0x52, 0x07,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x04, 0x73, 0x6f,
+	0x72, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x53, 0x6f, 0x72, 0x74,
+	0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x22, 0x79, 0x0a, 0x15, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x73, 0x0a, 0x13,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64,
+	0x73, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x07, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x22, 0x77, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x53, 0x6f, 0x72,
+	0x74, 0x12, 0x31, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xab, 0x02, 0x0a, 0x0f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x21,
+	0x0a, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x02, 0x48, 0x00, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x46, 0x6c,
+	0x6f, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3c, 0x0a,
+	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x54, 0x6f, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x54, 0x6f, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x22, 0xcc, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x19, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2d,
+	0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x22, 0x39, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x39, 0x0a,
+	0x0f, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x2a, 0xa3, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45,
+	0x53, 0x50, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45,
+	0x53, 0x50, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
+	0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x44, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x4e, 0x10, 0x04, 0x2a, 0x7b,
+	0x0a, 0x11, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x44, 0x53, 0x5f,
+	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x53, 0x50,
+	0x4f, 0x4e, 0x44, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f,
+	0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x45, 0x53, 0x50, 0x4f,
+	0x4e, 0x44, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x02, 0x32, 0xd4, 0x09, 0x0a, 0x0f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x78, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x12, 0x1b, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x1a,
+	0x1c, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x54, 0x6f, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x2a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2f, 0x7b, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x58, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x12, 0x16, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x1a,
+	0x17, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
+	0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2f, 0x7b,
+	0x49, 0x44, 0x7d, 0x12, 0x6e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x12, 0x1c, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x79,
+	0x49, 0x44, 0x73, 0x49, 0x6e, 0x1a, 0x1d, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x79, 0x49, 0x44,
+	0x73, 0x4f, 0x75, 0x74, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76,
+	0x31, 0x2f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x47, 0x65, 0x74, 0x12, 0x7f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1d, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x64, 0x73, 0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x4f, 0x75,
+	0x74, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a, 0x22, 0x26, 0x2f, 0x76,
+	0x31, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x12, 0x7b, 0x0a, 0x13, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1f, 0x2e, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22,
+	0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x7d, 0x2f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x01,
+	0x2a, 0x12, 0x77, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1b, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x49,
+	0x6e, 0x1a, 0x18, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x2d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x27, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73,
+	0x3a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x12, 0x73, 0x0a, 0x11, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x1d, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x49, 0x6e, 0x1a, 0x11,
+	0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75,
+	0x74, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x01, 0x2a, 0x12,
+	0x60, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64,
+	0x12, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x32, 0x11, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x3a, 0x01,
+	0x2a, 0x12, 0x5d, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x12, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a, 0x11, 0x2f,
+	0x76, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2f, 0x7b, 0x49, 0x44, 0x7d,
+	0x12, 0x67, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x12, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76,
+	0x31, 0x2f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x3a,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x67, 0x0a, 0x0d, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x12, 0x19, 0x2e, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x23, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x73, 0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x3a, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x44, 0x4b, 0x68, 0x6f, 0x72, 0x6b, 0x6f, 0x76, 0x2f, 0x68, 0x6d, 0x74, 0x6d, 0x2d, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x3b, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tickets_responds_proto_rawDescOnce sync.Once
+	file_tickets_responds_proto_rawDescData = file_tickets_responds_proto_rawDesc
+)
+
+func file_tickets_responds_proto_rawDescGZIP() []byte {
+	file_tickets_responds_proto_rawDescOnce.Do(func() {
+		file_tickets_responds_proto_rawDescData = protoimpl.X.CompressGZIP(file_tickets_responds_proto_rawDescData)
+	})
+	return file_tickets_responds_proto_rawDescData
+}
+
+var file_tickets_responds_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_tickets_responds_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_tickets_responds_proto_goTypes = []interface{}{
+	(RespondStatus)(0),            // 0: responds.RespondStatus
+	(RespondsSortField)(0),        // 1: responds.RespondsSortField
+	(*RespondToTicketIn)(nil),     // 2: responds.RespondToTicketIn
+	(*RespondToTicketOut)(nil),    // 3: responds.RespondToTicketOut
+	(*GetRespondIn)(nil),          // 4: responds.GetRespondIn
+	(*GetRespondOut)(nil),         // 5: responds.GetRespondOut
+	(*GetTicketRespondsIn)(nil),   // 6: responds.GetTicketRespondsIn
+	(*GetRespondsOut)(nil),        // 7: responds.GetRespondsOut
+	(*GetRespondsByIDsIn)(nil),    // 8: responds.GetRespondsByIDsIn
+	(*GetRespondsByIDsOut)(nil),   // 9: responds.GetRespondsByIDsOut
+	(*GetUserRespondsIn)(nil),     // 10: responds.GetUserRespondsIn
+	(*CountTicketRespondsIn)(nil), // 11: responds.CountTicketRespondsIn
+	(*CountUserRespondsIn)(nil),   // 12: responds.CountUserRespondsIn
+	(*RespondsSort)(nil),          // 13: responds.RespondsSort
+	(*RespondsFilters)(nil),       // 14: responds.RespondsFilters
+	(*UpdateRespondIn)(nil),       // 15: responds.UpdateRespondIn
+	(*DeleteRespondIn)(nil),       // 16: responds.DeleteRespondIn
+	(*AcceptRespondIn)(nil),       // 17: responds.AcceptRespondIn
+	(*RejectRespondIn)(nil),       // 18: responds.RejectRespondIn
+	(*timestamppb.Timestamp)(nil), // 19: google.protobuf.Timestamp
+	(*Pagination)(nil),            // 20: tickets.Pagination
+	(SortDirection)(0),            // 21: tickets.SortDirection
+	(*CountOut)(nil),              // 22: tickets.CountOut
+	(*emptypb.Empty)(nil),         // 23: google.protobuf.Empty
+}
+var file_tickets_responds_proto_depIdxs = []int32{
+	19, // 0: responds.GetRespondOut.createdAt:type_name -> google.protobuf.Timestamp
+	19, // 1: responds.GetRespondOut.updatedAt:type_name -> google.protobuf.Timestamp
+	0,  // 2: responds.GetRespondOut.status:type_name -> responds.RespondStatus
+	20, // 3: responds.GetTicketRespondsIn.pagination:type_name -> tickets.Pagination
+	14, // 4: responds.GetTicketRespondsIn.filters:type_name -> responds.RespondsFilters
+	13, // 5: responds.GetTicketRespondsIn.sort:type_name -> responds.RespondsSort
+	5,  // 6: responds.GetRespondsOut.responds:type_name -> responds.GetRespondOut
+	5,  // 7: responds.GetRespondsByIDsOut.responds:type_name -> responds.GetRespondOut
+	20, // 8: responds.GetUserRespondsIn.pagination:type_name -> tickets.Pagination
+	14, // 9: responds.GetUserRespondsIn.filters:type_name -> responds.RespondsFilters
+	13, // 10: responds.GetUserRespondsIn.sort:type_name -> responds.RespondsSort
+	14, // 11: responds.CountTicketRespondsIn.filters:type_name -> responds.RespondsFilters
+	14, // 12: responds.CountUserRespondsIn.filters:type_name -> responds.RespondsFilters
+	1,  // 13: responds.RespondsSort.field:type_name -> responds.RespondsSortField
+	21, // 14: responds.RespondsSort.direction:type_name -> tickets.SortDirection
+	0,  // 15: responds.RespondsFilters.statuses:type_name -> responds.RespondStatus
+	19, // 16: responds.RespondsFilters.createdAtFrom:type_name -> google.protobuf.Timestamp
+	19, // 17: responds.RespondsFilters.createdAtTo:type_name -> google.protobuf.Timestamp
+	2,  // 18: responds.RespondsService.RespondToTicket:input_type -> responds.RespondToTicketIn
+	4,  // 19: responds.RespondsService.GetRespond:input_type -> responds.GetRespondIn
+	8,  // 20: responds.RespondsService.GetRespondsByIDs:input_type -> responds.GetRespondsByIDsIn
+	6,  // 21: responds.RespondsService.GetTicketResponds:input_type -> responds.GetTicketRespondsIn
+	11, // 22: responds.RespondsService.CountTicketResponds:input_type -> responds.CountTicketRespondsIn
+	10, // 23: responds.RespondsService.GetUserResponds:input_type -> responds.GetUserRespondsIn
+	12, // 24: responds.RespondsService.CountUserResponds:input_type -> responds.CountUserRespondsIn
+	15, // 25: responds.RespondsService.UpdateRespond:input_type -> responds.UpdateRespondIn
+	16, // 26: responds.RespondsService.DeleteRespond:input_type -> responds.DeleteRespondIn
+	17, // 27: responds.RespondsService.AcceptRespond:input_type -> responds.AcceptRespondIn
+	18, // 28: responds.RespondsService.RejectRespond:input_type -> responds.RejectRespondIn
+	3,  // 29: responds.RespondsService.RespondToTicket:output_type -> responds.RespondToTicketOut
+	5,  // 30: responds.RespondsService.GetRespond:output_type -> responds.GetRespondOut
+	9,  // 31: responds.RespondsService.GetRespondsByIDs:output_type -> responds.GetRespondsByIDsOut
+	7,  // 32: responds.RespondsService.GetTicketResponds:output_type -> responds.GetRespondsOut
+	22, // 33: responds.RespondsService.CountTicketResponds:output_type -> tickets.CountOut
+	7,  // 34: responds.RespondsService.GetUserResponds:output_type -> responds.GetRespondsOut
+	22, // 35: responds.RespondsService.CountUserResponds:output_type -> tickets.CountOut
+	23, // 36: responds.RespondsService.UpdateRespond:output_type -> google.protobuf.Empty
+	23, // 37: responds.RespondsService.DeleteRespond:output_type -> google.protobuf.Empty
+	23, // 38: responds.RespondsService.AcceptRespond:output_type -> google.protobuf.Empty
+	23, // 39: responds.RespondsService.RejectRespond:output_type -> google.protobuf.Empty
+	29, // [29:40] is the sub-list for method output_type
+	18, // [18:29] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_tickets_responds_proto_init() }
+func file_tickets_responds_proto_init() {
+	if File_tickets_responds_proto != nil {
+		return
+	}
+	file_tickets_tickets_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_tickets_responds_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondToTicketIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tickets_responds_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondToTicketOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tickets_responds_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRespondIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
 				return &v.unknownFields
 			default:
 				return nil
@@ -757,7 +1621,7 @@ func file_tickets_responds_proto_init() {
 			}
 		}
 		file_tickets_responds_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserRespondsIn); i {
+			switch v := v.(*GetRespondsByIDsIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -769,7 +1633,7 @@ func file_tickets_responds_proto_init() {
 			}
 		}
 		file_tickets_responds_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRespondIn); i {
+			switch v := v.(*GetRespondsByIDsOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -781,6 +1645,78 @@ func file_tickets_responds_proto_init() {
 			}
 		}
 		file_tickets_responds_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserRespondsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tickets_responds_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountTicketRespondsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tickets_responds_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountUserRespondsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tickets_responds_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondsSort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tickets_responds_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondsFilters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tickets_responds_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRespondIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tickets_responds_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteRespondIn); i {
 			case 0:
 				return &v.state
@@ -792,22 +1728,52 @@ func file_tickets_responds_proto_init() {
 				return nil
 			}
 		}
+		file_tickets_responds_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptRespondIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tickets_responds_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectRespondIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_tickets_responds_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_tickets_responds_proto_msgTypes[3].OneofWrappers = []interface{}{}
-	file_tickets_responds_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_tickets_responds_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_tickets_responds_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_tickets_responds_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_tickets_responds_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_tickets_responds_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_tickets_responds_proto_msgTypes[13].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tickets_responds_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_tickets_responds_proto_goTypes,
 		DependencyIndexes: file_tickets_responds_proto_depIdxs,
+		EnumInfos:         file_tickets_responds_proto_enumTypes,
 		MessageInfos:      file_tickets_responds_proto_msgTypes,
 	}.Build()
 	File_tickets_responds_proto = out.File
@@ -21,10 +21,15 @@ const _ = grpc.SupportPackageIsVersion7
 type RespondsServiceClient interface {
 	RespondToTicket(ctx context.Context, in *RespondToTicketIn, opts ...grpc.CallOption) (*RespondToTicketOut, error)
 	GetRespond(ctx context.Context, in *GetRespondIn, opts ...grpc.CallOption) (*GetRespondOut, error)
+	GetRespondsByIDs(ctx context.Context, in *GetRespondsByIDsIn, opts ...grpc.CallOption) (*GetRespondsByIDsOut, error)
 	GetTicketResponds(ctx context.Context, in *GetTicketRespondsIn, opts ...grpc.CallOption) (*GetRespondsOut, error)
+	CountTicketResponds(ctx context.Context, in *CountTicketRespondsIn, opts ...grpc.CallOption) (*CountOut, error)
 	GetUserResponds(ctx context.Context, in *GetUserRespondsIn, opts ...grpc.CallOption) (*GetRespondsOut, error)
+	CountUserResponds(ctx context.Context, in *CountUserRespondsIn, opts ...grpc.CallOption) (*CountOut, error)
 	UpdateRespond(ctx context.Context, in *UpdateRespondIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteRespond(ctx context.Context, in *DeleteRespondIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AcceptRespond(ctx context.Context, in *AcceptRespondIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RejectRespond(ctx context.Context, in *RejectRespondIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type respondsServiceClient struct {
@@ -53,6 +58,15 @@ func (c *respondsServiceClient) GetRespond(ctx context.Context, in *GetRespondIn
 	return out, nil
 }
 
+func (c *respondsServiceClient) GetRespondsByIDs(ctx context.Context, in *GetRespondsByIDsIn, opts ...grpc.CallOption) (*GetRespondsByIDsOut, error) {
+	out := new(GetRespondsByIDsOut)
+	err := c.cc.Invoke(ctx, "/responds.RespondsService/GetRespondsByIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *respondsServiceClient) GetTicketResponds(ctx context.Context, in *GetTicketRespondsIn, opts ...grpc.CallOption) (*GetRespondsOut, error) {
 	out := new(GetRespondsOut)
 	err := c.cc.Invoke(ctx, "/responds.RespondsService/GetTicketResponds", in, out, opts...)
@@ -62,6 +76,15 @@ func (c *respondsServiceClient) GetTicketResponds(ctx context.Context, in *GetTi
 	return out, nil
 }
 
+func (c *respondsServiceClient) CountTicketResponds(ctx context.Context, in *CountTicketRespondsIn, opts ...grpc.CallOption) (*CountOut, error) {
+	out := new(CountOut)
+	err := c.cc.Invoke(ctx, "/responds.RespondsService/CountTicketResponds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *respondsServiceClient) GetUserResponds(ctx context.Context, in *GetUserRespondsIn, opts ...grpc.CallOption) (*GetRespondsOut, error) {
 	out := new(GetRespondsOut)
 	err := c.cc.Invoke(ctx, "/responds.RespondsService/GetUserResponds", in, out, opts...)
@@ -71,6 +94,15 @@ func (c *respondsServiceClient) GetUserResponds(ctx context.Context, in *GetUser
 	return out, nil
 }
 
+func (c *respondsServiceClient) CountUserResponds(ctx context.Context, in *CountUserRespondsIn, opts ...grpc.CallOption) (*CountOut, error) {
+	out := new(CountOut)
+	err := c.cc.Invoke(ctx, "/responds.RespondsService/CountUserResponds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *respondsServiceClient) UpdateRespond(ctx context.Context, in *UpdateRespondIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/responds.RespondsService/UpdateRespond", in, out, opts...)
@@ -89,16 +121,39 @@ func (c *respondsServiceClient) DeleteRespond(ctx context.Context, in *DeleteRes
 	return out, nil
 }
 
+func (c *respondsServiceClient) AcceptRespond(ctx context.Context, in *AcceptRespondIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/responds.RespondsService/AcceptRespond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *respondsServiceClient) RejectRespond(ctx context.Context, in *RejectRespondIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/responds.RespondsService/RejectRespond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RespondsServiceServer is the server API for RespondsService service.
 // All implementations must embed UnimplementedRespondsServiceServer
 // for forward compatibility
 type RespondsServiceServer interface {
 	RespondToTicket(context.Context, *RespondToTicketIn) (*RespondToTicketOut, error)
 	GetRespond(context.Context, *GetRespondIn) (*GetRespondOut, error)
+	GetRespondsByIDs(context.Context, *GetRespondsByIDsIn) (*GetRespondsByIDsOut, error)
 	GetTicketResponds(context.Context, *GetTicketRespondsIn) (*GetRespondsOut, error)
+	CountTicketResponds(context.Context, *CountTicketRespondsIn) (*CountOut, error)
 	GetUserResponds(context.Context, *GetUserRespondsIn) (*GetRespondsOut, error)
+	CountUserResponds(context.Context, *CountUserRespondsIn) (*CountOut, error)
 	UpdateRespond(context.Context, *UpdateRespondIn) (*emptypb.Empty, error)
 	DeleteRespond(context.Context, *DeleteRespondIn) (*emptypb.Empty, error)
+	AcceptRespond(context.Context, *AcceptRespondIn) (*emptypb.Empty, error)
+	RejectRespond(context.Context, *RejectRespondIn) (*emptypb.Empty, error)
 	mustEmbedUnimplementedRespondsServiceServer()
 }
 
@@ -112,18 +167,33 @@ func (UnimplementedRespondsServiceServer) RespondToTicket(context.Context, *Resp
 func (UnimplementedRespondsServiceServer) GetRespond(context.Context, *GetRespondIn) (*GetRespondOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRespond not implemented")
 }
+func (UnimplementedRespondsServiceServer) GetRespondsByIDs(context.Context, *GetRespondsByIDsIn) (*GetRespondsByIDsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRespondsByIDs not implemented")
+}
 func (UnimplementedRespondsServiceServer) GetTicketResponds(context.Context, *GetTicketRespondsIn) (*GetRespondsOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTicketResponds not implemented")
 }
+func (UnimplementedRespondsServiceServer) CountTicketResponds(context.Context, *CountTicketRespondsIn) (*CountOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountTicketResponds not implemented")
+}
 func (UnimplementedRespondsServiceServer) GetUserResponds(context.Context, *GetUserRespondsIn) (*GetRespondsOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserResponds not implemented")
 }
+func (UnimplementedRespondsServiceServer) CountUserResponds(context.Context, *CountUserRespondsIn) (*CountOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountUserResponds not implemented")
+}
 func (UnimplementedRespondsServiceServer) UpdateRespond(context.Context, *UpdateRespondIn) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRespond not implemented")
 }
 func (UnimplementedRespondsServiceServer) DeleteRespond(context.Context, *DeleteRespondIn) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRespond not implemented")
 }
+func (UnimplementedRespondsServiceServer) AcceptRespond(context.Context, *AcceptRespondIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptRespond not implemented")
+}
+func (UnimplementedRespondsServiceServer) RejectRespond(context.Context, *RejectRespondIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRespond not implemented")
+}
 func (UnimplementedRespondsServiceServer) mustEmbedUnimplementedRespondsServiceServer() {}
 
 // UnsafeRespondsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -173,6 +243,24 @@ func _RespondsService_GetRespond_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RespondsService_GetRespondsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRespondsByIDsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RespondsServiceServer).GetRespondsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/responds.RespondsService/GetRespondsByIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RespondsServiceServer).GetRespondsByIDs(ctx, req.(*GetRespondsByIDsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RespondsService_GetTicketResponds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTicketRespondsIn)
 	if err := dec(in); err != nil {
@@ -191,6 +279,24 @@ func _RespondsService_GetTicketResponds_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RespondsService_CountTicketResponds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountTicketRespondsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RespondsServiceServer).CountTicketResponds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/responds.RespondsService/CountTicketResponds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RespondsServiceServer).CountTicketResponds(ctx, req.(*CountTicketRespondsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RespondsService_GetUserResponds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserRespondsIn)
 	if err := dec(in); err != nil {
@@ -209,6 +315,24 @@ func _RespondsService_GetUserResponds_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RespondsService_CountUserResponds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountUserRespondsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RespondsServiceServer).CountUserResponds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/responds.RespondsService/CountUserResponds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RespondsServiceServer).CountUserResponds(ctx, req.(*CountUserRespondsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RespondsService_UpdateRespond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateRespondIn)
 	if err := dec(in); err != nil {
@@ -245,6 +369,42 @@ func _RespondsService_DeleteRespond_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RespondsService_AcceptRespond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptRespondIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RespondsServiceServer).AcceptRespond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/responds.RespondsService/AcceptRespond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RespondsServiceServer).AcceptRespond(ctx, req.(*AcceptRespondIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RespondsService_RejectRespond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectRespondIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RespondsServiceServer).RejectRespond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/responds.RespondsService/RejectRespond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RespondsServiceServer).RejectRespond(ctx, req.(*RejectRespondIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RespondsService_ServiceDesc is the grpc.ServiceDesc for RespondsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -260,14 +420,26 @@ var RespondsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRespond",
 			Handler:    _RespondsService_GetRespond_Handler,
 		},
+		{
+			MethodName: "GetRespondsByIDs",
+			Handler:    _RespondsService_GetRespondsByIDs_Handler,
+		},
 		{
 			MethodName: "GetTicketResponds",
 			Handler:    _RespondsService_GetTicketResponds_Handler,
 		},
+		{
+			MethodName: "CountTicketResponds",
+			Handler:    _RespondsService_CountTicketResponds_Handler,
+		},
 		{
 			MethodName: "GetUserResponds",
 			Handler:    _RespondsService_GetUserResponds_Handler,
 		},
+		{
+			MethodName: "CountUserResponds",
+			Handler:    _RespondsService_CountUserResponds_Handler,
+		},
 		{
 			MethodName: "UpdateRespond",
 			Handler:    _RespondsService_UpdateRespond_Handler,
@@ -276,6 +448,14 @@ var RespondsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRespond",
 			Handler:    _RespondsService_DeleteRespond_Handler,
 		},
+		{
+			MethodName: "AcceptRespond",
+			Handler:    _RespondsService_AcceptRespond_Handler,
+		},
+		{
+			MethodName: "RejectRespond",
+			Handler:    _RespondsService_RejectRespond_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tickets/responds.proto",
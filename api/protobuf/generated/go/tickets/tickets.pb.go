@@ -7,9 +7,11 @@
 package tickets
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,19 +24,240 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TicketStatus int32
+
+const (
+	TicketStatus_TICKET_STATUS_UNSPECIFIED TicketStatus = 0
+	TicketStatus_TICKET_STATUS_OPEN        TicketStatus = 1
+	TicketStatus_TICKET_STATUS_IN_PROGRESS TicketStatus = 2
+	TicketStatus_TICKET_STATUS_COMPLETED   TicketStatus = 3
+	TicketStatus_TICKET_STATUS_CANCELLED   TicketStatus = 4
+)
+
+// Enum value maps for TicketStatus.
+var (
+	TicketStatus_name = map[int32]string{
+		0: "TICKET_STATUS_UNSPECIFIED",
+		1: "TICKET_STATUS_OPEN",
+		2: "TICKET_STATUS_IN_PROGRESS",
+		3: "TICKET_STATUS_COMPLETED",
+		4: "TICKET_STATUS_CANCELLED",
+	}
+	TicketStatus_value = map[string]int32{
+		"TICKET_STATUS_UNSPECIFIED": 0,
+		"TICKET_STATUS_OPEN":        1,
+		"TICKET_STATUS_IN_PROGRESS": 2,
+		"TICKET_STATUS_COMPLETED":   3,
+		"TICKET_STATUS_CANCELLED":   4,
+	}
+)
+
+func (x TicketStatus) Enum() *TicketStatus {
+	p := new(TicketStatus)
+	*p = x
+	return p
+}
+
+func (x TicketStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TicketStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_tickets_tickets_proto_enumTypes[0].Descriptor()
+}
+
+func (TicketStatus) Type() protoreflect.EnumType {
+	return &file_tickets_tickets_proto_enumTypes[0]
+}
+
+func (x TicketStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TicketStatus.Descriptor instead.
+func (TicketStatus) EnumDescriptor() ([]byte, []int) {
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{0}
+}
+
+type TicketsSortField int32
+
+const (
+	TicketsSortField_TICKETS_SORT_FIELD_UNSPECIFIED    TicketsSortField = 0
+	TicketsSortField_TICKETS_SORT_FIELD_PRICE          TicketsSortField = 1 // Tickets without price go last
+	TicketsSortField_TICKETS_SORT_FIELD_QUANTITY       TicketsSortField = 2
+	TicketsSortField_TICKETS_SORT_FIELD_UPDATED_AT     TicketsSortField = 3
+	TicketsSortField_TICKETS_SORT_FIELD_RESPONDS_COUNT TicketsSortField = 4
+	TicketsSortField_TICKETS_SORT_FIELD_NAME           TicketsSortField = 5
+	TicketsSortField_TICKETS_SORT_FIELD_RELEVANCE      TicketsSortField = 6 // requires search, most relevant Tickets go first with descending direction
+)
+
+// Enum value maps for TicketsSortField.
+var (
+	TicketsSortField_name = map[int32]string{
+		0: "TICKETS_SORT_FIELD_UNSPECIFIED",
+		1: "TICKETS_SORT_FIELD_PRICE",
+		2: "TICKETS_SORT_FIELD_QUANTITY",
+		3: "TICKETS_SORT_FIELD_UPDATED_AT",
+		4: "TICKETS_SORT_FIELD_RESPONDS_COUNT",
+		5: "TICKETS_SORT_FIELD_NAME",
+		6: "TICKETS_SORT_FIELD_RELEVANCE",
+	}
+	TicketsSortField_value = map[string]int32{
+		"TICKETS_SORT_FIELD_UNSPECIFIED":    0,
+		"TICKETS_SORT_FIELD_PRICE":          1,
+		"TICKETS_SORT_FIELD_QUANTITY":       2,
+		"TICKETS_SORT_FIELD_UPDATED_AT":     3,
+		"TICKETS_SORT_FIELD_RESPONDS_COUNT": 4,
+		"TICKETS_SORT_FIELD_NAME":           5,
+		"TICKETS_SORT_FIELD_RELEVANCE":      6,
+	}
+)
+
+func (x TicketsSortField) Enum() *TicketsSortField {
+	p := new(TicketsSortField)
+	*p = x
+	return p
+}
+
+func (x TicketsSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TicketsSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_tickets_tickets_proto_enumTypes[1].Descriptor()
+}
+
+func (TicketsSortField) Type() protoreflect.EnumType {
+	return &file_tickets_tickets_proto_enumTypes[1]
+}
+
+func (x TicketsSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TicketsSortField.Descriptor instead.
+func (TicketsSortField) EnumDescriptor() ([]byte, []int) {
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{1}
+}
+
+type TicketEventType int32
+
+const (
+	TicketEventType_TICKET_EVENT_TYPE_UNSPECIFIED TicketEventType = 0
+	TicketEventType_TICKET_EVENT_TYPE_SNAPSHOT    TicketEventType = 1
+	TicketEventType_TICKET_EVENT_TYPE_CREATED     TicketEventType = 2
+	TicketEventType_TICKET_EVENT_TYPE_UPDATED     TicketEventType = 3
+	TicketEventType_TICKET_EVENT_TYPE_DELETED     TicketEventType = 4 // ticket contains last known state
+)
+
+// Enum value maps for TicketEventType.
+var (
+	TicketEventType_name = map[int32]string{
+		0: "TICKET_EVENT_TYPE_UNSPECIFIED",
+		1: "TICKET_EVENT_TYPE_SNAPSHOT",
+		2: "TICKET_EVENT_TYPE_CREATED",
+		3: "TICKET_EVENT_TYPE_UPDATED",
+		4: "TICKET_EVENT_TYPE_DELETED",
+	}
+	TicketEventType_value = map[string]int32{
+		"TICKET_EVENT_TYPE_UNSPECIFIED": 0,
+		"TICKET_EVENT_TYPE_SNAPSHOT":    1,
+		"TICKET_EVENT_TYPE_CREATED":     2,
+		"TICKET_EVENT_TYPE_UPDATED":     3,
+		"TICKET_EVENT_TYPE_DELETED":     4,
+	}
+)
+
+func (x TicketEventType) Enum() *TicketEventType {
+	p := new(TicketEventType)
+	*p = x
+	return p
+}
+
+func (x TicketEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TicketEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_tickets_tickets_proto_enumTypes[2].Descriptor()
+}
+
+func (TicketEventType) Type() protoreflect.EnumType {
+	return &file_tickets_tickets_proto_enumTypes[2]
+}
+
+func (x TicketEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TicketEventType.Descriptor instead.
+func (TicketEventType) EnumDescriptor() ([]byte, []int) {
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{2}
+}
+
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_UNSPECIFIED SortDirection = 0 // same as ascending
+	SortDirection_SORT_DIRECTION_ASC         SortDirection = 1
+	SortDirection_SORT_DIRECTION_DESC        SortDirection = 2
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_UNSPECIFIED",
+		1: "SORT_DIRECTION_ASC",
+		2: "SORT_DIRECTION_DESC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_UNSPECIFIED": 0,
+		"SORT_DIRECTION_ASC":         1,
+		"SORT_DIRECTION_DESC":        2,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_tickets_tickets_proto_enumTypes[3].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_tickets_tickets_proto_enumTypes[3]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{3}
+}
+
 type CreateTicketIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID      uint64   `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price       *float32 `protobuf:"fixed32,4,opt,name=price,proto3,oneof" json:"price,omitempty"`
-	Quantity    uint32   `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	CategoryID  uint32   `protobuf:"varint,6,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
-	TagIDs      []uint32 `protobuf:"varint,7,rep,packed,name=tagIDs,proto3" json:"tagIDs,omitempty"`
-	Attachments []string `protobuf:"bytes,8,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	UserID         uint64   `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Name           string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price          *float32 `protobuf:"fixed32,4,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	Quantity       uint32   `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	CategoryID     uint32   `protobuf:"varint,6,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	TagIDs         []uint32 `protobuf:"varint,7,rep,packed,name=tagIDs,proto3" json:"tagIDs,omitempty"`
+	Attachments    []string `protobuf:"bytes,8,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	IdempotencyKey *string  `protobuf:"bytes,9,opt,name=idempotencyKey,proto3,oneof" json:"idempotencyKey,omitempty"` // retried request with the same key returns original ticketID
 }
 
 func (x *CreateTicketIn) Reset() {
@@ -125,6 +348,13 @@ func (x *CreateTicketIn) GetAttachments() []string {
 	return nil
 }
 
+func (x *CreateTicketIn) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
 type CreateTicketOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -314,6 +544,8 @@ type GetTicketOut struct {
 	Attachments []*Attachment          `protobuf:"bytes,9,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Status      TicketStatus           `protobuf:"varint,12,opt,name=status,proto3,enum=tickets.TicketStatus" json:"status,omitempty"`
+	Version     uint64                 `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"` // incremented on every Ticket change
 }
 
 func (x *GetTicketOut) Reset() {
@@ -425,6 +657,20 @@ func (x *GetTicketOut) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GetTicketOut) GetStatus() TicketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TicketStatus_TICKET_STATUS_UNSPECIFIED
+}
+
+func (x *GetTicketOut) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type GetTicketsIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -432,6 +678,7 @@ type GetTicketsIn struct {
 
 	Pagination *Pagination     `protobuf:"bytes,1,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
 	Filters    *TicketsFilters `protobuf:"bytes,2,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	Sort       []*TicketsSort  `protobuf:"bytes,3,rep,name=sort,proto3" json:"sort,omitempty"` // sort keys in priority order, can't be combined with cursor
 }
 
 func (x *GetTicketsIn) Reset() {
@@ -480,12 +727,20 @@ func (x *GetTicketsIn) GetFilters() *TicketsFilters {
 	return nil
 }
 
+func (x *GetTicketsIn) GetSort() []*TicketsSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
 type GetTicketsOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tickets []*GetTicketOut `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	Tickets    []*GetTicketOut `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	NextCursor *string         `protobuf:"bytes,2,opt,name=nextCursor,proto3,oneof" json:"nextCursor,omitempty"` // cursor for the next page, if there may be one
 }
 
 func (x *GetTicketsOut) Reset() {
@@ -527,6 +782,115 @@ func (x *GetTicketsOut) GetTickets() []*GetTicketOut {
 	return nil
 }
 
+func (x *GetTicketsOut) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type GetTicketsByIDsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IDs []uint64 `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
+}
+
+func (x *GetTicketsByIDsIn) Reset() {
+	*x = GetTicketsByIDsIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tickets_tickets_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTicketsByIDsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketsByIDsIn) ProtoMessage() {}
+
+func (x *GetTicketsByIDsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_tickets_tickets_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketsByIDsIn.ProtoReflect.Descriptor instead.
+func (*GetTicketsByIDsIn) Descriptor() ([]byte, []int) {
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTicketsByIDsIn) GetIDs() []uint64 {
+	if x != nil {
+		return x.IDs
+	}
+	return nil
+}
+
+type GetTicketsByIDsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tickets    []*GetTicketOut `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"` // found Tickets in order of requested IDs
+	MissingIDs []uint64        `protobuf:"varint,2,rep,packed,name=missingIDs,proto3" json:"missingIDs,omitempty"`
+}
+
+func (x *GetTicketsByIDsOut) Reset() {
+	*x = GetTicketsByIDsOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tickets_tickets_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTicketsByIDsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketsByIDsOut) ProtoMessage() {}
+
+func (x *GetTicketsByIDsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_tickets_tickets_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketsByIDsOut.ProtoReflect.Descriptor instead.
+func (*GetTicketsByIDsOut) Descriptor() ([]byte, []int) {
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetTicketsByIDsOut) GetTickets() []*GetTicketOut {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+func (x *GetTicketsByIDsOut) GetMissingIDs() []uint64 {
+	if x != nil {
+		return x.MissingIDs
+	}
+	return nil
+}
+
 type GetUserTicketsIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -535,12 +899,13 @@ type GetUserTicketsIn struct {
 	UserID     uint64          `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	Pagination *Pagination     `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
 	Filters    *TicketsFilters `protobuf:"bytes,3,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	Sort       []*TicketsSort  `protobuf:"bytes,4,rep,name=sort,proto3" json:"sort,omitempty"` // sort keys in priority order, can't be combined with cursor
 }
 
 func (x *GetUserTicketsIn) Reset() {
 	*x = GetUserTicketsIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tickets_tickets_proto_msgTypes[7]
+		mi := &file_tickets_tickets_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -553,7 +918,7 @@ func (x *GetUserTicketsIn) String() string {
 func (*GetUserTicketsIn) ProtoMessage() {}
 
 func (x *GetUserTicketsIn) ProtoReflect() protoreflect.Message {
-	mi := &file_tickets_tickets_proto_msgTypes[7]
+	mi := &file_tickets_tickets_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -566,7 +931,7 @@ func (x *GetUserTicketsIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserTicketsIn.ProtoReflect.Descriptor instead.
 func (*GetUserTicketsIn) Descriptor() ([]byte, []int) {
-	return file_tickets_tickets_proto_rawDescGZIP(), []int{7}
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetUserTicketsIn) GetUserID() uint64 {
@@ -590,18 +955,26 @@ func (x *GetUserTicketsIn) GetFilters() *TicketsFilters {
 	return nil
 }
 
+func (x *GetUserTicketsIn) GetSort() []*TicketsSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
 type DeleteTicketIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ID     uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
 func (x *DeleteTicketIn) Reset() {
 	*x = DeleteTicketIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tickets_tickets_proto_msgTypes[8]
+		mi := &file_tickets_tickets_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -614,7 +987,7 @@ func (x *DeleteTicketIn) String() string {
 func (*DeleteTicketIn) ProtoMessage() {}
 
 func (x *DeleteTicketIn) ProtoReflect() protoreflect.Message {
-	mi := &file_tickets_tickets_proto_msgTypes[8]
+	mi := &file_tickets_tickets_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,7 +1000,7 @@ func (x *DeleteTicketIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTicketIn.ProtoReflect.Descriptor instead.
 func (*DeleteTicketIn) Descriptor() ([]byte, []int) {
-	return file_tickets_tickets_proto_rawDescGZIP(), []int{8}
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteTicketIn) GetID() uint64 {
@@ -637,25 +1010,38 @@ func (x *DeleteTicketIn) GetID() uint64 {
 	return 0
 }
 
+func (x *DeleteTicketIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
 type UpdateTicketIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name        *string  `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description *string  `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Price       *float32 `protobuf:"fixed32,4,opt,name=price,proto3,oneof" json:"price,omitempty"`
-	Quantity    *uint32  `protobuf:"varint,5,opt,name=quantity,proto3,oneof" json:"quantity,omitempty"`
-	CategoryID  *uint32  `protobuf:"varint,6,opt,name=categoryID,proto3,oneof" json:"categoryID,omitempty"`
-	TagIDs      []uint32 `protobuf:"varint,7,rep,packed,name=tagIDs,proto3" json:"tagIDs,omitempty"`
-	Attachments []string `protobuf:"bytes,8,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	ID              uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name            *string  `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description     *string  `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Price           *float32 `protobuf:"fixed32,4,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	Quantity        *uint32  `protobuf:"varint,5,opt,name=quantity,proto3,oneof" json:"quantity,omitempty"`
+	CategoryID      *uint32  `protobuf:"varint,6,opt,name=categoryID,proto3,oneof" json:"categoryID,omitempty"`
+	TagIDs          []uint32 `protobuf:"varint,7,rep,packed,name=tagIDs,proto3" json:"tagIDs,omitempty"`
+	Attachments     []string `protobuf:"bytes,8,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	UserID          uint64   `protobuf:"varint,9,opt,name=userID,proto3" json:"userID,omitempty"`
+	ExpectedVersion *uint64  `protobuf:"varint,10,opt,name=expectedVersion,proto3,oneof" json:"expectedVersion,omitempty"` // update is aborted, if Ticket has another version
+	// Paths of fields to be updated: categoryID, name, description, price, quantity, tagIDs, attachments.
+	// Price is cleared, if it is listed in mask, but not provided. Empty tagIDs and attachments remove all
+	// Ticket Tags and Attachments only if they are listed in mask. If mask is not provided, all fields are updated.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,11,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
 }
 
 func (x *UpdateTicketIn) Reset() {
 	*x = UpdateTicketIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tickets_tickets_proto_msgTypes[9]
+		mi := &file_tickets_tickets_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -668,7 +1054,7 @@ func (x *UpdateTicketIn) String() string {
 func (*UpdateTicketIn) ProtoMessage() {}
 
 func (x *UpdateTicketIn) ProtoReflect() protoreflect.Message {
-	mi := &file_tickets_tickets_proto_msgTypes[9]
+	mi := &file_tickets_tickets_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,7 +1067,7 @@ func (x *UpdateTicketIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTicketIn.ProtoReflect.Descriptor instead.
 func (*UpdateTicketIn) Descriptor() ([]byte, []int) {
-	return file_tickets_tickets_proto_rawDescGZIP(), []int{9}
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateTicketIn) GetID() uint64 {
@@ -740,31 +1126,54 @@ func (x *UpdateTicketIn) GetAttachments() []string {
 	return nil
 }
 
-type CountTicketsIn struct {
+func (x *UpdateTicketIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *UpdateTicketIn) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateTicketIn) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type ChangeTicketStatusIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filters *TicketsFilters `protobuf:"bytes,1,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	ID     uint64       `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Status TicketStatus `protobuf:"varint,2,opt,name=status,proto3,enum=tickets.TicketStatus" json:"status,omitempty"`
+	UserID uint64       `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *CountTicketsIn) Reset() {
-	*x = CountTicketsIn{}
+func (x *ChangeTicketStatusIn) Reset() {
+	*x = ChangeTicketStatusIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tickets_tickets_proto_msgTypes[10]
+		mi := &file_tickets_tickets_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CountTicketsIn) String() string {
+func (x *ChangeTicketStatusIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountTicketsIn) ProtoMessage() {}
+func (*ChangeTicketStatusIn) ProtoMessage() {}
 
-func (x *CountTicketsIn) ProtoReflect() protoreflect.Message {
-	mi := &file_tickets_tickets_proto_msgTypes[10]
+func (x *ChangeTicketStatusIn) ProtoReflect() protoreflect.Message {
+	mi := &file_tickets_tickets_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,31 +1184,92 @@ func (x *CountTicketsIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountTicketsIn.ProtoReflect.Descriptor instead.
-func (*CountTicketsIn) Descriptor() ([]byte, []int) {
-	return file_tickets_tickets_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ChangeTicketStatusIn.ProtoReflect.Descriptor instead.
+func (*ChangeTicketStatusIn) Descriptor() ([]byte, []int) {
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CountTicketsIn) GetFilters() *TicketsFilters {
+func (x *ChangeTicketStatusIn) GetID() uint64 {
 	if x != nil {
-		return x.Filters
+		return x.ID
 	}
-	return nil
+	return 0
 }
 
-type CountUserTicketsIn struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserID  uint64          `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+func (x *ChangeTicketStatusIn) GetStatus() TicketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TicketStatus_TICKET_STATUS_UNSPECIFIED
+}
+
+func (x *ChangeTicketStatusIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type CountTicketsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filters *TicketsFilters `protobuf:"bytes,1,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+}
+
+func (x *CountTicketsIn) Reset() {
+	*x = CountTicketsIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tickets_tickets_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountTicketsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountTicketsIn) ProtoMessage() {}
+
+func (x *CountTicketsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_tickets_tickets_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountTicketsIn.ProtoReflect.Descriptor instead.
+func (*CountTicketsIn) Descriptor() ([]byte, []int) {
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CountTicketsIn) GetFilters() *TicketsFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type CountUserTicketsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  uint64          `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	Filters *TicketsFilters `protobuf:"bytes,2,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
 }
 
 func (x *CountUserTicketsIn) Reset() {
 	*x = CountUserTicketsIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tickets_tickets_proto_msgTypes[11]
+		mi := &file_tickets_tickets_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -812,7 +1282,7 @@ func (x *CountUserTicketsIn) String() string {
 func (*CountUserTicketsIn) ProtoMessage() {}
 
 func (x *CountUserTicketsIn) ProtoReflect() protoreflect.Message {
-	mi := &file_tickets_tickets_proto_msgTypes[11]
+	mi := &file_tickets_tickets_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -825,7 +1295,7 @@ func (x *CountUserTicketsIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountUserTicketsIn.ProtoReflect.Descriptor instead.
 func (*CountUserTicketsIn) Descriptor() ([]byte, []int) {
-	return file_tickets_tickets_proto_rawDescGZIP(), []int{11}
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CountUserTicketsIn) GetUserID() uint64 {
@@ -853,7 +1323,7 @@ type CountOut struct {
 func (x *CountOut) Reset() {
 	*x = CountOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tickets_tickets_proto_msgTypes[12]
+		mi := &file_tickets_tickets_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -866,7 +1336,7 @@ func (x *CountOut) String() string {
 func (*CountOut) ProtoMessage() {}
 
 func (x *CountOut) ProtoReflect() protoreflect.Message {
-	mi := &file_tickets_tickets_proto_msgTypes[12]
+	mi := &file_tickets_tickets_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -879,7 +1349,7 @@ func (x *CountOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountOut.ProtoReflect.Descriptor instead.
 func (*CountOut) Descriptor() ([]byte, []int) {
-	return file_tickets_tickets_proto_rawDescGZIP(), []int{12}
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CountOut) GetCount() uint64 {
@@ -896,12 +1366,13 @@ type Pagination struct {
 
 	Limit  *uint64 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
 	Offset *uint64 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Cursor *string `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"` // opaque keyset pagination cursor, can't be combined with offset
 }
 
 func (x *Pagination) Reset() {
 	*x = Pagination{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tickets_tickets_proto_msgTypes[13]
+		mi := &file_tickets_tickets_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -914,7 +1385,7 @@ func (x *Pagination) String() string {
 func (*Pagination) ProtoMessage() {}
 
 func (x *Pagination) ProtoReflect() protoreflect.Message {
-	mi := &file_tickets_tickets_proto_msgTypes[13]
+	mi := &file_tickets_tickets_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -927,7 +1398,7 @@ func (x *Pagination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
 func (*Pagination) Descriptor() ([]byte, []int) {
-	return file_tickets_tickets_proto_rawDescGZIP(), []int{13}
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Pagination) GetLimit() uint64 {
@@ -944,24 +1415,91 @@ func (x *Pagination) GetOffset() uint64 {
 	return 0
 }
 
+func (x *Pagination) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type TicketsSort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field     TicketsSortField `protobuf:"varint,1,opt,name=field,proto3,enum=tickets.TicketsSortField" json:"field,omitempty"`
+	Direction SortDirection    `protobuf:"varint,2,opt,name=direction,proto3,enum=tickets.SortDirection" json:"direction,omitempty"`
+}
+
+func (x *TicketsSort) Reset() {
+	*x = TicketsSort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tickets_tickets_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TicketsSort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketsSort) ProtoMessage() {}
+
+func (x *TicketsSort) ProtoReflect() protoreflect.Message {
+	mi := &file_tickets_tickets_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketsSort.ProtoReflect.Descriptor instead.
+func (*TicketsSort) Descriptor() ([]byte, []int) {
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TicketsSort) GetField() TicketsSortField {
+	if x != nil {
+		return x.Field
+	}
+	return TicketsSortField_TICKETS_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *TicketsSort) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
 type TicketsFilters struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Search              *string  `protobuf:"bytes,1,opt,name=search,proto3,oneof" json:"search,omitempty"`
-	PriceCeil           *float32 `protobuf:"fixed32,2,opt,name=priceCeil,proto3,oneof" json:"priceCeil,omitempty"`        // max price
-	PriceFloor          *float32 `protobuf:"fixed32,3,opt,name=priceFloor,proto3,oneof" json:"priceFloor,omitempty"`      // min price
-	QuantityFloor       *uint32  `protobuf:"varint,4,opt,name=quantityFloor,proto3,oneof" json:"quantityFloor,omitempty"` // min quantity
-	CategoryIDs         []uint32 `protobuf:"varint,5,rep,packed,name=categoryIDs,proto3" json:"categoryIDs,omitempty"`
-	TagIDs              []uint32 `protobuf:"varint,6,rep,packed,name=tagIDs,proto3" json:"tagIDs,omitempty"`
-	CreatedAtOrderByAsc *bool    `protobuf:"varint,7,opt,name=createdAtOrderByAsc,proto3,oneof" json:"createdAtOrderByAsc,omitempty"`
+	Search              *string                `protobuf:"bytes,1,opt,name=search,proto3,oneof" json:"search,omitempty"`
+	PriceCeil           *float32               `protobuf:"fixed32,2,opt,name=priceCeil,proto3,oneof" json:"priceCeil,omitempty"`        // max price
+	PriceFloor          *float32               `protobuf:"fixed32,3,opt,name=priceFloor,proto3,oneof" json:"priceFloor,omitempty"`      // min price
+	QuantityFloor       *uint32                `protobuf:"varint,4,opt,name=quantityFloor,proto3,oneof" json:"quantityFloor,omitempty"` // min quantity
+	CategoryIDs         []uint32               `protobuf:"varint,5,rep,packed,name=categoryIDs,proto3" json:"categoryIDs,omitempty"`
+	TagIDs              []uint32               `protobuf:"varint,6,rep,packed,name=tagIDs,proto3" json:"tagIDs,omitempty"`
+	CreatedAtOrderByAsc *bool                  `protobuf:"varint,7,opt,name=createdAtOrderByAsc,proto3,oneof" json:"createdAtOrderByAsc,omitempty"`
+	Statuses            []TicketStatus         `protobuf:"varint,8,rep,packed,name=statuses,proto3,enum=tickets.TicketStatus" json:"statuses,omitempty"`
+	CreatedAtFrom       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=createdAtFrom,proto3" json:"createdAtFrom,omitempty"` // inclusive
+	CreatedAtTo         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=createdAtTo,proto3" json:"createdAtTo,omitempty"`     // exclusive
+	UpdatedAtFrom       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updatedAtFrom,proto3" json:"updatedAtFrom,omitempty"` // inclusive
+	UpdatedAtTo         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updatedAtTo,proto3" json:"updatedAtTo,omitempty"`     // exclusive
 }
 
 func (x *TicketsFilters) Reset() {
 	*x = TicketsFilters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tickets_tickets_proto_msgTypes[14]
+		mi := &file_tickets_tickets_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -974,7 +1512,7 @@ func (x *TicketsFilters) String() string {
 func (*TicketsFilters) ProtoMessage() {}
 
 func (x *TicketsFilters) ProtoReflect() protoreflect.Message {
-	mi := &file_tickets_tickets_proto_msgTypes[14]
+	mi := &file_tickets_tickets_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -987,7 +1525,7 @@ func (x *TicketsFilters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TicketsFilters.ProtoReflect.Descriptor instead.
 func (*TicketsFilters) Descriptor() ([]byte, []int) {
-	return file_tickets_tickets_proto_rawDescGZIP(), []int{14}
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *TicketsFilters) GetSearch() string {
@@ -1039,6 +1577,152 @@ func (x *TicketsFilters) GetCreatedAtOrderByAsc() bool {
 	return false
 }
 
+func (x *TicketsFilters) GetStatuses() []TicketStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *TicketsFilters) GetCreatedAtFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtFrom
+	}
+	return nil
+}
+
+func (x *TicketsFilters) GetCreatedAtTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTo
+	}
+	return nil
+}
+
+func (x *TicketsFilters) GetUpdatedAtFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAtFrom
+	}
+	return nil
+}
+
+func (x *TicketsFilters) GetUpdatedAtTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAtTo
+	}
+	return nil
+}
+
+type WatchTicketsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filters  *TicketsFilters `protobuf:"bytes,1,opt,name=filters,proto3,oneof" json:"filters,omitempty"` // search is not supported, since it can not be applied to Tickets changes
+	Snapshot bool            `protobuf:"varint,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`    // send the newest matching Tickets before changes
+}
+
+func (x *WatchTicketsIn) Reset() {
+	*x = WatchTicketsIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tickets_tickets_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchTicketsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTicketsIn) ProtoMessage() {}
+
+func (x *WatchTicketsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_tickets_tickets_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTicketsIn.ProtoReflect.Descriptor instead.
+func (*WatchTicketsIn) Descriptor() ([]byte, []int) {
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WatchTicketsIn) GetFilters() *TicketsFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *WatchTicketsIn) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
+type WatchTicketsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type TicketEventType `protobuf:"varint,1,opt,name=type,proto3,enum=tickets.TicketEventType" json:"type,omitempty"`
+	// Updated Ticket is also sent, if it matched filters before update, so it may not match filters anymore.
+	Ticket *GetTicketOut `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (x *WatchTicketsOut) Reset() {
+	*x = WatchTicketsOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tickets_tickets_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchTicketsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTicketsOut) ProtoMessage() {}
+
+func (x *WatchTicketsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_tickets_tickets_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTicketsOut.ProtoReflect.Descriptor instead.
+func (*WatchTicketsOut) Descriptor() ([]byte, []int) {
+	return file_tickets_tickets_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WatchTicketsOut) GetType() TicketEventType {
+	if x != nil {
+		return x.Type
+	}
+	return TicketEventType_TICKET_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchTicketsOut) GetTicket() *GetTicketOut {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
 var File_tickets_tickets_proto protoreflect.FileDescriptor
 
 var file_tickets_tickets_proto_rawDesc = []byte{
@@ -1047,8 +1731,12 @@ var file_tickets_tickets_proto_rawDesc = []byte{
 	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf9,
-	0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49,
+	0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9,
+	0x02, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49,
 	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
@@ -1063,7 +1751,11 @@ var file_tickets_tickets_proto_rawDesc = []byte{
 	0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x61, 0x67, 0x49, 0x44, 0x73, 0x12, 0x20,
 	0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20,
 	0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x2d, 0x0a, 0x0f, 0x43, 0x72,
+	0x12, 0x2b, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
+	0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x2d, 0x0a, 0x0f, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x1a, 0x0a,
 	0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
 	0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x22, 0x1d, 0x0a, 0x0b, 0x47, 0x65, 0x74,
@@ -1080,7 +1772,7 @@ var file_tickets_tickets_proto_rawDesc = []byte{
 	0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x90, 0x03, 0x0a, 0x0c,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd9, 0x03, 0x0a, 0x0c,
 	0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02,
 	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
 	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73,
@@ -1105,136 +1797,300 @@ var file_tickets_tickets_proto_rawDesc = []byte{
 	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x9b,
-	0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x49, 0x6e, 0x12,
-	0x38, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x48, 0x01, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01,
-	0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x40, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x2f, 0x0a,
-	0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x4f, 0x75, 0x74, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0xb7,
-	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48,
-	0x01, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a,
-	0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08,
-	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x20, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x22, 0xba, 0x02, 0x0a, 0x0e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x17, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x48, 0x02, 0x52, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x08, 0x71, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52,
-	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x16,
-	0x0a, 0x06, 0x74, 0x61, 0x67, 0x49, 0x44, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06,
-	0x74, 0x61, 0x67, 0x49, 0x44, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74,
-	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x22, 0x54, 0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x49, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01,
-	0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x70, 0x0a,
-	0x12, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x07, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x49, 0x6e, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x01, 0x52, 0x07,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x04, 0x73, 0x6f,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04,
+	0x73, 0x6f, 0x72, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22,
+	0x74, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x4f, 0x75, 0x74,
+	0x12, 0x2f, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x12, 0x23, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x25, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x49, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x65, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x4f,
+	0x75, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x44,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x49, 0x44, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x38, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x48, 0x01, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x28, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x38, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x22, 0xd1, 0x03, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x02, 0x48, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x1f, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x23, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x67, 0x49, 0x44, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x61, 0x67, 0x49, 0x44, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x05, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x73, 0x6b, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49,
+	0x44, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6d, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2d, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x54, 0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x49, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x70, 0x0a, 0x12, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x49, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x20, 0x0a, 0x08,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x81,
+	0x01, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x88,
+	0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0x74, 0x0a, 0x0b, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x6f, 0x72,
+	0x74, 0x12, 0x2f, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x34, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xae, 0x05, 0x0a, 0x0e, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x43, 0x65, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x09, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x48,
+	0x02, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x29, 0x0a, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x6c, 0x6f, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x61, 0x67, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x74,
+	0x61, 0x67, 0x49, 0x44, 0x73, 0x12, 0x35, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73, 0x63, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x04, 0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73, 0x63, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x08,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12,
+	0x40, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x46, 0x72, 0x6f, 0x6d,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x54, 0x6f,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x54, 0x6f, 0x12,
+	0x40, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x46, 0x72, 0x6f, 0x6d,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x3c, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x54, 0x6f,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x54, 0x6f, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73,
+	0x63, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x52, 0x10, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79,
+	0x52, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x70, 0x0a, 0x0e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x49, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74,
 	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x69,
 	0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22,
-	0x20, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x59, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00,
-	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xe3, 0x02, 0x0a,
-	0x0e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12,
-	0x1b, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48,
-	0x01, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12,
-	0x23, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x02, 0x48, 0x02, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f,
-	0x72, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x0d, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12,
-	0x20, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x67, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0d, 0x52, 0x06, 0x74, 0x61, 0x67, 0x49, 0x44, 0x73, 0x12, 0x35, 0x0a, 0x13, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73, 0x63,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73, 0x63, 0x88, 0x01, 0x01,
-	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x71, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41,
-	0x73, 0x63, 0x32, 0xa1, 0x04, 0x0a, 0x0e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x1a, 0x18,
-	0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x47, 0x65,
-	0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x1a, 0x15, 0x2e,
+	0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x6e, 0x0a, 0x0f, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x2c,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x06,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x4f, 0x75, 0x74, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2a, 0x9e, 0x01, 0x0a, 0x0c,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19,
+	0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x54,
+	0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45,
+	0x4e, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53,
+	0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x1b, 0x0a, 0x17, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xfe, 0x01, 0x0a,
+	0x10, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x53, 0x4f, 0x52,
+	0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x53,
+	0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x50, 0x52, 0x49, 0x43,
+	0x45, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x53,
+	0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x51, 0x55, 0x41, 0x4e, 0x54, 0x49,
+	0x54, 0x59, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f,
+	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x49, 0x43, 0x4b, 0x45,
+	0x54, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x52, 0x45,
+	0x53, 0x50, 0x4f, 0x4e, 0x44, 0x53, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x04, 0x12, 0x1b,
+	0x0a, 0x17, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x05, 0x12, 0x20, 0x0a, 0x1c, 0x54,
+	0x49, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c,
+	0x44, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x56, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x06, 0x2a, 0xb1, 0x01,
+	0x0a, 0x0f, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48,
+	0x4f, 0x54, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10,
+	0x04, 0x2a, 0x60, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4f,
+	0x52, 0x54, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x53,
+	0x43, 0x10, 0x02, 0x32, 0xd6, 0x08, 0x0a, 0x0e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x1a,
+	0x18, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x10, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x3a, 0x01,
+	0x2a, 0x12, 0x52, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14,
+	0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x49, 0x6e, 0x1a, 0x15, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x18, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x12, 0x68, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x12, 0x1a, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x49,
+	0x44, 0x73, 0x49, 0x6e, 0x1a, 0x1b, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x4f, 0x75,
+	0x74, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x12,
+	0x5a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x15, 0x2e,
 	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x74, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x49, 0x6e, 0x1a, 0x11,
-	0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75,
-	0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x49, 0x6e,
-	0x1a, 0x16, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x10, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1b,
-	0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x74, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00,
-	0x12, 0x41, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x12, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x4b, 0x68, 0x6f, 0x72, 0x6b, 0x6f, 0x76, 0x2f, 0x68, 0x6d,
-	0x74, 0x6d, 0x2d, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x3b,
-	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x73, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x1d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x3a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x12, 0x58, 0x0a, 0x0c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22,
+	0x11, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x3a, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x71, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x3a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x6f, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x2b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x3a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x59, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x12, 0x2a, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f,
+	0x7b, 0x49, 0x44, 0x7d, 0x12, 0x5c, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x32, 0x10, 0x2f,
+	0x76, 0x31, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x3a,
+	0x01, 0x2a, 0x12, 0x75, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x3a, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x61, 0x0a, 0x0c, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x1c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x3a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x42, 0x3f, 0x5a, 0x3d,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x4b, 0x68, 0x6f, 0x72,
+	0x6b, 0x6f, 0x76, 0x2f, 0x68, 0x6d, 0x74, 0x6d, 0x2d, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x3b, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1249,60 +2105,94 @@ func file_tickets_tickets_proto_rawDescGZIP() []byte {
 	return file_tickets_tickets_proto_rawDescData
 }
 
-var file_tickets_tickets_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_tickets_tickets_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_tickets_tickets_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_tickets_tickets_proto_goTypes = []interface{}{
-	(*CreateTicketIn)(nil),        // 0: tickets.CreateTicketIn
-	(*CreateTicketOut)(nil),       // 1: tickets.CreateTicketOut
-	(*GetTicketIn)(nil),           // 2: tickets.GetTicketIn
-	(*Attachment)(nil),            // 3: tickets.Attachment
-	(*GetTicketOut)(nil),          // 4: tickets.GetTicketOut
-	(*GetTicketsIn)(nil),          // 5: tickets.GetTicketsIn
-	(*GetTicketsOut)(nil),         // 6: tickets.GetTicketsOut
-	(*GetUserTicketsIn)(nil),      // 7: tickets.GetUserTicketsIn
-	(*DeleteTicketIn)(nil),        // 8: tickets.DeleteTicketIn
-	(*UpdateTicketIn)(nil),        // 9: tickets.UpdateTicketIn
-	(*CountTicketsIn)(nil),        // 10: tickets.CountTicketsIn
-	(*CountUserTicketsIn)(nil),    // 11: tickets.CountUserTicketsIn
-	(*CountOut)(nil),              // 12: tickets.CountOut
-	(*Pagination)(nil),            // 13: tickets.Pagination
-	(*TicketsFilters)(nil),        // 14: tickets.TicketsFilters
-	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 16: google.protobuf.Empty
+	(TicketStatus)(0),             // 0: tickets.TicketStatus
+	(TicketsSortField)(0),         // 1: tickets.TicketsSortField
+	(TicketEventType)(0),          // 2: tickets.TicketEventType
+	(SortDirection)(0),            // 3: tickets.SortDirection
+	(*CreateTicketIn)(nil),        // 4: tickets.CreateTicketIn
+	(*CreateTicketOut)(nil),       // 5: tickets.CreateTicketOut
+	(*GetTicketIn)(nil),           // 6: tickets.GetTicketIn
+	(*Attachment)(nil),            // 7: tickets.Attachment
+	(*GetTicketOut)(nil),          // 8: tickets.GetTicketOut
+	(*GetTicketsIn)(nil),          // 9: tickets.GetTicketsIn
+	(*GetTicketsOut)(nil),         // 10: tickets.GetTicketsOut
+	(*GetTicketsByIDsIn)(nil),     // 11: tickets.GetTicketsByIDsIn
+	(*GetTicketsByIDsOut)(nil),    // 12: tickets.GetTicketsByIDsOut
+	(*GetUserTicketsIn)(nil),      // 13: tickets.GetUserTicketsIn
+	(*DeleteTicketIn)(nil),        // 14: tickets.DeleteTicketIn
+	(*UpdateTicketIn)(nil),        // 15: tickets.UpdateTicketIn
+	(*ChangeTicketStatusIn)(nil),  // 16: tickets.ChangeTicketStatusIn
+	(*CountTicketsIn)(nil),        // 17: tickets.CountTicketsIn
+	(*CountUserTicketsIn)(nil),    // 18: tickets.CountUserTicketsIn
+	(*CountOut)(nil),              // 19: tickets.CountOut
+	(*Pagination)(nil),            // 20: tickets.Pagination
+	(*TicketsSort)(nil),           // 21: tickets.TicketsSort
+	(*TicketsFilters)(nil),        // 22: tickets.TicketsFilters
+	(*WatchTicketsIn)(nil),        // 23: tickets.WatchTicketsIn
+	(*WatchTicketsOut)(nil),       // 24: tickets.WatchTicketsOut
+	(*timestamppb.Timestamp)(nil), // 25: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 26: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 27: google.protobuf.Empty
 }
 var file_tickets_tickets_proto_depIdxs = []int32{
-	15, // 0: tickets.Attachment.createdAt:type_name -> google.protobuf.Timestamp
-	15, // 1: tickets.Attachment.updatedAt:type_name -> google.protobuf.Timestamp
-	3,  // 2: tickets.GetTicketOut.attachments:type_name -> tickets.Attachment
-	15, // 3: tickets.GetTicketOut.createdAt:type_name -> google.protobuf.Timestamp
-	15, // 4: tickets.GetTicketOut.updatedAt:type_name -> google.protobuf.Timestamp
-	13, // 5: tickets.GetTicketsIn.pagination:type_name -> tickets.Pagination
-	14, // 6: tickets.GetTicketsIn.filters:type_name -> tickets.TicketsFilters
-	4,  // 7: tickets.GetTicketsOut.tickets:type_name -> tickets.GetTicketOut
-	13, // 8: tickets.GetUserTicketsIn.pagination:type_name -> tickets.Pagination
-	14, // 9: tickets.GetUserTicketsIn.filters:type_name -> tickets.TicketsFilters
-	14, // 10: tickets.CountTicketsIn.filters:type_name -> tickets.TicketsFilters
-	14, // 11: tickets.CountUserTicketsIn.filters:type_name -> tickets.TicketsFilters
-	0,  // 12: tickets.TicketsService.CreateTicket:input_type -> tickets.CreateTicketIn
-	2,  // 13: tickets.TicketsService.GetTicket:input_type -> tickets.GetTicketIn
-	5,  // 14: tickets.TicketsService.GetTickets:input_type -> tickets.GetTicketsIn
-	10, // 15: tickets.TicketsService.CountTickets:input_type -> tickets.CountTicketsIn
-	7,  // 16: tickets.TicketsService.GetUserTickets:input_type -> tickets.GetUserTicketsIn
-	11, // 17: tickets.TicketsService.CountUserTickets:input_type -> tickets.CountUserTicketsIn
-	8,  // 18: tickets.TicketsService.DeleteTicket:input_type -> tickets.DeleteTicketIn
-	9,  // 19: tickets.TicketsService.UpdateTicket:input_type -> tickets.UpdateTicketIn
-	1,  // 20: tickets.TicketsService.CreateTicket:output_type -> tickets.CreateTicketOut
-	4,  // 21: tickets.TicketsService.GetTicket:output_type -> tickets.GetTicketOut
-	6,  // 22: tickets.TicketsService.GetTickets:output_type -> tickets.GetTicketsOut
-	12, // 23: tickets.TicketsService.CountTickets:output_type -> tickets.CountOut
-	6,  // 24: tickets.TicketsService.GetUserTickets:output_type -> tickets.GetTicketsOut
-	12, // 25: tickets.TicketsService.CountUserTickets:output_type -> tickets.CountOut
-	16, // 26: tickets.TicketsService.DeleteTicket:output_type -> google.protobuf.Empty
-	16, // 27: tickets.TicketsService.UpdateTicket:output_type -> google.protobuf.Empty
-	20, // [20:28] is the sub-list for method output_type
-	12, // [12:20] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	25, // 0: tickets.Attachment.createdAt:type_name -> google.protobuf.Timestamp
+	25, // 1: tickets.Attachment.updatedAt:type_name -> google.protobuf.Timestamp
+	7,  // 2: tickets.GetTicketOut.attachments:type_name -> tickets.Attachment
+	25, // 3: tickets.GetTicketOut.createdAt:type_name -> google.protobuf.Timestamp
+	25, // 4: tickets.GetTicketOut.updatedAt:type_name -> google.protobuf.Timestamp
+	0,  // 5: tickets.GetTicketOut.status:type_name -> tickets.TicketStatus
+	20, // 6: tickets.GetTicketsIn.pagination:type_name -> tickets.Pagination
+	22, // 7: tickets.GetTicketsIn.filters:type_name -> tickets.TicketsFilters
+	21, // 8: tickets.GetTicketsIn.sort:type_name -> tickets.TicketsSort
+	8,  // 9: tickets.GetTicketsOut.tickets:type_name -> tickets.GetTicketOut
+	8,  // 10: tickets.GetTicketsByIDsOut.tickets:type_name -> tickets.GetTicketOut
+	20, // 11: tickets.GetUserTicketsIn.pagination:type_name -> tickets.Pagination
+	22, // 12: tickets.GetUserTicketsIn.filters:type_name -> tickets.TicketsFilters
+	21, // 13: tickets.GetUserTicketsIn.sort:type_name -> tickets.TicketsSort
+	26, // 14: tickets.UpdateTicketIn.updateMask:type_name -> google.protobuf.FieldMask
+	0,  // 15: tickets.ChangeTicketStatusIn.status:type_name -> tickets.TicketStatus
+	22, // 16: tickets.CountTicketsIn.filters:type_name -> tickets.TicketsFilters
+	22, // 17: tickets.CountUserTicketsIn.filters:type_name -> tickets.TicketsFilters
+	1,  // 18: tickets.TicketsSort.field:type_name -> tickets.TicketsSortField
+	3,  // 19: tickets.TicketsSort.direction:type_name -> tickets.SortDirection
+	0,  // 20: tickets.TicketsFilters.statuses:type_name -> tickets.TicketStatus
+	25, // 21: tickets.TicketsFilters.createdAtFrom:type_name -> google.protobuf.Timestamp
+	25, // 22: tickets.TicketsFilters.createdAtTo:type_name -> google.protobuf.Timestamp
+	25, // 23: tickets.TicketsFilters.updatedAtFrom:type_name -> google.protobuf.Timestamp
+	25, // 24: tickets.TicketsFilters.updatedAtTo:type_name -> google.protobuf.Timestamp
+	22, // 25: tickets.WatchTicketsIn.filters:type_name -> tickets.TicketsFilters
+	2,  // 26: tickets.WatchTicketsOut.type:type_name -> tickets.TicketEventType
+	8,  // 27: tickets.WatchTicketsOut.ticket:type_name -> tickets.GetTicketOut
+	4,  // 28: tickets.TicketsService.CreateTicket:input_type -> tickets.CreateTicketIn
+	6,  // 29: tickets.TicketsService.GetTicket:input_type -> tickets.GetTicketIn
+	11, // 30: tickets.TicketsService.GetTicketsByIDs:input_type -> tickets.GetTicketsByIDsIn
+	9,  // 31: tickets.TicketsService.GetTickets:input_type -> tickets.GetTicketsIn
+	17, // 32: tickets.TicketsService.CountTickets:input_type -> tickets.CountTicketsIn
+	13, // 33: tickets.TicketsService.GetUserTickets:input_type -> tickets.GetUserTicketsIn
+	18, // 34: tickets.TicketsService.CountUserTickets:input_type -> tickets.CountUserTicketsIn
+	14, // 35: tickets.TicketsService.DeleteTicket:input_type -> tickets.DeleteTicketIn
+	15, // 36: tickets.TicketsService.UpdateTicket:input_type -> tickets.UpdateTicketIn
+	16, // 37: tickets.TicketsService.ChangeTicketStatus:input_type -> tickets.ChangeTicketStatusIn
+	23, // 38: tickets.TicketsService.WatchTickets:input_type -> tickets.WatchTicketsIn
+	5,  // 39: tickets.TicketsService.CreateTicket:output_type -> tickets.CreateTicketOut
+	8,  // 40: tickets.TicketsService.GetTicket:output_type -> tickets.GetTicketOut
+	12, // 41: tickets.TicketsService.GetTicketsByIDs:output_type -> tickets.GetTicketsByIDsOut
+	10, // 42: tickets.TicketsService.GetTickets:output_type -> tickets.GetTicketsOut
+	19, // 43: tickets.TicketsService.CountTickets:output_type -> tickets.CountOut
+	10, // 44: tickets.TicketsService.GetUserTickets:output_type -> tickets.GetTicketsOut
+	19, // 45: tickets.TicketsService.CountUserTickets:output_type -> tickets.CountOut
+	27, // 46: tickets.TicketsService.DeleteTicket:output_type -> google.protobuf.Empty
+	27, // 47: tickets.TicketsService.UpdateTicket:output_type -> google.protobuf.Empty
+	27, // 48: tickets.TicketsService.ChangeTicketStatus:output_type -> google.protobuf.Empty
+	24, // 49: tickets.TicketsService.WatchTickets:output_type -> tickets.WatchTicketsOut
+	39, // [39:50] is the sub-list for method output_type
+	28, // [28:39] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_tickets_tickets_proto_init() }
@@ -1396,7 +2286,7 @@ func file_tickets_tickets_proto_init() {
 			}
 		}
 		file_tickets_tickets_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserTicketsIn); i {
+			switch v := v.(*GetTicketsByIDsIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1408,7 +2298,7 @@ func file_tickets_tickets_proto_init() {
 			}
 		}
 		file_tickets_tickets_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTicketIn); i {
+			switch v := v.(*GetTicketsByIDsOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1420,7 +2310,7 @@ func file_tickets_tickets_proto_init() {
 			}
 		}
 		file_tickets_tickets_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTicketIn); i {
+			switch v := v.(*GetUserTicketsIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1432,7 +2322,7 @@ func file_tickets_tickets_proto_init() {
 			}
 		}
 		file_tickets_tickets_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountTicketsIn); i {
+			switch v := v.(*DeleteTicketIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1444,7 +2334,7 @@ func file_tickets_tickets_proto_init() {
 			}
 		}
 		file_tickets_tickets_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountUserTicketsIn); i {
+			switch v := v.(*UpdateTicketIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1456,7 +2346,7 @@ func file_tickets_tickets_proto_init() {
 			}
 		}
 		file_tickets_tickets_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountOut); i {
+			switch v := v.(*ChangeTicketStatusIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1468,7 +2358,7 @@ func file_tickets_tickets_proto_init() {
 			}
 		}
 		file_tickets_tickets_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pagination); i {
+			switch v := v.(*CountTicketsIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1480,6 +2370,54 @@ func file_tickets_tickets_proto_init() {
 			}
 		}
 		file_tickets_tickets_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountUserTicketsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tickets_tickets_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tickets_tickets_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pagination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tickets_tickets_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TicketsSort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tickets_tickets_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TicketsFilters); i {
 			case 0:
 				return &v.state
@@ -1491,28 +2429,55 @@ func file_tickets_tickets_proto_init() {
 				return nil
 			}
 		}
+		file_tickets_tickets_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchTicketsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tickets_tickets_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchTicketsOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_tickets_tickets_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_tickets_tickets_proto_msgTypes[4].OneofWrappers = []interface{}{}
 	file_tickets_tickets_proto_msgTypes[5].OneofWrappers = []interface{}{}
-	file_tickets_tickets_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_tickets_tickets_proto_msgTypes[6].OneofWrappers = []interface{}{}
 	file_tickets_tickets_proto_msgTypes[9].OneofWrappers = []interface{}{}
-	file_tickets_tickets_proto_msgTypes[10].OneofWrappers = []interface{}{}
 	file_tickets_tickets_proto_msgTypes[11].OneofWrappers = []interface{}{}
 	file_tickets_tickets_proto_msgTypes[13].OneofWrappers = []interface{}{}
 	file_tickets_tickets_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_tickets_tickets_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_tickets_tickets_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_tickets_tickets_proto_msgTypes[19].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tickets_tickets_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   15,
+			NumEnums:      4,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_tickets_tickets_proto_goTypes,
 		DependencyIndexes: file_tickets_tickets_proto_depIdxs,
+		EnumInfos:         file_tickets_tickets_proto_enumTypes,
 		MessageInfos:      file_tickets_tickets_proto_msgTypes,
 	}.Build()
 	File_tickets_tickets_proto = out.File
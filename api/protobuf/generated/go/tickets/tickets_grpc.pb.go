@@ -21,12 +21,17 @@ const _ = grpc.SupportPackageIsVersion7
 type TicketsServiceClient interface {
 	CreateTicket(ctx context.Context, in *CreateTicketIn, opts ...grpc.CallOption) (*CreateTicketOut, error)
 	GetTicket(ctx context.Context, in *GetTicketIn, opts ...grpc.CallOption) (*GetTicketOut, error)
+	GetTicketsByIDs(ctx context.Context, in *GetTicketsByIDsIn, opts ...grpc.CallOption) (*GetTicketsByIDsOut, error)
 	GetTickets(ctx context.Context, in *GetTicketsIn, opts ...grpc.CallOption) (*GetTicketsOut, error)
 	CountTickets(ctx context.Context, in *CountTicketsIn, opts ...grpc.CallOption) (*CountOut, error)
 	GetUserTickets(ctx context.Context, in *GetUserTicketsIn, opts ...grpc.CallOption) (*GetTicketsOut, error)
 	CountUserTickets(ctx context.Context, in *CountUserTicketsIn, opts ...grpc.CallOption) (*CountOut, error)
 	DeleteTicket(ctx context.Context, in *DeleteTicketIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateTicket(ctx context.Context, in *UpdateTicketIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ChangeTicketStatus(ctx context.Context, in *ChangeTicketStatusIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Streams Tickets, matching filters: snapshot of the newest Tickets, if requested, and then Tickets changes.
+	// Only changes, made through the same service instance, are streamed.
+	WatchTickets(ctx context.Context, in *WatchTicketsIn, opts ...grpc.CallOption) (TicketsService_WatchTicketsClient, error)
 }
 
 type ticketsServiceClient struct {
@@ -55,6 +60,15 @@ func (c *ticketsServiceClient) GetTicket(ctx context.Context, in *GetTicketIn, o
 	return out, nil
 }
 
+func (c *ticketsServiceClient) GetTicketsByIDs(ctx context.Context, in *GetTicketsByIDsIn, opts ...grpc.CallOption) (*GetTicketsByIDsOut, error) {
+	out := new(GetTicketsByIDsOut)
+	err := c.cc.Invoke(ctx, "/tickets.TicketsService/GetTicketsByIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ticketsServiceClient) GetTickets(ctx context.Context, in *GetTicketsIn, opts ...grpc.CallOption) (*GetTicketsOut, error) {
 	out := new(GetTicketsOut)
 	err := c.cc.Invoke(ctx, "/tickets.TicketsService/GetTickets", in, out, opts...)
@@ -109,18 +123,64 @@ func (c *ticketsServiceClient) UpdateTicket(ctx context.Context, in *UpdateTicke
 	return out, nil
 }
 
+func (c *ticketsServiceClient) ChangeTicketStatus(ctx context.Context, in *ChangeTicketStatusIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/tickets.TicketsService/ChangeTicketStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketsServiceClient) WatchTickets(ctx context.Context, in *WatchTicketsIn, opts ...grpc.CallOption) (TicketsService_WatchTicketsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TicketsService_ServiceDesc.Streams[0], "/tickets.TicketsService/WatchTickets", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ticketsServiceWatchTicketsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TicketsService_WatchTicketsClient interface {
+	Recv() (*WatchTicketsOut, error)
+	grpc.ClientStream
+}
+
+type ticketsServiceWatchTicketsClient struct {
+	grpc.ClientStream
+}
+
+func (x *ticketsServiceWatchTicketsClient) Recv() (*WatchTicketsOut, error) {
+	m := new(WatchTicketsOut)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // TicketsServiceServer is the server API for TicketsService service.
 // All implementations must embed UnimplementedTicketsServiceServer
 // for forward compatibility
 type TicketsServiceServer interface {
 	CreateTicket(context.Context, *CreateTicketIn) (*CreateTicketOut, error)
 	GetTicket(context.Context, *GetTicketIn) (*GetTicketOut, error)
+	GetTicketsByIDs(context.Context, *GetTicketsByIDsIn) (*GetTicketsByIDsOut, error)
 	GetTickets(context.Context, *GetTicketsIn) (*GetTicketsOut, error)
 	CountTickets(context.Context, *CountTicketsIn) (*CountOut, error)
 	GetUserTickets(context.Context, *GetUserTicketsIn) (*GetTicketsOut, error)
 	CountUserTickets(context.Context, *CountUserTicketsIn) (*CountOut, error)
 	DeleteTicket(context.Context, *DeleteTicketIn) (*emptypb.Empty, error)
 	UpdateTicket(context.Context, *UpdateTicketIn) (*emptypb.Empty, error)
+	ChangeTicketStatus(context.Context, *ChangeTicketStatusIn) (*emptypb.Empty, error)
+	// Streams Tickets, matching filters: snapshot of the newest Tickets, if requested, and then Tickets changes.
+	// Only changes, made through the same service instance, are streamed.
+	WatchTickets(*WatchTicketsIn, TicketsService_WatchTicketsServer) error
 	mustEmbedUnimplementedTicketsServiceServer()
 }
 
@@ -134,6 +194,9 @@ func (UnimplementedTicketsServiceServer) CreateTicket(context.Context, *CreateTi
 func (UnimplementedTicketsServiceServer) GetTicket(context.Context, *GetTicketIn) (*GetTicketOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTicket not implemented")
 }
+func (UnimplementedTicketsServiceServer) GetTicketsByIDs(context.Context, *GetTicketsByIDsIn) (*GetTicketsByIDsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicketsByIDs not implemented")
+}
 func (UnimplementedTicketsServiceServer) GetTickets(context.Context, *GetTicketsIn) (*GetTicketsOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTickets not implemented")
 }
@@ -152,6 +215,12 @@ func (UnimplementedTicketsServiceServer) DeleteTicket(context.Context, *DeleteTi
 func (UnimplementedTicketsServiceServer) UpdateTicket(context.Context, *UpdateTicketIn) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTicket not implemented")
 }
+func (UnimplementedTicketsServiceServer) ChangeTicketStatus(context.Context, *ChangeTicketStatusIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeTicketStatus not implemented")
+}
+func (UnimplementedTicketsServiceServer) WatchTickets(*WatchTicketsIn, TicketsService_WatchTicketsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTickets not implemented")
+}
 func (UnimplementedTicketsServiceServer) mustEmbedUnimplementedTicketsServiceServer() {}
 
 // UnsafeTicketsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -201,6 +270,24 @@ func _TicketsService_GetTicket_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TicketsService_GetTicketsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketsByIDsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketsServiceServer).GetTicketsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tickets.TicketsService/GetTicketsByIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketsServiceServer).GetTicketsByIDs(ctx, req.(*GetTicketsByIDsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TicketsService_GetTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTicketsIn)
 	if err := dec(in); err != nil {
@@ -309,6 +396,45 @@ func _TicketsService_UpdateTicket_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TicketsService_ChangeTicketStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeTicketStatusIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketsServiceServer).ChangeTicketStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tickets.TicketsService/ChangeTicketStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketsServiceServer).ChangeTicketStatus(ctx, req.(*ChangeTicketStatusIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketsService_WatchTickets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTicketsIn)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TicketsServiceServer).WatchTickets(m, &ticketsServiceWatchTicketsServer{stream})
+}
+
+type TicketsService_WatchTicketsServer interface {
+	Send(*WatchTicketsOut) error
+	grpc.ServerStream
+}
+
+type ticketsServiceWatchTicketsServer struct {
+	grpc.ServerStream
+}
+
+func (x *ticketsServiceWatchTicketsServer) Send(m *WatchTicketsOut) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // TicketsService_ServiceDesc is the grpc.ServiceDesc for TicketsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -324,6 +450,10 @@ var TicketsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTicket",
 			Handler:    _TicketsService_GetTicket_Handler,
 		},
+		{
+			MethodName: "GetTicketsByIDs",
+			Handler:    _TicketsService_GetTicketsByIDs_Handler,
+		},
 		{
 			MethodName: "GetTickets",
 			Handler:    _TicketsService_GetTickets_Handler,
@@ -348,7 +478,17 @@ var TicketsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateTicket",
 			Handler:    _TicketsService_UpdateTicket_Handler,
 		},
+		{
+			MethodName: "ChangeTicketStatus",
+			Handler:    _TicketsService_ChangeTicketStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTickets",
+			Handler:       _TicketsService_WatchTickets_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "tickets/tickets.proto",
 }
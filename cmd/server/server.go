@@ -2,11 +2,15 @@ package main
 
 import (
 	"context"
+	"maps"
+	"slices"
 
 	"github.com/DKhorkov/libs/db"
 	"github.com/DKhorkov/libs/logging"
 	"github.com/DKhorkov/libs/tracing"
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	grpchealth "google.golang.org/grpc/health"
 
 	customnats "github.com/DKhorkov/libs/nats"
 
@@ -14,6 +18,14 @@ import (
 	toysgrpcclient "github.com/DKhorkov/hmtm-tickets/internal/clients/toys/grpc"
 	"github.com/DKhorkov/hmtm-tickets/internal/config"
 	grpccontroller "github.com/DKhorkov/hmtm-tickets/internal/controllers/grpc"
+	httpcontroller "github.com/DKhorkov/hmtm-tickets/internal/controllers/http"
+	natscontroller "github.com/DKhorkov/hmtm-tickets/internal/controllers/nats"
+	"github.com/DKhorkov/hmtm-tickets/internal/cursor"
+	"github.com/DKhorkov/hmtm-tickets/internal/eventbus"
+	"github.com/DKhorkov/hmtm-tickets/internal/health"
+	"github.com/DKhorkov/hmtm-tickets/internal/interfaces"
+	"github.com/DKhorkov/hmtm-tickets/internal/outbox"
+	"github.com/DKhorkov/hmtm-tickets/internal/publishers"
 	"github.com/DKhorkov/hmtm-tickets/internal/repositories"
 	"github.com/DKhorkov/hmtm-tickets/internal/services"
 	"github.com/DKhorkov/hmtm-tickets/internal/usecases"
@@ -21,6 +33,10 @@ import (
 
 func main() {
 	settings := config.New()
+	if err := settings.Validate(); err != nil {
+		panic(err)
+	}
+
 	logger := logging.New(
 		settings.Logging.Level,
 		settings.Logging.LogFilePath,
@@ -50,14 +66,6 @@ func main() {
 		panic(err)
 	}
 
-	natsPublisher, err := customnats.NewPublisher(
-		settings.NATS.ClientURL,
-		nats.Name(settings.NATS.Publisher.Name),
-	)
-	if err != nil {
-		panic(err)
-	}
-
 	defer func() {
 		if err = traceProvider.Shutdown(context.Background()); err != nil {
 			logging.LogError(logger, "Error shutting down tracer", err)
@@ -88,6 +96,8 @@ func main() {
 		logger,
 		traceProvider,
 		settings.Tracing.Spans.Repositories.Tickets,
+		settings.Search,
+		settings.Idempotency,
 	)
 
 	ticketsService := services.NewTicketsService(
@@ -100,6 +110,7 @@ func main() {
 		logger,
 		traceProvider,
 		settings.Tracing.Spans.Repositories.Responds,
+		settings.Idempotency,
 	)
 
 	respondsService := services.NewRespondsService(
@@ -107,24 +118,203 @@ func main() {
 		logger,
 	)
 
+	outboxRepository := repositories.NewOutboxRepository(
+		dbConnector,
+		logger,
+		traceProvider,
+		settings.Tracing.Spans.Repositories.Outbox,
+	)
+
+	outboxService := services.NewOutboxService(
+		outboxRepository,
+		logger,
+	)
+
+	idempotencyKeysRepository := repositories.NewIdempotencyKeysRepository(
+		dbConnector,
+		logger,
+		traceProvider,
+		settings.Tracing.Spans.Repositories.IdempotencyKeys,
+	)
+
+	idempotencyKeysService := services.NewIdempotencyKeysService(
+		idempotencyKeysRepository,
+		logger,
+	)
+
+	natsConnection, err := nats.Connect(
+		settings.NATS.ClientURL,
+		nats.Name(settings.NATS.Publisher.Name),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Connection is closed after Relay and health Monitor have been stopped due to defer LIFO order.
+	// Outbox messages are published via this connection, so health Monitor checks the connection in use:
+	defer natsConnection.Close()
+
+	// JetStream is optional, so it is used only, if enabled:
+	var js jetstream.JetStream
+	if settings.NATS.JetStream.Enabled {
+		if js, err = jetstream.New(natsConnection); err != nil {
+			panic(err)
+		}
+	}
+
+	var outboxPublisher interfaces.OutboxPublisher = publishers.NewCorePublisher(natsConnection)
+	if settings.NATS.JetStream.Enabled {
+		outboxPublisher, err = publishers.NewJetStreamPublisher(
+			context.Background(),
+			js,
+			settings.NATS.JetStream,
+			[]string{
+				settings.NATS.Subjects.TicketCreated,
+				settings.NATS.Subjects.TicketUpdated,
+				settings.NATS.Subjects.TicketDeleted,
+				settings.NATS.Subjects.RespondAccepted,
+				settings.NATS.Subjects.RespondRejected,
+				settings.NATS.Subjects.RespondCreated,
+				settings.NATS.Subjects.RespondUpdated,
+				settings.NATS.Subjects.RespondDeleted,
+				settings.NATS.Subjects.UserDataDeleted,
+			},
+		)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	outboxRelay := outbox.New(
+		outboxService,
+		idempotencyKeysService,
+		outboxPublisher,
+		settings.Outbox,
+		logger,
+	)
+
+	// Relay is stopped before closing db connections pool due to defer LIFO order:
+	go outboxRelay.Run()
+	defer outboxRelay.Stop()
+
+	// Bus is in-process, so watch streams receive only changes, made through this service instance:
+	ticketEventsBus := eventbus.New(settings.Watch)
+
 	useCases := usecases.New(
 		ticketsService,
 		respondsService,
 		toysService,
-		natsPublisher,
+		ticketEventsBus,
 		settings.NATS,
+		settings.Watch,
 		logger,
 	)
 
+	natsHandlers := natscontroller.NewHandlers(
+		useCases,
+		settings.NATS.Subscriber.FallbackCategoryID,
+		logger,
+	)
+
+	subscriptions := map[string]natscontroller.MessageHandler{
+		settings.NATS.Subscriber.Subjects.CategoryDeleted: natsHandlers.HandleCategoryDeleted,
+		settings.NATS.Subscriber.Subjects.TagDeleted:      natsHandlers.HandleTagDeleted,
+		settings.NATS.Subscriber.Subjects.MasterDeleted:   natsHandlers.HandleMasterDeleted,
+		settings.NATS.Subscriber.Subjects.UserDeleted:     natsHandlers.HandleUserDeleted,
+	}
+
+	natsWorkers := make([]customnats.Worker, 0, len(subscriptions))
+
+	// In JetStream mode deletion events are stored in stream and are processed via durable consumers until
+	// success. Workers of both modes use the same connection as publisher, so they are covered by health check:
+	if settings.NATS.JetStream.Enabled {
+		if err = natscontroller.CreateStream(
+			context.Background(),
+			js,
+			settings.NATS.Subscriber,
+			slices.Collect(maps.Keys(subscriptions)),
+		); err != nil {
+			panic(err)
+		}
+
+		for subject, handler := range subscriptions {
+			natsConsumer, err := natscontroller.NewConsumer(
+				context.Background(),
+				js,
+				settings.NATS.Subscriber,
+				subject,
+				handler,
+				logger,
+			)
+			if err != nil {
+				panic(err)
+			}
+
+			natsWorkers = append(natsWorkers, natsConsumer)
+		}
+	} else {
+		for subject, handler := range subscriptions {
+			natsWorkers = append(
+				natsWorkers,
+				natscontroller.NewQueueSubscriber(
+					natsConnection,
+					settings.NATS.Subscriber,
+					subject,
+					handler,
+					logger,
+				),
+			)
+		}
+	}
+
+	natsController := natscontroller.New(logger, natsWorkers...)
+	if err = natsController.Run(); err != nil {
+		panic(err)
+	}
+
+	// Workers are stopped before closing db connections pool due to defer LIFO order:
+	defer natsController.Stop()
+
+	healthServer := grpchealth.NewServer()
+	healthMonitor := health.New(
+		healthServer,
+		[]health.Check{
+			health.Database(dbConnector),
+			health.NATS(natsConnection),
+			{Name: "Toys client", Check: toysClient.Ping},
+		},
+		settings.Health,
+		logger,
+	)
+
+	// Monitor is stopped before closing db connections pool due to defer LIFO order:
+	go healthMonitor.Run()
+	defer healthMonitor.Stop()
+
 	controller := grpccontroller.New(
 		settings.HTTP.Host,
 		settings.HTTP.Port,
+		settings.HTTP.ReflectionEnabled,
 		useCases,
+		healthServer,
+		cursor.New(settings.Pagination.CursorSecret),
+		settings.Batch,
 		logger,
 		traceProvider,
 		settings.Tracing.Spans.Root,
 	)
 
-	application := app.New(controller)
+	gatewayController, err := httpcontroller.New(
+		settings.HTTP.Host,
+		settings.HTTP.Port,
+		settings.HTTP.Gateway,
+		logger,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Gateway is stopped before gRPC server, because it proxies requests to gRPC server:
+	application := app.New(gatewayController, controller)
 	application.Run()
 }
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Types of events, published by hmtm-tickets. Type does not depend on NATS subject, which is configurable.
+const (
+	TicketCreatedEventType   = "hmtm.tickets.ticket.created"
+	TicketUpdatedEventType   = "hmtm.tickets.ticket.updated"
+	TicketDeletedEventType   = "hmtm.tickets.ticket.deleted"
+	RespondCreatedEventType  = "hmtm.tickets.respond.created"
+	RespondUpdatedEventType  = "hmtm.tickets.respond.updated"
+	RespondDeletedEventType  = "hmtm.tickets.respond.deleted"
+	RespondAcceptedEventType = "hmtm.tickets.respond.accepted"
+	RespondRejectedEventType = "hmtm.tickets.respond.rejected"
+	UserDataDeletedEventType = "hmtm.tickets.user-data.deleted"
+)
+
+// EventEnvelope wraps every published message. Fields follow CloudEvents 1.0 JSON format, where
+// SchemaVersion, RequestID, TraceParent and TraceState are extension attributes. Schemas of envelope
+// and of all events data are published under api/events directory.
+type EventEnvelope struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	DataSchema      string          `json:"dataschema"`
+	SchemaVersion   uint32          `json:"schemaversion"`
+	RequestID       string          `json:"requestid,omitempty"`
+	TraceParent     string          `json:"traceparent,omitempty"`
+	TraceState      string          `json:"tracestate,omitempty"`
+	Data            json.RawMessage `json:"data"`
+}
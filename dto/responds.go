@@ -0,0 +1,37 @@
+package dto
+
+type RespondAcceptedDTO struct {
+	TicketID           uint64   `json:"ticketId"`
+	TicketOwnerID      uint64   `json:"ticketOwnerId"`
+	RespondID          uint64   `json:"respondId"`
+	MasterID           uint64   `json:"masterId"`
+	RejectedMastersIDs []uint64 `json:"rejectedMastersIds"`
+}
+
+type RespondRejectedDTO struct {
+	TicketID      uint64 `json:"ticketId"`
+	TicketOwnerID uint64 `json:"ticketOwnerId"`
+	RespondID     uint64 `json:"respondId"`
+	MasterID      uint64 `json:"masterId"`
+}
+
+type RespondCreatedDTO struct {
+	TicketID      uint64 `json:"ticketId"`
+	TicketOwnerID uint64 `json:"ticketOwnerId"`
+	RespondID     uint64 `json:"respondId"`
+	MasterID      uint64 `json:"masterId"`
+}
+
+type RespondUpdatedDTO struct {
+	TicketID      uint64 `json:"ticketId"`
+	TicketOwnerID uint64 `json:"ticketOwnerId"`
+	RespondID     uint64 `json:"respondId"`
+	MasterID      uint64 `json:"masterId"`
+}
+
+type RespondDeletedDTO struct {
+	TicketID      uint64 `json:"ticketId"`
+	TicketOwnerID uint64 `json:"ticketOwnerId"`
+	RespondID     uint64 `json:"respondId"`
+	MasterID      uint64 `json:"masterId"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+type TicketCreatedDTO struct {
+	TicketID      uint64   `json:"ticketId"`
+	TicketOwnerID uint64   `json:"ticketOwnerId"`
+	CategoryID    uint32   `json:"categoryId"`
+	TagIDs        []uint32 `json:"tagIds,omitempty"`
+}
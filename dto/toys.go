@@ -0,0 +1,16 @@
+package dto
+
+// CategoryDeletedDTO is received from hmtm-toys, when Category has been deleted.
+type CategoryDeletedDTO struct {
+	CategoryID uint32 `json:"categoryId"`
+}
+
+// TagDeletedDTO is received from hmtm-toys, when Tag has been deleted.
+type TagDeletedDTO struct {
+	TagID uint32 `json:"tagId"`
+}
+
+// MasterDeletedDTO is received from hmtm-toys, when Master has been deleted.
+type MasterDeletedDTO struct {
+	MasterID uint64 `json:"masterId"`
+}
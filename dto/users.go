@@ -0,0 +1,17 @@
+package dto
+
+// UserDeletedDTO is received from hmtm-sso, when User has been deleted.
+type UserDeletedDTO struct {
+	UserID uint64 `json:"userId"`
+}
+
+// UserDataDeletedDTO is published, when all Tickets and Responds of deleted User have been purged.
+type UserDataDeletedDTO struct {
+	UserID                uint64  `json:"userId"`
+	MasterID              *uint64 `json:"masterId,omitempty"`
+	TicketsCount          uint64  `json:"ticketsCount"`
+	RespondsCount         uint64  `json:"respondsCount"`
+	AttachmentsCount      uint64  `json:"attachmentsCount"`
+	TagsAssociationsCount uint64  `json:"tagsAssociationsCount"`
+	IdempotencyKeysCount  uint64  `json:"idempotencyKeysCount"`
+}
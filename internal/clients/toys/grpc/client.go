@@ -1,6 +1,7 @@
 package toysgrpcclient
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -9,17 +10,21 @@ import (
 	"github.com/DKhorkov/libs/tracing"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
 
 	customgrpc "github.com/DKhorkov/libs/grpc/interceptors"
 	grpclogging "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
 )
 
 type Client struct {
 	toys.TagsServiceClient
 	toys.CategoriesServiceClient
 	toys.MastersServiceClient
+	connection *grpc.ClientConn
 }
 
 func New(
@@ -75,5 +80,30 @@ func New(
 		TagsServiceClient:       toys.NewTagsServiceClient(clientConnection),
 		MastersServiceClient:    toys.NewMastersServiceClient(clientConnection),
 		CategoriesServiceClient: toys.NewCategoriesServiceClient(clientConnection),
+		connection:              clientConnection,
 	}, nil
 }
+
+// Ping checks, that connection with Toys gRPC server is ready. Connection is established lazily,
+// so Ping triggers connecting, if connection is idle, and waits for it until context is done.
+func (client *Client) Ping(ctx context.Context) error {
+	client.connection.Connect()
+
+	for {
+		state := client.connection.GetState()
+		if state == connectivity.Ready {
+			return nil
+		}
+
+		if state == connectivity.Shutdown {
+			return &customerrors.DependencyUnavailableError{Message: "Toys gRPC connection is closed"}
+		}
+
+		if !client.connection.WaitForStateChange(ctx, state) {
+			return &customerrors.DependencyUnavailableError{
+				Message: fmt.Sprintf("Toys gRPC connection state is %s", state),
+				BaseErr: ctx.Err(),
+			}
+		}
+	}
+}
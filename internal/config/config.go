@@ -1,7 +1,9 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/DKhorkov/libs/db"
@@ -17,8 +19,15 @@ func New() Config {
 		Environment: loadenv.GetEnv("ENVIRONMENT", "local"),
 		Version:     loadenv.GetEnv("VERSION", "latest"),
 		HTTP: HTTPConfig{
-			Host: loadenv.GetEnv("HOST", "0.0.0.0"),
-			Port: loadenv.GetEnvAsInt("PORT", 8050),
+			Host:              loadenv.GetEnv("HOST", "0.0.0.0"),
+			Port:              loadenv.GetEnvAsInt("PORT", 8050),
+			ReflectionEnabled: loadenv.GetEnvAsBool("GRPC_REFLECTION_ENABLED", false),
+			Gateway: GatewayConfig{
+				Port: loadenv.GetEnvAsInt("GATEWAY_PORT", 8051),
+				ShutdownTimeout: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("GATEWAY_SHUTDOWN_TIMEOUT", 10),
+				),
+			},
 		},
 		Database: db.Config{
 			Host:         loadenv.GetEnv("POSTGRES_HOST", "0.0.0.0"),
@@ -60,12 +69,125 @@ func New() Config {
 				loadenv.GetEnvAsInt("NATS_CLIENT_PORT", 4222),
 			),
 			Subjects: NATSSubjects{
+				TicketCreated: loadenv.GetEnv("NATS_TICKET_CREATED_SUBJECT", "ticket-created"),
 				TicketUpdated: loadenv.GetEnv("NATS_TICKET_UPDATED_SUBJECT", "ticket-updated"),
 				TicketDeleted: loadenv.GetEnv("NATS_TICKET_DELETED_SUBJECT", "ticket-deleted"),
+				RespondAccepted: loadenv.GetEnv(
+					"NATS_RESPOND_ACCEPTED_SUBJECT",
+					"respond-accepted",
+				),
+				RespondRejected: loadenv.GetEnv(
+					"NATS_RESPOND_REJECTED_SUBJECT",
+					"respond-rejected",
+				),
+				RespondCreated: loadenv.GetEnv(
+					"NATS_RESPOND_CREATED_SUBJECT",
+					"respond-created",
+				),
+				RespondUpdated: loadenv.GetEnv(
+					"NATS_RESPOND_UPDATED_SUBJECT",
+					"respond-updated",
+				),
+				RespondDeleted: loadenv.GetEnv(
+					"NATS_RESPOND_DELETED_SUBJECT",
+					"respond-deleted",
+				),
+				UserDataDeleted: loadenv.GetEnv(
+					"NATS_USER_DATA_DELETED_SUBJECT",
+					"tickets-user-data-deleted",
+				),
 			},
 			Publisher: NATSPublisher{
 				Name: loadenv.GetEnv("NATS_PUBLISHER_NAME", "hmtm-tickets-publisher"),
 			},
+			JetStream: NATSJetStream{
+				Enabled:    loadenv.GetEnvAsBool("NATS_JETSTREAM_ENABLED", false),
+				StreamName: loadenv.GetEnv("NATS_JETSTREAM_STREAM_NAME", "HMTM_TICKETS"),
+				AckTimeout: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("NATS_JETSTREAM_ACK_TIMEOUT", 5),
+				),
+				DuplicatesWindow: time.Minute * time.Duration(
+					loadenv.GetEnvAsInt("NATS_JETSTREAM_DUPLICATES_WINDOW", 10),
+				),
+			},
+			Subscriber: NATSSubscriber{
+				Name:                     loadenv.GetEnv("NATS_SUBSCRIBER_NAME", "hmtm-tickets-subscriber"),
+				GoroutinesPoolSize:       loadenv.GetEnvAsInt("NATS_SUBSCRIBER_GOROUTINES_POOL_SIZE", 1),
+				MessageChannelBufferSize: loadenv.GetEnvAsInt("NATS_SUBSCRIBER_MESSAGE_CHANNEL_BUFFER_SIZE", 1),
+				FallbackCategoryID: uint32(
+					loadenv.GetEnvAsInt("NATS_SUBSCRIBER_FALLBACK_CATEGORY_ID", 1),
+				),
+				StreamName: loadenv.GetEnv("NATS_SUBSCRIBER_STREAM_NAME", "HMTM_TICKETS_EVENTS"),
+				HandlerTimeout: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("NATS_SUBSCRIBER_HANDLER_TIMEOUT", 30),
+				),
+				RetryDelay: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("NATS_SUBSCRIBER_RETRY_DELAY", 5),
+				),
+				MaxDeliveries: loadenv.GetEnvAsInt("NATS_SUBSCRIBER_MAX_DELIVERIES", 10),
+				Subjects: NATSSubscriberSubjects{
+					CategoryDeleted: loadenv.GetEnv(
+						"NATS_CATEGORY_DELETED_SUBJECT",
+						"category-deleted",
+					),
+					TagDeleted:    loadenv.GetEnv("NATS_TAG_DELETED_SUBJECT", "tag-deleted"),
+					MasterDeleted: loadenv.GetEnv("NATS_MASTER_DELETED_SUBJECT", "master-deleted"),
+					UserDeleted:   loadenv.GetEnv("NATS_USER_DELETED_SUBJECT", "user-deleted"),
+				},
+			},
+		},
+		Search: SearchConfig{
+			FullTextEnabled: loadenv.GetEnvAsBool("SEARCH_FULL_TEXT_ENABLED", true),
+			Languages: loadenv.GetEnvAsSlice(
+				"SEARCH_LANGUAGES",
+				SearchVectorLanguages,
+				",",
+			),
+		},
+		Pagination: PaginationConfig{
+			CursorSecret: loadenv.GetEnv("PAGINATION_CURSOR_SECRET", ""),
+		},
+		Idempotency: IdempotencyConfig{
+			KeysTTL: time.Hour * time.Duration(
+				loadenv.GetEnvAsInt("IDEMPOTENCY_KEYS_TTL", 24),
+			),
+		},
+		Outbox: OutboxConfig{
+			BatchSize: uint64(loadenv.GetEnvAsInt("OUTBOX_BATCH_SIZE", 100)),
+			PollInterval: time.Second * time.Duration(
+				loadenv.GetEnvAsInt("OUTBOX_POLL_INTERVAL", 1),
+			),
+			BaseRetryTimeout: time.Second * time.Duration(
+				loadenv.GetEnvAsInt("OUTBOX_BASE_RETRY_TIMEOUT", 1),
+			),
+			MaxRetryTimeout: time.Second * time.Duration(
+				loadenv.GetEnvAsInt("OUTBOX_MAX_RETRY_TIMEOUT", 300),
+			),
+			LeaseTimeout: time.Second * time.Duration(
+				loadenv.GetEnvAsInt("OUTBOX_LEASE_TIMEOUT", 60),
+			),
+			CleanupInterval: time.Minute * time.Duration(
+				loadenv.GetEnvAsInt("OUTBOX_CLEANUP_INTERVAL", 60),
+			),
+			SentMessagesRetention: time.Hour * time.Duration(
+				loadenv.GetEnvAsInt("OUTBOX_SENT_MESSAGES_RETENTION", 168),
+			),
+		},
+		Health: HealthConfig{
+			CheckInterval: time.Second * time.Duration(
+				loadenv.GetEnvAsInt("HEALTH_CHECK_INTERVAL", 5),
+			),
+			CheckTimeout: time.Second * time.Duration(
+				loadenv.GetEnvAsInt("HEALTH_CHECK_TIMEOUT", 2),
+			),
+		},
+		Batch: BatchConfig{
+			MaxIDs: loadenv.GetEnvAsInt("BATCH_MAX_IDS", 100),
+		},
+		Watch: WatchConfig{
+			MaxStreams:    loadenv.GetEnvAsInt("WATCH_MAX_STREAMS", 100),
+			BufferSize:    loadenv.GetEnvAsInt("WATCH_BUFFER_SIZE", 64),
+			SnapshotLimit: uint64(loadenv.GetEnvAsInt("WATCH_SNAPSHOT_LIMIT", 100)),
 		},
 		Tracing: TracingConfig{
 			Server: tracing.Config{
@@ -178,6 +300,74 @@ func New() Config {
 							},
 						},
 					},
+					Outbox: tracing.SpanConfig{
+						Opts: []trace.SpanStartOption{
+							trace.WithAttributes(
+								attribute.String(
+									"Environment",
+									loadenv.GetEnv("ENVIRONMENT", "local"),
+								),
+							),
+						},
+						Events: tracing.SpanEventsConfig{
+							Start: tracing.SpanEventConfig{
+								Name: "Calling database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+							End: tracing.SpanEventConfig{
+								Name: "Received response from database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+						},
+					},
+					IdempotencyKeys: tracing.SpanConfig{
+						Opts: []trace.SpanStartOption{
+							trace.WithAttributes(
+								attribute.String(
+									"Environment",
+									loadenv.GetEnv("ENVIRONMENT", "local"),
+								),
+							),
+						},
+						Events: tracing.SpanEventsConfig{
+							Start: tracing.SpanEventConfig{
+								Name: "Calling database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+							End: tracing.SpanEventConfig{
+								Name: "Received response from database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+						},
+					},
 				},
 				Clients: SpanClients{
 					Toys: tracing.SpanConfig{
@@ -231,9 +421,21 @@ type ClientsConfig struct {
 	Toys ClientConfig
 }
 
+// HTTPConfig describes gRPC server. ReflectionEnabled allows tools like grpcurl to discover
+// services without proto files, so it is better to keep it disabled in production.
 type HTTPConfig struct {
-	Host string
-	Port int
+	Host              string
+	Port              int
+	ReflectionEnabled bool
+	Gateway           GatewayConfig
+}
+
+// GatewayConfig describes HTTP/JSON gateway, which proxies REST requests to gRPC server.
+// Gateway listens on the same Host as gRPC server. ShutdownTimeout limits waiting for active requests
+// to be finished during graceful shutdown.
+type GatewayConfig struct {
+	Port            int
+	ShutdownTimeout time.Duration
 }
 
 type TracingConfig struct {
@@ -248,8 +450,10 @@ type SpansConfig struct {
 }
 
 type SpanRepositories struct {
-	Responds tracing.SpanConfig
-	Tickets  tracing.SpanConfig
+	Responds        tracing.SpanConfig
+	Tickets         tracing.SpanConfig
+	Outbox          tracing.SpanConfig
+	IdempotencyKeys tracing.SpanConfig
 }
 
 type SpanClients struct {
@@ -257,20 +461,130 @@ type SpanClients struct {
 }
 
 type NATSConfig struct {
-	ClientURL string
-	Subjects  NATSSubjects
-	Publisher NATSPublisher
+	ClientURL  string
+	Subjects   NATSSubjects
+	Publisher  NATSPublisher
+	JetStream  NATSJetStream
+	Subscriber NATSSubscriber
 }
 
 type NATSSubjects struct {
-	TicketUpdated string
-	TicketDeleted string
+	TicketCreated   string
+	TicketUpdated   string
+	TicketDeleted   string
+	RespondAccepted string
+	RespondRejected string
+	RespondCreated  string
+	RespondUpdated  string
+	RespondDeleted  string
+	UserDataDeleted string
 }
 
 type NATSPublisher struct {
 	Name string
 }
 
+// NATSJetStream enables durable publishing and consuming. Stream with StreamName is created for all NATSSubjects.
+// DuplicatesWindow should be greater than outbox retry timeout to drop duplicates of retried messages.
+type NATSJetStream struct {
+	Enabled          bool
+	StreamName       string
+	AckTimeout       time.Duration
+	DuplicatesWindow time.Duration
+}
+
+// NATSSubscriber describes subscriptions to hmtm-toys and hmtm-sso events. Tickets of deleted Category
+// are moved to Category with FallbackCategoryID. Each event is processed during HandlerTimeout.
+// In JetStream mode events are stored in stream with StreamName and are processed via durable consumers.
+// Event is redelivered after RetryDelay, if processing has failed, and is dropped after MaxDeliveries attempts.
+// Otherwise, events are received via core NATS queue subscriptions and failed events are not redelivered.
+type NATSSubscriber struct {
+	Name                     string
+	GoroutinesPoolSize       int
+	MessageChannelBufferSize int
+	FallbackCategoryID       uint32
+	StreamName               string
+	HandlerTimeout           time.Duration
+	RetryDelay               time.Duration
+	MaxDeliveries            int
+	Subjects                 NATSSubscriberSubjects
+}
+
+type NATSSubscriberSubjects struct {
+	CategoryDeleted string
+	TagDeleted      string
+	MasterDeleted   string
+	UserDeleted     string
+}
+
+// SearchVectorLanguages are languages, which are hardcoded in search_vector column generation by
+// Postgres migration. Tickets can be searched only in these languages.
+var SearchVectorLanguages = []string{"russian", "english"}
+
+// SearchConfig describes Tickets search. Full-text search requires Postgres migrations to be applied
+// and Languages to be a subset of SearchVectorLanguages.
+type SearchConfig struct {
+	FullTextEnabled bool
+	Languages       []string
+}
+
+// MinCursorSecretLength is minimal length of PaginationConfig.CursorSecret, which equals to size
+// of HMAC-SHA256 signature, used for signing cursors.
+const MinCursorSecretLength = 32
+
+// PaginationConfig describes keyset pagination. CursorSecret is used for signing cursors,
+// so it has no default value and should not be changed often, because all previously
+// issued cursors become invalid.
+type PaginationConfig struct {
+	CursorSecret string
+}
+
+// OutboxConfig describes how often outbox relay polls database for pending messages
+// and how it postpones messages, which failed to be published. Claimed messages are not
+// claimed by other relays during LeaseTimeout, so it should be greater than time, needed for
+// publishing BatchSize messages. Sent messages are deleted every CleanupInterval, when
+// SentMessagesRetention has passed since their sending. Expired idempotency keys are deleted
+// every CleanupInterval as well.
+type OutboxConfig struct {
+	BatchSize             uint64
+	PollInterval          time.Duration
+	BaseRetryTimeout      time.Duration
+	MaxRetryTimeout       time.Duration
+	LeaseTimeout          time.Duration
+	CleanupInterval       time.Duration
+	SentMessagesRetention time.Duration
+}
+
+// IdempotencyConfig describes how long results of create requests are stored, so retried
+// requests with the same idempotency key return the same result. KeysTTL should be greater
+// than maximum retry period of clients.
+type IdempotencyConfig struct {
+	KeysTTL time.Duration
+}
+
+// HealthConfig describes how often readiness of dependencies (database, Toys client, NATS) is checked.
+// CheckTimeout is applied to each check and should be less than CheckInterval.
+type HealthConfig struct {
+	CheckInterval time.Duration
+	CheckTimeout  time.Duration
+}
+
+// WatchConfig describes Tickets watch streams. MaxStreams limits simultaneously opened streams.
+// BufferSize is number of Tickets events, which can wait for sending to each stream. Stream, which buffer
+// has overflowed, is closed, so slow clients do not block others. SnapshotLimit limits number of Tickets,
+// sent to stream before events.
+type WatchConfig struct {
+	MaxStreams    int
+	BufferSize    int
+	SnapshotLimit uint64
+}
+
+// BatchConfig describes batch requests. MaxIDs limits number of IDs, which can be requested at once,
+// so one request can not load whole table.
+type BatchConfig struct {
+	MaxIDs int
+}
+
 type Config struct {
 	HTTP        HTTPConfig
 	Database    db.Config
@@ -280,4 +594,44 @@ type Config struct {
 	Environment string
 	Version     string
 	NATS        NATSConfig
+	Outbox      OutboxConfig
+	Search      SearchConfig
+	Pagination  PaginationConfig
+	Idempotency IdempotencyConfig
+	Health      HealthConfig
+	Watch       WatchConfig
+	Batch       BatchConfig
+}
+
+// Validate checks settings, which have no safe defaults or depend on applied migrations, so service
+// fails at startup instead of working with invalid configuration.
+func (config Config) Validate() error {
+	if len(config.Pagination.CursorSecret) < MinCursorSecretLength {
+		return fmt.Errorf(
+			"PAGINATION_CURSOR_SECRET must be at least %d characters long",
+			MinCursorSecretLength,
+		)
+	}
+
+	if config.NATS.JetStream.Enabled && config.NATS.Subscriber.MaxDeliveries < 1 {
+		return errors.New("NATS_SUBSCRIBER_MAX_DELIVERIES must be positive, when JetStream is enabled")
+	}
+
+	if config.Search.FullTextEnabled {
+		if len(config.Search.Languages) == 0 {
+			return errors.New("SEARCH_LANGUAGES must not be empty, when full-text search is enabled")
+		}
+
+		for _, language := range config.Search.Languages {
+			if !slices.Contains(SearchVectorLanguages, language) {
+				return fmt.Errorf(
+					"SEARCH_LANGUAGES contains %q, but search_vector column supports only %v",
+					language,
+					SearchVectorLanguages,
+				)
+			}
+		}
+	}
+
+	return nil
 }
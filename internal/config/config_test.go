@@ -0,0 +1,87 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		config        Config
+		errorExpected bool
+	}{
+		{
+			name: "valid",
+			config: Config{
+				Pagination: PaginationConfig{CursorSecret: strings.Repeat("s", MinCursorSecretLength)},
+				Search:     SearchConfig{FullTextEnabled: true, Languages: []string{"english"}},
+			},
+			errorExpected: false,
+		},
+		{
+			name: "unsupported search language",
+			config: Config{
+				Pagination: PaginationConfig{CursorSecret: strings.Repeat("s", MinCursorSecretLength)},
+				Search:     SearchConfig{FullTextEnabled: true, Languages: []string{"english", "german"}},
+			},
+			errorExpected: true,
+		},
+		{
+			name: "empty search languages",
+			config: Config{
+				Pagination: PaginationConfig{CursorSecret: strings.Repeat("s", MinCursorSecretLength)},
+				Search:     SearchConfig{FullTextEnabled: true},
+			},
+			errorExpected: true,
+		},
+		{
+			name: "search languages are not checked, when full-text search is disabled",
+			config: Config{
+				Pagination: PaginationConfig{CursorSecret: strings.Repeat("s", MinCursorSecretLength)},
+				Search:     SearchConfig{Languages: []string{"german"}},
+			},
+			errorExpected: false,
+		},
+		{
+			name: "unlimited deliveries in JetStream mode",
+			config: Config{
+				Pagination: PaginationConfig{CursorSecret: strings.Repeat("s", MinCursorSecretLength)},
+				NATS:       NATSConfig{JetStream: NATSJetStream{Enabled: true}},
+			},
+			errorExpected: true,
+		},
+		{
+			name: "deliveries are not checked, when JetStream is disabled",
+			config: Config{
+				Pagination: PaginationConfig{CursorSecret: strings.Repeat("s", MinCursorSecretLength)},
+			},
+			errorExpected: false,
+		},
+		{
+			name:          "empty cursor secret",
+			config:        Config{},
+			errorExpected: true,
+		},
+		{
+			name: "short cursor secret",
+			config: Config{
+				Pagination: PaginationConfig{CursorSecret: strings.Repeat("s", MinCursorSecretLength-1)},
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -7,11 +7,16 @@ import (
 	"github.com/DKhorkov/libs/logging"
 	"github.com/DKhorkov/libs/tracing"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
 	customgrpc "github.com/DKhorkov/libs/grpc/interceptors"
 
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
 	"github.com/DKhorkov/hmtm-tickets/internal/controllers/grpc/responds"
 	"github.com/DKhorkov/hmtm-tickets/internal/controllers/grpc/tickets"
+	"github.com/DKhorkov/hmtm-tickets/internal/cursor"
 	"github.com/DKhorkov/hmtm-tickets/internal/interfaces"
 )
 
@@ -19,7 +24,11 @@ import (
 func New(
 	host string,
 	port int,
+	reflectionEnabled bool,
 	useCases interfaces.UseCases,
+	healthServer *health.Server,
+	cursorCodec *cursor.Codec,
+	batchConfig config.BatchConfig,
 	logger logging.Logger,
 	traceProvider tracing.Provider,
 	spanConfig tracing.SpanConfig,
@@ -32,22 +41,29 @@ func New(
 	)
 
 	// Connects our gRPC services to grpcServer:
-	tickets.RegisterServer(grpcServer, useCases, logger)
-	responds.RegisterServer(grpcServer, useCases, logger)
+	tickets.RegisterServer(grpcServer, useCases, cursorCodec, batchConfig, logger)
+	responds.RegisterServer(grpcServer, useCases, batchConfig, logger)
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+
+	if reflectionEnabled {
+		reflection.Register(grpcServer)
+	}
 
 	return &Controller{
-		grpcServer: grpcServer,
-		port:       port,
-		host:       host,
-		logger:     logger,
+		grpcServer:   grpcServer,
+		healthServer: healthServer,
+		port:         port,
+		host:         host,
+		logger:       logger,
 	}
 }
 
 type Controller struct {
-	grpcServer *grpc.Server
-	host       string
-	port       int
-	logger     logging.Logger
+	grpcServer   *grpc.Server
+	healthServer *health.Server
+	host         string
+	port         int
+	logger       logging.Logger
 }
 
 // Run gRPC server.
@@ -73,6 +89,9 @@ func (controller *Controller) Run() {
 
 // Stop gRPC server gracefully (graceful shutdown).
 func (controller *Controller) Stop() {
+	// Reports NOT_SERVING for all services, so load balancers stop routing new requests to this instance:
+	controller.healthServer.Shutdown()
+
 	// Stops accepting new requests and processes already received requests:
 	controller.grpcServer.GracefulStop()
 	logging.LogInfo(controller.logger, "Graceful shutdown completed.")
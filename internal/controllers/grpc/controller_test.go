@@ -0,0 +1,81 @@
+package grpccontroller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DKhorkov/libs/tracing"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+	"github.com/DKhorkov/hmtm-tickets/internal/cursor"
+	mockusecases "github.com/DKhorkov/hmtm-tickets/mocks/usecases"
+)
+
+const reflectionService = "grpc.reflection.v1.ServerReflection"
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name              string
+		reflectionEnabled bool
+	}{
+		{
+			name:              "reflection enabled",
+			reflectionEnabled: true,
+		},
+		{
+			name:              "reflection disabled",
+			reflectionEnabled: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := newTestController(t, health.NewServer(), tc.reflectionEnabled)
+
+			services := controller.grpcServer.GetServiceInfo()
+			require.Contains(t, services, grpc_health_v1.Health_ServiceDesc.ServiceName)
+
+			_, ok := services[reflectionService]
+			require.Equal(t, tc.reflectionEnabled, ok)
+		})
+	}
+}
+
+func TestController_Stop(t *testing.T) {
+	healthServer := health.NewServer()
+	controller := newTestController(t, healthServer, false)
+
+	controller.Stop()
+
+	response, err := healthServer.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, response.GetStatus())
+}
+
+func newTestController(t *testing.T, healthServer *health.Server, reflectionEnabled bool) *Controller {
+	t.Helper()
+
+	mockController := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(mockController)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	return New(
+		"0.0.0.0",
+		8050,
+		reflectionEnabled,
+		mockusecases.NewMockUseCases(mockController),
+		healthServer,
+		cursor.New("secret"),
+		config.BatchConfig{MaxIDs: 100},
+		logger,
+		mocktracing.NewMockProvider(mockController),
+		tracing.SpanConfig{},
+	)
+}
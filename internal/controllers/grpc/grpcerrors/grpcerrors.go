@@ -0,0 +1,149 @@
+package grpcerrors
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
+)
+
+// Domain is sent in ErrorInfo details to distinguish reasons of this service from reasons of others.
+const Domain = "hmtm-tickets"
+
+// Request fields, which are sent in BadRequest details. Field names correspond to .proto files:
+const (
+	categoryIDField     = "categoryID"
+	tagIDsField         = "tagIDs"
+	userIDField         = "userID"
+	cursorField         = "pagination.cursor"
+	idempotencyKeyField = "idempotencyKey"
+	updateMaskField     = "updateMask"
+	statusField         = "status"
+	sortField           = "sort"
+	idsField            = "IDs"
+	searchField         = "filters.search"
+)
+
+// Error is gRPC error, which status contains details of original error: ErrorInfo with stable reason code
+// for customerrors types and BadRequest with field violation, if error is caused by invalid request field
+// and status code reports invalid request.
+type Error struct {
+	Status codes.Code
+	Err    error
+}
+
+func New(code codes.Code, err error) error {
+	return &Error{Status: code, Err: err}
+}
+
+func (e Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e Error) Unwrap() error {
+	return e.Err
+}
+
+// GRPCStatus is used by gRPC when converting an error into a status.
+func (e Error) GRPCStatus() *status.Status {
+	grpcStatus := status.New(e.Status, e.Err.Error())
+
+	details := errorDetails(e.Status, e.Err)
+	if len(details) == 0 {
+		return grpcStatus
+	}
+
+	// Details are optional, so status without them is better than no status:
+	withDetails, err := grpcStatus.WithDetails(details...)
+	if err != nil {
+		return grpcStatus
+	}
+
+	return withDetails
+}
+
+// badRequestCodes are status codes, which report invalid request, so only they are sent with BadRequest details.
+var badRequestCodes = []codes.Code{codes.InvalidArgument, codes.FailedPrecondition}
+
+func errorDetails(code codes.Code, err error) []protoadapt.MessageV1 {
+	var reasoner interface{ Reason() string }
+	if !errors.As(err, &reasoner) {
+		return nil
+	}
+
+	errorInfo := &errdetails.ErrorInfo{
+		Reason: reasoner.Reason(),
+		Domain: Domain,
+	}
+
+	details := []protoadapt.MessageV1{errorInfo}
+
+	field, metadata := fieldViolation(err)
+	errorInfo.Metadata = metadata
+
+	if field == "" || !slices.Contains(badRequestCodes, code) {
+		return details
+	}
+
+	return append(
+		details,
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{
+					Field:       field,
+					Description: err.Error(),
+				},
+			},
+		},
+	)
+}
+
+// fieldViolation returns request field, which caused error, and metadata with offending value, if it is known.
+// Field of repeated value contains index of offending element.
+func fieldViolation(err error) (string, map[string]string) {
+	var (
+		categoryNotFoundError      *customerrors.CategoryNotFoundError
+		tagNotFoundError           *customerrors.TagNotFoundError
+		masterNotFoundError        *customerrors.MasterNotFoundError
+		invalidCursorError         *customerrors.InvalidCursorError
+		invalidIdempotencyKeyError *customerrors.InvalidIdempotencyKeyError
+		invalidUpdateMaskError     *customerrors.InvalidUpdateMaskError
+		invalidTicketStatusError   *customerrors.InvalidTicketStatusError
+		invalidSortError           *customerrors.InvalidSortError
+		tooManyIDsError            *customerrors.TooManyIDsError
+		watchSearchError           *customerrors.WatchSearchNotSupportedError
+	)
+
+	// Not found Toys entities store their IDs in Message:
+	switch {
+	case errors.As(err, &categoryNotFoundError):
+		return categoryIDField, map[string]string{categoryIDField: categoryNotFoundError.Message}
+	case errors.As(err, &tagNotFoundError):
+		return fmt.Sprintf("%s[%d]", tagIDsField, tagNotFoundError.Index),
+			map[string]string{"tagID": tagNotFoundError.Message}
+	case errors.As(err, &masterNotFoundError):
+		return userIDField, map[string]string{userIDField: masterNotFoundError.Message}
+	case errors.As(err, &invalidCursorError):
+		return cursorField, nil
+	case errors.As(err, &invalidIdempotencyKeyError):
+		return idempotencyKeyField, nil
+	case errors.As(err, &invalidUpdateMaskError):
+		return updateMaskField, nil
+	case errors.As(err, &invalidTicketStatusError):
+		return statusField, nil
+	case errors.As(err, &invalidSortError):
+		return fmt.Sprintf("%s[%d]", sortField, invalidSortError.Index), nil
+	case errors.As(err, &tooManyIDsError):
+		return idsField, nil
+	case errors.As(err, &watchSearchError):
+		return searchField, nil
+	default:
+		return "", nil
+	}
+}
@@ -0,0 +1,218 @@
+package grpcerrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
+)
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name                    string
+		code                    codes.Code
+		err                     error
+		expectedMessage         string
+		expectedErrorInfo       *errdetails.ErrorInfo
+		expectedFieldViolations []*errdetails.BadRequest_FieldViolation
+	}{
+		{
+			name:            "error without reason",
+			code:            codes.Internal,
+			err:             errors.New("some error"),
+			expectedMessage: "some error",
+		},
+		{
+			name:            "error with reason",
+			code:            codes.NotFound,
+			err:             &customerrors.TicketNotFoundError{},
+			expectedMessage: "ticket not found",
+			expectedErrorInfo: &errdetails.ErrorInfo{
+				Reason: customerrors.ReasonTicketNotFound,
+				Domain: Domain,
+			},
+		},
+		{
+			name:            "wrapped error with reason",
+			code:            codes.Unavailable,
+			err:             fmt.Errorf("wrapped: %w", &customerrors.DependencyUnavailableError{}),
+			expectedMessage: "wrapped: dependency is unavailable",
+			expectedErrorInfo: &errdetails.ErrorInfo{
+				Reason: customerrors.ReasonDependencyUnavailable,
+				Domain: Domain,
+			},
+		},
+		{
+			name:            "not found tag",
+			code:            codes.InvalidArgument,
+			err:             &customerrors.TagNotFoundError{Message: "7", Index: 2},
+			expectedMessage: "tag with ID=7 not found",
+			expectedErrorInfo: &errdetails.ErrorInfo{
+				Reason:   customerrors.ReasonTagNotFound,
+				Domain:   Domain,
+				Metadata: map[string]string{"tagID": "7"},
+			},
+			expectedFieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "tagIDs[2]", Description: "tag with ID=7 not found"},
+			},
+		},
+		{
+			name:            "not found category",
+			code:            codes.FailedPrecondition,
+			err:             &customerrors.CategoryNotFoundError{Message: "3"},
+			expectedMessage: "category with ID=3 not found",
+			expectedErrorInfo: &errdetails.ErrorInfo{
+				Reason:   customerrors.ReasonCategoryNotFound,
+				Domain:   Domain,
+				Metadata: map[string]string{"categoryID": "3"},
+			},
+			expectedFieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "categoryID", Description: "category with ID=3 not found"},
+			},
+		},
+		{
+			name:            "field violation is not sent with status, which does not report invalid request",
+			code:            codes.Internal,
+			err:             &customerrors.MasterNotFoundError{Message: "5"},
+			expectedMessage: "master for user with ID=5 not found",
+			expectedErrorInfo: &errdetails.ErrorInfo{
+				Reason:   customerrors.ReasonMasterNotFound,
+				Domain:   Domain,
+				Metadata: map[string]string{"userID": "5"},
+			},
+		},
+		{
+			name:            "invalid cursor",
+			code:            codes.InvalidArgument,
+			err:             &customerrors.InvalidCursorError{Message: "pagination cursor can not be combined with offset"},
+			expectedMessage: "pagination cursor can not be combined with offset",
+			expectedErrorInfo: &errdetails.ErrorInfo{
+				Reason: customerrors.ReasonInvalidCursor,
+				Domain: Domain,
+			},
+			expectedFieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "pagination.cursor", Description: "pagination cursor can not be combined with offset"},
+			},
+		},
+		{
+			name:            "invalid update mask",
+			code:            codes.InvalidArgument,
+			err:             &customerrors.InvalidUpdateMaskError{},
+			expectedMessage: "invalid update mask",
+			expectedErrorInfo: &errdetails.ErrorInfo{
+				Reason: customerrors.ReasonInvalidUpdateMask,
+				Domain: Domain,
+			},
+			expectedFieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "updateMask", Description: "invalid update mask"},
+			},
+		},
+		{
+			name:            "invalid ticket status",
+			code:            codes.InvalidArgument,
+			err:             &customerrors.InvalidTicketStatusError{},
+			expectedMessage: "invalid ticket status",
+			expectedErrorInfo: &errdetails.ErrorInfo{
+				Reason: customerrors.ReasonInvalidTicketStatus,
+				Domain: Domain,
+			},
+			expectedFieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "status", Description: "invalid ticket status"},
+			},
+		},
+		{
+			name:            "invalid sort",
+			code:            codes.InvalidArgument,
+			err:             &customerrors.InvalidSortError{Message: "sort field 100 is not allowed", Index: 1},
+			expectedMessage: "sort field 100 is not allowed",
+			expectedErrorInfo: &errdetails.ErrorInfo{
+				Reason: customerrors.ReasonInvalidSort,
+				Domain: Domain,
+			},
+			expectedFieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "sort[1]", Description: "sort field 100 is not allowed"},
+			},
+		},
+		{
+			name:            "too many IDs",
+			code:            codes.InvalidArgument,
+			err:             &customerrors.TooManyIDsError{},
+			expectedMessage: "too many IDs",
+			expectedErrorInfo: &errdetails.ErrorInfo{
+				Reason: customerrors.ReasonTooManyIDs,
+				Domain: Domain,
+			},
+			expectedFieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "IDs", Description: "too many IDs"},
+			},
+		},
+		{
+			name:            "search in watch stream",
+			code:            codes.InvalidArgument,
+			err:             &customerrors.WatchSearchNotSupportedError{},
+			expectedMessage: "search is not supported by watch stream",
+			expectedErrorInfo: &errdetails.ErrorInfo{
+				Reason: customerrors.ReasonWatchSearchNotSupported,
+				Domain: Domain,
+			},
+			expectedFieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "filters.search", Description: "search is not supported by watch stream"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := New(tc.code, tc.err)
+			require.ErrorIs(t, err, tc.err)
+
+			grpcStatus, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, tc.code, grpcStatus.Code())
+			require.Equal(t, tc.expectedMessage, grpcStatus.Message())
+
+			var (
+				errorInfo  *errdetails.ErrorInfo
+				badRequest *errdetails.BadRequest
+			)
+
+			for _, detail := range grpcStatus.Details() {
+				switch typedDetail := detail.(type) {
+				case *errdetails.ErrorInfo:
+					errorInfo = typedDetail
+				case *errdetails.BadRequest:
+					badRequest = typedDetail
+				}
+			}
+
+			if tc.expectedErrorInfo == nil {
+				require.Empty(t, grpcStatus.Details())
+
+				return
+			}
+
+			require.Equal(t, tc.expectedErrorInfo.GetReason(), errorInfo.GetReason())
+			require.Equal(t, tc.expectedErrorInfo.GetDomain(), errorInfo.GetDomain())
+			require.Equal(t, tc.expectedErrorInfo.GetMetadata(), errorInfo.GetMetadata())
+
+			if tc.expectedFieldViolations == nil {
+				require.Nil(t, badRequest)
+
+				return
+			}
+
+			require.Len(t, badRequest.GetFieldViolations(), len(tc.expectedFieldViolations))
+
+			for i, expected := range tc.expectedFieldViolations {
+				require.Equal(t, expected.GetField(), badRequest.GetFieldViolations()[i].GetField())
+				require.Equal(t, expected.GetDescription(), badRequest.GetFieldViolations()[i].GetDescription())
+			}
+		})
+	}
+}
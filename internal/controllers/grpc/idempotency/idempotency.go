@@ -0,0 +1,39 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/metadata"
+
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
+)
+
+const (
+	// MetadataKey is gRPC metadata header, which can be used to provide idempotency key instead of request field.
+	MetadataKey = "idempotency-key"
+
+	// maxKeyLength corresponds to idempotency_key column size.
+	maxKeyLength = 255
+)
+
+// Key returns idempotency key from request field or, if field is not provided, from gRPC metadata.
+// Empty key is treated as not provided.
+func Key(ctx context.Context, requestKey string) (*string, error) {
+	var key *string
+	if requestKey != "" {
+		key = &requestKey
+	} else if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(MetadataKey); len(values) > 0 && values[0] != "" {
+			key = &values[0]
+		}
+	}
+
+	if key != nil && len(*key) > maxKeyLength {
+		return nil, &customerrors.InvalidIdempotencyKeyError{
+			Message: fmt.Sprintf("idempotency key must not be longer than %d characters", maxKeyLength),
+		}
+	}
+
+	return key, nil
+}
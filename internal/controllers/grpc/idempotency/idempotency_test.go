@@ -0,0 +1,77 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
+	"github.com/DKhorkov/libs/pointers"
+)
+
+func TestKey(t *testing.T) {
+	testCases := []struct {
+		name          string
+		ctx           context.Context
+		requestKey    string
+		expected      *string
+		errorExpected bool
+	}{
+		{
+			name:       "not provided",
+			ctx:        context.Background(),
+			requestKey: "",
+			expected:   nil,
+		},
+		{
+			name:       "from request field",
+			ctx:        context.Background(),
+			requestKey: "field-key",
+			expected:   pointers.New("field-key"),
+		},
+		{
+			name:       "from metadata",
+			ctx:        metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "metadata-key")),
+			requestKey: "",
+			expected:   pointers.New("metadata-key"),
+		},
+		{
+			name:       "request field has priority over metadata",
+			ctx:        metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "metadata-key")),
+			requestKey: "field-key",
+			expected:   pointers.New("field-key"),
+		},
+		{
+			name:       "empty metadata value",
+			ctx:        metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "")),
+			requestKey: "",
+			expected:   nil,
+		},
+		{
+			name:          "too long",
+			ctx:           context.Background(),
+			requestKey:    strings.Repeat("k", maxKeyLength+1),
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := Key(tc.ctx, tc.requestKey)
+			if tc.errorExpected {
+				var invalidIdempotencyKeyError *customerrors.InvalidIdempotencyKeyError
+				require.True(t, errors.As(err, &invalidIdempotencyKeyError))
+				require.Nil(t, actual)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -1,10 +1,13 @@
 package responds
 
 import (
+	"time"
+
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/DKhorkov/hmtm-tickets/api/protobuf/generated/go/tickets"
 	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
 )
 
 func mapRespondOut(respond entities.Respond) *tickets.GetRespondOut {
@@ -16,5 +19,136 @@ func mapRespondOut(respond entities.Respond) *tickets.GetRespondOut {
 		Comment:   respond.Comment,
 		CreatedAt: timestamppb.New(respond.CreatedAt),
 		UpdatedAt: timestamppb.New(respond.UpdatedAt),
+		Status:    mapRespondStatusToOut(respond.Status),
+		Version:   respond.Version,
+	}
+}
+
+func mapRespondStatusToOut(status entities.RespondStatus) tickets.RespondStatus {
+	switch status {
+	case entities.RespondStatusPending:
+		return tickets.RespondStatus_RESPOND_STATUS_PENDING
+	case entities.RespondStatusAccepted:
+		return tickets.RespondStatus_RESPOND_STATUS_ACCEPTED
+	case entities.RespondStatusRejected:
+		return tickets.RespondStatus_RESPOND_STATUS_REJECTED
+	case entities.RespondStatusWithdrawn:
+		return tickets.RespondStatus_RESPOND_STATUS_WITHDRAWN
+	default:
+		return tickets.RespondStatus_RESPOND_STATUS_UNSPECIFIED
+	}
+}
+
+func mapRespondStatusFromIn(status tickets.RespondStatus) entities.RespondStatus {
+	switch status {
+	case tickets.RespondStatus_RESPOND_STATUS_PENDING:
+		return entities.RespondStatusPending
+	case tickets.RespondStatus_RESPOND_STATUS_ACCEPTED:
+		return entities.RespondStatusAccepted
+	case tickets.RespondStatus_RESPOND_STATUS_REJECTED:
+		return entities.RespondStatusRejected
+	case tickets.RespondStatus_RESPOND_STATUS_WITHDRAWN:
+		return entities.RespondStatusWithdrawn
+	default:
+		return ""
+	}
+}
+
+// mapPaginationFromIn returns error for cursor pagination, because Responds are paginated only with offset.
+func mapPaginationFromIn(in *tickets.Pagination) (*entities.Pagination, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	if in.Cursor != nil {
+		return nil, &customerrors.InvalidCursorError{Message: "pagination cursor is not supported for Responds"}
+	}
+
+	return &entities.Pagination{
+		Limit:  in.Limit,
+		Offset: in.Offset,
+	}, nil
+}
+
+func mapRespondsFiltersFromIn(in *tickets.RespondsFilters) *entities.RespondsFilters {
+	if in == nil {
+		return nil
 	}
+
+	return &entities.RespondsFilters{
+		PriceCeil:     in.PriceCeil,
+		PriceFloor:    in.PriceFloor,
+		Statuses:      mapRespondStatusesFromIn(in.GetStatuses()),
+		CreatedAtFrom: mapTimestampFromIn(in.GetCreatedAtFrom()),
+		CreatedAtTo:   mapTimestampFromIn(in.GetCreatedAtTo()),
+	}
+}
+
+// mapRespondsFiltersWithSortFromIn is used for listing Responds, where sort keys are provided
+// separately from filters.
+func mapRespondsFiltersWithSortFromIn(
+	in *tickets.RespondsFilters,
+	sort []*tickets.RespondsSort,
+) *entities.RespondsFilters {
+	filters := mapRespondsFiltersFromIn(in)
+	if len(sort) == 0 {
+		return filters
+	}
+
+	if filters == nil {
+		filters = &entities.RespondsFilters{}
+	}
+
+	filters.Sort = make([]entities.RespondsSort, len(sort))
+	for i, key := range sort {
+		filters.Sort[i] = entities.RespondsSort{
+			Field:     mapRespondsSortFieldFromIn(key.GetField()),
+			Direction: mapSortDirectionFromIn(key.GetDirection()),
+		}
+	}
+
+	return filters
+}
+
+func mapRespondsSortFieldFromIn(field tickets.RespondsSortField) entities.RespondsSortField {
+	switch field {
+	case tickets.RespondsSortField_RESPONDS_SORT_FIELD_PRICE:
+		return entities.RespondsSortFieldPrice
+	case tickets.RespondsSortField_RESPONDS_SORT_FIELD_CREATED_AT:
+		return entities.RespondsSortFieldCreatedAt
+	default:
+		return ""
+	}
+}
+
+func mapSortDirectionFromIn(direction tickets.SortDirection) entities.SortDirection {
+	switch direction {
+	case tickets.SortDirection_SORT_DIRECTION_DESC:
+		return entities.SortDirectionDesc
+	default:
+		return entities.SortDirectionAsc
+	}
+}
+
+func mapRespondStatusesFromIn(statuses []tickets.RespondStatus) []entities.RespondStatus {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	result := make([]entities.RespondStatus, len(statuses))
+	for i, status := range statuses {
+		result[i] = mapRespondStatusFromIn(status)
+	}
+
+	return result
+}
+
+func mapTimestampFromIn(timestamp *timestamppb.Timestamp) *time.Time {
+	if timestamp == nil {
+		return nil
+	}
+
+	result := timestamp.AsTime()
+
+	return &result
 }
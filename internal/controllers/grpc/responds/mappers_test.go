@@ -28,6 +28,8 @@ func TestMapRespondOut(t *testing.T) {
 				Comment:   pointers.New("Test"),
 				CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
 				UpdatedAt: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+				Status:    entities.RespondStatusAccepted,
+				Version:   3,
 			},
 			expected: &tickets.GetRespondOut{
 				ID:        1,
@@ -37,6 +39,8 @@ func TestMapRespondOut(t *testing.T) {
 				Comment:   pointers.New("Test"),
 				CreatedAt: timestamppb.New(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
 				UpdatedAt: timestamppb.New(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)),
+				Status:    tickets.RespondStatus_RESPOND_STATUS_ACCEPTED,
+				Version:   3,
 			},
 		},
 		{
@@ -49,6 +53,7 @@ func TestMapRespondOut(t *testing.T) {
 				Comment:   nil,
 				CreatedAt: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
 				UpdatedAt: time.Date(2023, 2, 2, 0, 0, 0, 0, time.UTC),
+				Status:    entities.RespondStatusPending,
 			},
 			expected: &tickets.GetRespondOut{
 				ID:        2,
@@ -58,6 +63,7 @@ func TestMapRespondOut(t *testing.T) {
 				Comment:   nil,
 				CreatedAt: timestamppb.New(time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)),
 				UpdatedAt: timestamppb.New(time.Date(2023, 2, 2, 0, 0, 0, 0, time.UTC)),
+				Status:    tickets.RespondStatus_RESPOND_STATUS_PENDING,
 			},
 		},
 		{
@@ -93,6 +99,7 @@ func TestMapRespondOut(t *testing.T) {
 			require.Equal(t, tc.expected.MasterID, result.MasterID)
 			require.Equal(t, tc.expected.Price, result.Price)
 			require.Equal(t, tc.expected.Comment, result.Comment)
+			require.Equal(t, tc.expected.Status, result.Status)
 
 			// Проверка временных меток
 			require.Equal(t, tc.expected.CreatedAt.AsTime(), result.CreatedAt.AsTime())
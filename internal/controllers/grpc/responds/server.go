@@ -10,9 +10,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/emptypb"
 
-	customgrpc "github.com/DKhorkov/libs/grpc"
-
 	"github.com/DKhorkov/hmtm-tickets/api/protobuf/generated/go/tickets"
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+	"github.com/DKhorkov/hmtm-tickets/internal/controllers/grpc/grpcerrors"
+	"github.com/DKhorkov/hmtm-tickets/internal/controllers/grpc/idempotency"
 	"github.com/DKhorkov/hmtm-tickets/internal/entities"
 	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
 	"github.com/DKhorkov/hmtm-tickets/internal/interfaces"
@@ -21,21 +22,32 @@ import (
 var (
 	respondNotFoundError      = &customerrors.RespondNotFoundError{}
 	respondAlreadyExistsError = &customerrors.RespondAlreadyExistsError{}
+	ticketIsNotOpenError      = &customerrors.TicketIsNotOpenError{}
+	ticketNotFoundError       = &customerrors.TicketNotFoundError{}
+	respondIsNotPendingError  = &customerrors.RespondIsNotPendingError{}
+	permissionDeniedError     = &customerrors.PermissionDeniedError{}
+	versionConflictError      = &customerrors.VersionConflictError{}
 )
 
 // RegisterServer handler (serverAPI) for RespondsServer to gRPC server:.
-func RegisterServer(gRPCServer *grpc.Server, useCases interfaces.UseCases, logger logging.Logger) {
+func RegisterServer(
+	gRPCServer *grpc.Server,
+	useCases interfaces.UseCases,
+	batchConfig config.BatchConfig,
+	logger logging.Logger,
+) {
 	tickets.RegisterRespondsServiceServer(
 		gRPCServer,
-		&ServerAPI{useCases: useCases, logger: logger},
+		&ServerAPI{useCases: useCases, batchConfig: batchConfig, logger: logger},
 	)
 }
 
 type ServerAPI struct {
 	// Helps to test single endpoints, if others is not implemented yet
 	tickets.UnimplementedRespondsServiceServer
-	useCases interfaces.UseCases
-	logger   logging.Logger
+	useCases    interfaces.UseCases
+	batchConfig config.BatchConfig
+	logger      logging.Logger
 }
 
 // UpdateRespond handler updates Respond with provided ID.
@@ -43,13 +55,15 @@ func (api *ServerAPI) UpdateRespond(
 	ctx context.Context,
 	in *tickets.UpdateRespondIn,
 ) (*emptypb.Empty, error) {
-	respondData := entities.UpdateRespondDTO{
-		ID: in.GetID(),
+	respondData := entities.RawUpdateRespondDTO{
+		ID:     in.GetID(),
+		UserID: in.GetUserID(),
 	}
 
 	if in != nil {
 		respondData.Price = in.Price
 		respondData.Comment = in.Comment
+		respondData.ExpectedVersion = in.ExpectedVersion
 	}
 
 	err := api.useCases.UpdateRespond(ctx, respondData)
@@ -63,9 +77,15 @@ func (api *ServerAPI) UpdateRespond(
 
 		switch {
 		case errors.As(err, &respondNotFoundError):
-			return nil, &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
+			return nil, grpcerrors.New(codes.NotFound, err)
+		case errors.As(err, &permissionDeniedError):
+			return nil, grpcerrors.New(codes.PermissionDenied, err)
+		case errors.As(err, &respondIsNotPendingError):
+			return nil, grpcerrors.New(codes.FailedPrecondition, err)
+		case errors.As(err, &versionConflictError):
+			return nil, grpcerrors.New(codes.Aborted, err)
 		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+			return nil, grpcerrors.New(codes.Internal, err)
 		}
 	}
 
@@ -77,7 +97,7 @@ func (api *ServerAPI) DeleteRespond(
 	ctx context.Context,
 	in *tickets.DeleteRespondIn,
 ) (*emptypb.Empty, error) {
-	err := api.useCases.DeleteRespond(ctx, in.GetID())
+	err := api.useCases.DeleteRespond(ctx, in.GetID(), in.GetUserID())
 	if err != nil {
 		logging.LogErrorContext(
 			ctx,
@@ -88,9 +108,70 @@ func (api *ServerAPI) DeleteRespond(
 
 		switch {
 		case errors.As(err, &respondNotFoundError):
-			return nil, &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
+			return nil, grpcerrors.New(codes.NotFound, err)
+		case errors.As(err, &permissionDeniedError):
+			return nil, grpcerrors.New(codes.PermissionDenied, err)
 		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+			return nil, grpcerrors.New(codes.Internal, err)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// AcceptRespond handler accepts Respond with provided ID, rejects other Responds for the same Ticket
+// and moves Ticket out of open pool.
+func (api *ServerAPI) AcceptRespond(
+	ctx context.Context,
+	in *tickets.AcceptRespondIn,
+) (*emptypb.Empty, error) {
+	err := api.useCases.AcceptRespond(ctx, in.GetID(), in.GetUserID())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to accept Respond with ID=%d", in.GetID()),
+			err,
+		)
+
+		switch {
+		case errors.As(err, &respondNotFoundError), errors.As(err, &ticketNotFoundError):
+			return nil, grpcerrors.New(codes.NotFound, err)
+		case errors.As(err, &respondIsNotPendingError), errors.As(err, &ticketIsNotOpenError):
+			return nil, grpcerrors.New(codes.FailedPrecondition, err)
+		case errors.As(err, &permissionDeniedError):
+			return nil, grpcerrors.New(codes.PermissionDenied, err)
+		default:
+			return nil, grpcerrors.New(codes.Internal, err)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RejectRespond handler rejects Respond with provided ID.
+func (api *ServerAPI) RejectRespond(
+	ctx context.Context,
+	in *tickets.RejectRespondIn,
+) (*emptypb.Empty, error) {
+	err := api.useCases.RejectRespond(ctx, in.GetID(), in.GetUserID())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to reject Respond with ID=%d", in.GetID()),
+			err,
+		)
+
+		switch {
+		case errors.As(err, &respondNotFoundError), errors.As(err, &ticketNotFoundError):
+			return nil, grpcerrors.New(codes.NotFound, err)
+		case errors.As(err, &respondIsNotPendingError):
+			return nil, grpcerrors.New(codes.FailedPrecondition, err)
+		case errors.As(err, &permissionDeniedError):
+			return nil, grpcerrors.New(codes.PermissionDenied, err)
+		default:
+			return nil, grpcerrors.New(codes.Internal, err)
 		}
 	}
 
@@ -102,10 +183,26 @@ func (api *ServerAPI) RespondToTicket(
 	ctx context.Context,
 	in *tickets.RespondToTicketIn,
 ) (*tickets.RespondToTicketOut, error) {
+	idempotencyKey, err := idempotency.Key(ctx, in.GetIdempotencyKey())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to process idempotency key for Respond to Ticket with ID=%d",
+				in.GetTicketID(),
+			),
+			err,
+		)
+
+		return nil, grpcerrors.New(codes.InvalidArgument, err)
+	}
+
 	respondData := entities.RawRespondToTicketDTO{
-		UserID:   in.GetUserID(),
-		TicketID: in.GetTicketID(),
-		Price:    in.GetPrice(),
+		UserID:         in.GetUserID(),
+		TicketID:       in.GetTicketID(),
+		Price:          in.GetPrice(),
+		IdempotencyKey: idempotencyKey,
 	}
 
 	if in != nil {
@@ -123,9 +220,11 @@ func (api *ServerAPI) RespondToTicket(
 
 		switch {
 		case errors.As(err, &respondAlreadyExistsError):
-			return nil, &customgrpc.BaseError{Status: codes.AlreadyExists, Message: err.Error()}
+			return nil, grpcerrors.New(codes.AlreadyExists, err)
+		case errors.As(err, &ticketIsNotOpenError):
+			return nil, grpcerrors.New(codes.FailedPrecondition, err)
 		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+			return nil, grpcerrors.New(codes.Internal, err)
 		}
 	}
 
@@ -148,21 +247,79 @@ func (api *ServerAPI) GetRespond(
 
 		switch {
 		case errors.As(err, &respondNotFoundError):
-			return nil, &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
+			return nil, grpcerrors.New(codes.NotFound, err)
 		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+			return nil, grpcerrors.New(codes.Internal, err)
 		}
 	}
 
 	return mapRespondOut(*respond), nil
 }
 
+// GetRespondsByIDs handler returns Responds for provided IDs in the same order and IDs of not found Responds.
+// Number of IDs is limited by BatchConfig.MaxIDs, so IDs are not logged.
+func (api *ServerAPI) GetRespondsByIDs(
+	ctx context.Context,
+	in *tickets.GetRespondsByIDsIn,
+) (*tickets.GetRespondsByIDsOut, error) {
+	if len(in.GetIDs()) > api.batchConfig.MaxIDs {
+		err := &customerrors.TooManyIDsError{
+			Message: fmt.Sprintf("at most %d IDs can be requested at once", api.batchConfig.MaxIDs),
+		}
+
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to get %d Responds by IDs", len(in.GetIDs())),
+			err,
+		)
+
+		return nil, grpcerrors.New(codes.InvalidArgument, err)
+	}
+
+	foundResponds, missingIDs, err := api.useCases.GetRespondsByIDs(ctx, in.GetIDs())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to get %d Responds by IDs", len(in.GetIDs())),
+			err,
+		)
+
+		return nil, grpcerrors.New(codes.Internal, err)
+	}
+
+	processedResponds := make([]*tickets.GetRespondOut, len(foundResponds))
+	for i, respond := range foundResponds {
+		processedResponds[i] = mapRespondOut(respond)
+	}
+
+	return &tickets.GetRespondsByIDsOut{Responds: processedResponds, MissingIDs: missingIDs}, nil
+}
+
 // GetTicketResponds handler returns Responds for provided Ticket ID.
 func (api *ServerAPI) GetTicketResponds(
 	ctx context.Context,
 	in *tickets.GetTicketRespondsIn,
 ) (*tickets.GetRespondsOut, error) {
-	ticketResponds, err := api.useCases.GetTicketResponds(ctx, in.GetTicketID())
+	pagination, err := mapPaginationFromIn(in.GetPagination())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to process pagination for Responds for Ticket with ID=%d",
+				in.GetTicketID(),
+			),
+			err,
+		)
+
+		return nil, grpcerrors.New(codes.InvalidArgument, err)
+	}
+
+	filters := mapRespondsFiltersWithSortFromIn(in.GetFilters(), in.GetSort())
+
+	ticketResponds, err := api.useCases.GetTicketResponds(ctx, in.GetTicketID(), pagination, filters)
 	if err != nil {
 		logging.LogErrorContext(
 			ctx,
@@ -174,7 +331,7 @@ func (api *ServerAPI) GetTicketResponds(
 			err,
 		)
 
-		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+		return nil, grpcerrors.New(codes.Internal, err)
 	}
 
 	processedResponds := make([]*tickets.GetRespondOut, len(ticketResponds))
@@ -185,12 +342,49 @@ func (api *ServerAPI) GetTicketResponds(
 	return &tickets.GetRespondsOut{Responds: processedResponds}, nil
 }
 
+// CountTicketResponds handler returns number of Responds for Ticket with provided ID, which satisfy filters.
+func (api *ServerAPI) CountTicketResponds(
+	ctx context.Context,
+	in *tickets.CountTicketRespondsIn,
+) (*tickets.CountOut, error) {
+	count, err := api.useCases.CountTicketResponds(ctx, in.GetTicketID(), mapRespondsFiltersFromIn(in.GetFilters()))
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to count Responds for Ticket with ID=%d", in.GetTicketID()),
+			err,
+		)
+
+		return nil, grpcerrors.New(codes.Internal, err)
+	}
+
+	return &tickets.CountOut{Count: count}, nil
+}
+
 // GetUserResponds handler returns Responds for User with provided ID.
 func (api *ServerAPI) GetUserResponds(
 	ctx context.Context,
 	in *tickets.GetUserRespondsIn,
 ) (*tickets.GetRespondsOut, error) {
-	userResponds, err := api.useCases.GetUserResponds(ctx, in.GetUserID())
+	pagination, err := mapPaginationFromIn(in.GetPagination())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to process pagination for Responds for User with ID=%d",
+				in.GetUserID(),
+			),
+			err,
+		)
+
+		return nil, grpcerrors.New(codes.InvalidArgument, err)
+	}
+
+	filters := mapRespondsFiltersWithSortFromIn(in.GetFilters(), in.GetSort())
+
+	userResponds, err := api.useCases.GetUserResponds(ctx, in.GetUserID(), pagination, filters)
 	if err != nil {
 		logging.LogErrorContext(
 			ctx,
@@ -202,7 +396,7 @@ func (api *ServerAPI) GetUserResponds(
 			err,
 		)
 
-		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+		return nil, grpcerrors.New(codes.Internal, err)
 	}
 
 	processedResponds := make([]*tickets.GetRespondOut, len(userResponds))
@@ -212,3 +406,23 @@ func (api *ServerAPI) GetUserResponds(
 
 	return &tickets.GetRespondsOut{Responds: processedResponds}, nil
 }
+
+// CountUserResponds handler returns number of Responds for User with provided ID, which satisfy filters.
+func (api *ServerAPI) CountUserResponds(
+	ctx context.Context,
+	in *tickets.CountUserRespondsIn,
+) (*tickets.CountOut, error) {
+	count, err := api.useCases.CountUserResponds(ctx, in.GetUserID(), mapRespondsFiltersFromIn(in.GetFilters()))
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to count Responds for User with ID=%d", in.GetUserID()),
+			err,
+		)
+
+		return nil, grpcerrors.New(codes.Internal, err)
+	}
+
+	return &tickets.CountOut{Count: count}, nil
+}
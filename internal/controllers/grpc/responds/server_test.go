@@ -3,6 +3,7 @@ package responds
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,11 +13,12 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
-	customgrpc "github.com/DKhorkov/libs/grpc"
 	mocklogging "github.com/DKhorkov/libs/logging/mocks"
 	"github.com/DKhorkov/libs/pointers"
 
 	"github.com/DKhorkov/hmtm-tickets/api/protobuf/generated/go/tickets"
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+	"github.com/DKhorkov/hmtm-tickets/internal/controllers/grpc/grpcerrors"
 	"github.com/DKhorkov/hmtm-tickets/internal/entities"
 	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
 	mockusecases "github.com/DKhorkov/hmtm-tickets/mocks/usecases"
@@ -42,14 +44,16 @@ func TestServerAPI_UpdateRespond(t *testing.T) {
 			name: "success",
 			in: &tickets.UpdateRespondIn{
 				ID:      1,
+				UserID:  2,
 				Price:   pointers.New[float32](200),
 				Comment: pointers.New("Updated comment"),
 			},
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				useCases.
 					EXPECT().
-					UpdateRespond(gomock.Any(), entities.UpdateRespondDTO{
+					UpdateRespond(gomock.Any(), entities.RawUpdateRespondDTO{
 						ID:      1,
+						UserID:  2,
 						Price:   pointers.New[float32](200),
 						Comment: pointers.New("Updated comment"),
 					}).
@@ -67,7 +71,7 @@ func TestServerAPI_UpdateRespond(t *testing.T) {
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				useCases.
 					EXPECT().
-					UpdateRespond(gomock.Any(), entities.UpdateRespondDTO{ID: 1}).
+					UpdateRespond(gomock.Any(), entities.RawUpdateRespondDTO{ID: 1}).
 					Return(&customerrors.RespondNotFoundError{}).
 					Times(1)
 
@@ -76,7 +80,66 @@ func TestServerAPI_UpdateRespond(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.NotFound, Message: "respond not found"},
+			expectedErr:   grpcerrors.New(codes.NotFound, &customerrors.RespondNotFoundError{}),
+			errorExpected: true,
+		},
+		{
+			name: "permission denied error",
+			in:   &tickets.UpdateRespondIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateRespond(gomock.Any(), entities.RawUpdateRespondDTO{ID: 1, UserID: 2}).
+					Return(&customerrors.PermissionDeniedError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.PermissionDenied, &customerrors.PermissionDeniedError{}),
+			errorExpected: true,
+		},
+		{
+			name: "version conflict error",
+			in:   &tickets.UpdateRespondIn{ID: 1, ExpectedVersion: pointers.New[uint64](2)},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateRespond(gomock.Any(), entities.RawUpdateRespondDTO{
+						ID:              1,
+						ExpectedVersion: pointers.New[uint64](2),
+					}).
+					Return(&customerrors.VersionConflictError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.Aborted, &customerrors.VersionConflictError{}),
+			errorExpected: true,
+		},
+		{
+			name: "respond is not pending error",
+			in: &tickets.UpdateRespondIn{
+				ID: 1,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateRespond(gomock.Any(), entities.RawUpdateRespondDTO{ID: 1}).
+					Return(&customerrors.RespondIsNotPendingError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.FailedPrecondition, &customerrors.RespondIsNotPendingError{}),
 			errorExpected: true,
 		},
 		{
@@ -87,7 +150,7 @@ func TestServerAPI_UpdateRespond(t *testing.T) {
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				useCases.
 					EXPECT().
-					UpdateRespond(gomock.Any(), entities.UpdateRespondDTO{ID: 1}).
+					UpdateRespond(gomock.Any(), entities.RawUpdateRespondDTO{ID: 1}).
 					Return(errors.New("internal error")).
 					Times(1)
 
@@ -96,7 +159,7 @@ func TestServerAPI_UpdateRespond(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "internal error"},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
 			errorExpected: true,
 		},
 	}
@@ -139,11 +202,11 @@ func TestServerAPI_DeleteRespond(t *testing.T) {
 	}{
 		{
 			name: "success",
-			in:   &tickets.DeleteRespondIn{ID: 1},
+			in:   &tickets.DeleteRespondIn{ID: 1, UserID: 2},
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				useCases.
 					EXPECT().
-					DeleteRespond(gomock.Any(), uint64(1)).
+					DeleteRespond(gomock.Any(), uint64(1), uint64(2)).
 					Return(nil).
 					Times(1)
 			},
@@ -152,11 +215,11 @@ func TestServerAPI_DeleteRespond(t *testing.T) {
 		},
 		{
 			name: "not found error",
-			in:   &tickets.DeleteRespondIn{ID: 1},
+			in:   &tickets.DeleteRespondIn{ID: 1, UserID: 2},
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				useCases.
 					EXPECT().
-					DeleteRespond(gomock.Any(), uint64(1)).
+					DeleteRespond(gomock.Any(), uint64(1), uint64(2)).
 					Return(&customerrors.RespondNotFoundError{}).
 					Times(1)
 
@@ -165,16 +228,34 @@ func TestServerAPI_DeleteRespond(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.NotFound, Message: "respond not found"},
+			expectedErr:   grpcerrors.New(codes.NotFound, &customerrors.RespondNotFoundError{}),
+			errorExpected: true,
+		},
+		{
+			name: "permission denied error",
+			in:   &tickets.DeleteRespondIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					DeleteRespond(gomock.Any(), uint64(1), uint64(2)).
+					Return(&customerrors.PermissionDeniedError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.PermissionDenied, &customerrors.PermissionDeniedError{}),
 			errorExpected: true,
 		},
 		{
 			name: "internal error",
-			in:   &tickets.DeleteRespondIn{ID: 1},
+			in:   &tickets.DeleteRespondIn{ID: 1, UserID: 2},
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				useCases.
 					EXPECT().
-					DeleteRespond(gomock.Any(), uint64(1)).
+					DeleteRespond(gomock.Any(), uint64(1), uint64(2)).
 					Return(errors.New("internal error")).
 					Times(1)
 
@@ -183,7 +264,7 @@ func TestServerAPI_DeleteRespond(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "internal error"},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
 			errorExpected: true,
 		},
 	}
@@ -247,6 +328,46 @@ func TestServerAPI_RespondToTicket(t *testing.T) {
 			expectedErr:   nil,
 			errorExpected: false,
 		},
+		{
+			name: "with idempotency key",
+			in: &tickets.RespondToTicketIn{
+				UserID:         1,
+				TicketID:       2,
+				IdempotencyKey: pointers.New("key"),
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					RespondToTicket(gomock.Any(), entities.RawRespondToTicketDTO{
+						UserID:         1,
+						TicketID:       2,
+						IdempotencyKey: pointers.New("key"),
+					}).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+			expectedOut:   &tickets.RespondToTicketOut{RespondID: 1},
+			errorExpected: false,
+		},
+		{
+			name: "invalid idempotency key",
+			in: &tickets.RespondToTicketIn{
+				UserID:         1,
+				TicketID:       2,
+				IdempotencyKey: pointers.New(strings.Repeat("k", 256)),
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr: grpcerrors.New(
+				codes.InvalidArgument,
+				&customerrors.InvalidIdempotencyKeyError{Message: "idempotency key must not be longer than 255 characters"},
+			),
+			errorExpected: true,
+		},
 		{
 			name: "already exists error",
 			in: &tickets.RespondToTicketIn{
@@ -268,7 +389,31 @@ func TestServerAPI_RespondToTicket(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.AlreadyExists, Message: "respond already exists"},
+			expectedErr:   grpcerrors.New(codes.AlreadyExists, &customerrors.RespondAlreadyExistsError{}),
+			errorExpected: true,
+		},
+		{
+			name: "ticket is not open error",
+			in: &tickets.RespondToTicketIn{
+				UserID:   1,
+				TicketID: 2,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					RespondToTicket(gomock.Any(), entities.RawRespondToTicketDTO{
+						UserID:   1,
+						TicketID: 2,
+					}).
+					Return(uint64(0), &customerrors.TicketIsNotOpenError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.FailedPrecondition, &customerrors.TicketIsNotOpenError{}),
 			errorExpected: true,
 		},
 		{
@@ -292,7 +437,7 @@ func TestServerAPI_RespondToTicket(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "internal error"},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
 			errorExpected: true,
 		},
 	}
@@ -378,7 +523,7 @@ func TestServerAPI_GetRespond(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.NotFound, Message: "respond not found"},
+			expectedErr:   grpcerrors.New(codes.NotFound, &customerrors.RespondNotFoundError{}),
 			errorExpected: true,
 		},
 		{
@@ -396,7 +541,7 @@ func TestServerAPI_GetRespond(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "internal error"},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
 			errorExpected: true,
 		},
 	}
@@ -420,6 +565,130 @@ func TestServerAPI_GetRespond(t *testing.T) {
 	}
 }
 
+func TestServerAPI_GetRespondsByIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases:    useCases,
+		batchConfig: config.BatchConfig{MaxIDs: 3},
+		logger:      logger,
+	}
+
+	testCases := []struct {
+		name          string
+		in            *tickets.GetRespondsByIDsIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedOut   *tickets.GetRespondsByIDsOut
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			in:   &tickets.GetRespondsByIDsIn{IDs: []uint64{2, 1, 3}},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				responds := []entities.Respond{
+					{
+						ID:        2,
+						TicketID:  1,
+						MasterID:  1,
+						Price:     100,
+						CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:        1,
+						TicketID:  1,
+						MasterID:  2,
+						Price:     200,
+						CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+				}
+
+				useCases.
+					EXPECT().
+					GetRespondsByIDs(gomock.Any(), []uint64{2, 1, 3}).
+					Return(responds, []uint64{3}, nil).
+					Times(1)
+			},
+			expectedOut: &tickets.GetRespondsByIDsOut{
+				Responds: []*tickets.GetRespondOut{
+					{
+						ID:        2,
+						TicketID:  1,
+						MasterID:  1,
+						Price:     100,
+						CreatedAt: timestamppb.New(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+						UpdatedAt: timestamppb.New(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)),
+					},
+					{
+						ID:        1,
+						TicketID:  1,
+						MasterID:  2,
+						Price:     200,
+						CreatedAt: timestamppb.New(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+						UpdatedAt: timestamppb.New(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)),
+					},
+				},
+				MissingIDs: []uint64{3},
+			},
+			errorExpected: false,
+		},
+		{
+			name: "too many IDs",
+			in:   &tickets.GetRespondsByIDsIn{IDs: []uint64{1, 2, 3, 4}},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr: grpcerrors.New(
+				codes.InvalidArgument,
+				&customerrors.TooManyIDsError{Message: "at most 3 IDs can be requested at once"},
+			),
+			errorExpected: true,
+		},
+		{
+			name: "internal error",
+			in:   &tickets.GetRespondsByIDsIn{IDs: []uint64{1}},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					GetRespondsByIDs(gomock.Any(), []uint64{1}).
+					Return(nil, nil, errors.New("internal error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.GetRespondsByIDs(context.Background(), tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, resp)
+			}
+		})
+	}
+}
+
 func TestServerAPI_GetTicketResponds(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	useCases := mockusecases.NewMockUseCases(ctrl)
@@ -455,7 +724,7 @@ func TestServerAPI_GetTicketResponds(t *testing.T) {
 
 				useCases.
 					EXPECT().
-					GetTicketResponds(gomock.Any(), uint64(1)).
+					GetTicketResponds(gomock.Any(), uint64(1), nil, nil).
 					Return(responds, nil).
 					Times(1)
 			},
@@ -474,13 +743,77 @@ func TestServerAPI_GetTicketResponds(t *testing.T) {
 			expectedErr:   nil,
 			errorExpected: false,
 		},
+		{
+			name: "with pagination, filters and sort",
+			in: &tickets.GetTicketRespondsIn{
+				TicketID: 1,
+				Pagination: &tickets.Pagination{
+					Limit:  pointers.New[uint64](10),
+					Offset: pointers.New[uint64](20),
+				},
+				Filters: &tickets.RespondsFilters{
+					PriceFloor:    pointers.New[float32](100),
+					Statuses:      []tickets.RespondStatus{tickets.RespondStatus_RESPOND_STATUS_PENDING},
+					CreatedAtFrom: timestamppb.New(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+				Sort: []*tickets.RespondsSort{
+					{
+						Field:     tickets.RespondsSortField_RESPONDS_SORT_FIELD_PRICE,
+						Direction: tickets.SortDirection_SORT_DIRECTION_ASC,
+					},
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					GetTicketResponds(
+						gomock.Any(),
+						uint64(1),
+						&entities.Pagination{
+							Limit:  pointers.New[uint64](10),
+							Offset: pointers.New[uint64](20),
+						},
+						&entities.RespondsFilters{
+							PriceFloor:    pointers.New[float32](100),
+							Statuses:      []entities.RespondStatus{entities.RespondStatusPending},
+							CreatedAtFrom: pointers.New(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+							Sort: []entities.RespondsSort{
+								{Field: entities.RespondsSortFieldPrice, Direction: entities.SortDirectionAsc},
+							},
+						},
+					).
+					Return([]entities.Respond{}, nil).
+					Times(1)
+			},
+			expectedOut:   &tickets.GetRespondsOut{Responds: []*tickets.GetRespondOut{}},
+			expectedErr:   nil,
+			errorExpected: false,
+		},
+		{
+			name: "cursor pagination is not supported",
+			in: &tickets.GetTicketRespondsIn{
+				TicketID:   1,
+				Pagination: &tickets.Pagination{Cursor: pointers.New("cursor")},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr: grpcerrors.New(
+				codes.InvalidArgument,
+				&customerrors.InvalidCursorError{Message: "pagination cursor is not supported for Responds"},
+			),
+			errorExpected: true,
+		},
 		{
 			name: "internal error",
 			in:   &tickets.GetTicketRespondsIn{TicketID: 1},
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				useCases.
 					EXPECT().
-					GetTicketResponds(gomock.Any(), uint64(1)).
+					GetTicketResponds(gomock.Any(), uint64(1), nil, nil).
 					Return(nil, errors.New("internal error")).
 					Times(1)
 
@@ -489,7 +822,7 @@ func TestServerAPI_GetTicketResponds(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "internal error"},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
 			errorExpected: true,
 		},
 	}
@@ -548,7 +881,7 @@ func TestServerAPI_GetUserResponds(t *testing.T) {
 
 				useCases.
 					EXPECT().
-					GetUserResponds(gomock.Any(), uint64(1)).
+					GetUserResponds(gomock.Any(), uint64(1), nil, nil).
 					Return(responds, nil).
 					Times(1)
 			},
@@ -573,7 +906,7 @@ func TestServerAPI_GetUserResponds(t *testing.T) {
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				useCases.
 					EXPECT().
-					GetUserResponds(gomock.Any(), uint64(1)).
+					GetUserResponds(gomock.Any(), uint64(1), nil, nil).
 					Return(nil, errors.New("internal error")).
 					Times(1)
 
@@ -582,7 +915,7 @@ func TestServerAPI_GetUserResponds(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "internal error"},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
 			errorExpected: true,
 		},
 	}
@@ -605,3 +938,438 @@ func TestServerAPI_GetUserResponds(t *testing.T) {
 		})
 	}
 }
+
+func TestServerAPI_CountTicketResponds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	testCases := []struct {
+		name          string
+		in            *tickets.CountTicketRespondsIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedOut   *tickets.CountOut
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			in: &tickets.CountTicketRespondsIn{
+				TicketID: 1,
+				Filters: &tickets.RespondsFilters{
+					PriceCeil: pointers.New[float32](500),
+					Statuses:  []tickets.RespondStatus{tickets.RespondStatus_RESPOND_STATUS_ACCEPTED},
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					CountTicketResponds(
+						gomock.Any(),
+						uint64(1),
+						&entities.RespondsFilters{
+							PriceCeil: pointers.New[float32](500),
+							Statuses:  []entities.RespondStatus{entities.RespondStatusAccepted},
+						},
+					).
+					Return(uint64(3), nil).
+					Times(1)
+			},
+			expectedOut:   &tickets.CountOut{Count: 3},
+			expectedErr:   nil,
+			errorExpected: false,
+		},
+		{
+			name: "internal error",
+			in:   &tickets.CountTicketRespondsIn{TicketID: 1},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					CountTicketResponds(gomock.Any(), uint64(1), nil).
+					Return(uint64(0), errors.New("internal error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.CountTicketResponds(context.Background(), tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, resp)
+			}
+		})
+	}
+}
+
+func TestServerAPI_CountUserResponds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	testCases := []struct {
+		name          string
+		in            *tickets.CountUserRespondsIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedOut   *tickets.CountOut
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			in:   &tickets.CountUserRespondsIn{UserID: 1},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					CountUserResponds(gomock.Any(), uint64(1), nil).
+					Return(uint64(7), nil).
+					Times(1)
+			},
+			expectedOut:   &tickets.CountOut{Count: 7},
+			expectedErr:   nil,
+			errorExpected: false,
+		},
+		{
+			name: "internal error",
+			in:   &tickets.CountUserRespondsIn{UserID: 1},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					CountUserResponds(gomock.Any(), uint64(1), nil).
+					Return(uint64(0), errors.New("internal error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.CountUserResponds(context.Background(), tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, resp)
+			}
+		})
+	}
+}
+
+func TestServerAPI_AcceptRespond(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	testCases := []struct {
+		name          string
+		in            *tickets.AcceptRespondIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			in:   &tickets.AcceptRespondIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					AcceptRespond(gomock.Any(), uint64(1), uint64(2)).
+					Return(nil).
+					Times(1)
+			},
+			expectedErr:   nil,
+			errorExpected: false,
+		},
+		{
+			name: "respond not found error",
+			in:   &tickets.AcceptRespondIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					AcceptRespond(gomock.Any(), uint64(1), uint64(2)).
+					Return(&customerrors.RespondNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.NotFound, &customerrors.RespondNotFoundError{}),
+			errorExpected: true,
+		},
+		{
+			name: "ticket not found error",
+			in:   &tickets.AcceptRespondIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					AcceptRespond(gomock.Any(), uint64(1), uint64(2)).
+					Return(&customerrors.TicketNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.NotFound, &customerrors.TicketNotFoundError{}),
+			errorExpected: true,
+		},
+		{
+			name: "respond is not pending error",
+			in:   &tickets.AcceptRespondIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					AcceptRespond(gomock.Any(), uint64(1), uint64(2)).
+					Return(&customerrors.RespondIsNotPendingError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.FailedPrecondition, &customerrors.RespondIsNotPendingError{}),
+			errorExpected: true,
+		},
+		{
+			name: "ticket is not open error",
+			in:   &tickets.AcceptRespondIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					AcceptRespond(gomock.Any(), uint64(1), uint64(2)).
+					Return(&customerrors.TicketIsNotOpenError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.FailedPrecondition, &customerrors.TicketIsNotOpenError{}),
+			errorExpected: true,
+		},
+		{
+			name: "permission denied error",
+			in:   &tickets.AcceptRespondIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					AcceptRespond(gomock.Any(), uint64(1), uint64(2)).
+					Return(&customerrors.PermissionDeniedError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.PermissionDenied, &customerrors.PermissionDeniedError{}),
+			errorExpected: true,
+		},
+		{
+			name: "internal error",
+			in:   &tickets.AcceptRespondIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					AcceptRespond(gomock.Any(), uint64(1), uint64(2)).
+					Return(errors.New("internal error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.AcceptRespond(context.Background(), tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+				require.IsType(t, &emptypb.Empty{}, resp)
+			}
+		})
+	}
+}
+
+func TestServerAPI_RejectRespond(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	testCases := []struct {
+		name          string
+		in            *tickets.RejectRespondIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			in:   &tickets.RejectRespondIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					RejectRespond(gomock.Any(), uint64(1), uint64(2)).
+					Return(nil).
+					Times(1)
+			},
+			expectedErr:   nil,
+			errorExpected: false,
+		},
+		{
+			name: "respond not found error",
+			in:   &tickets.RejectRespondIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					RejectRespond(gomock.Any(), uint64(1), uint64(2)).
+					Return(&customerrors.RespondNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.NotFound, &customerrors.RespondNotFoundError{}),
+			errorExpected: true,
+		},
+		{
+			name: "respond is not pending error",
+			in:   &tickets.RejectRespondIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					RejectRespond(gomock.Any(), uint64(1), uint64(2)).
+					Return(&customerrors.RespondIsNotPendingError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.FailedPrecondition, &customerrors.RespondIsNotPendingError{}),
+			errorExpected: true,
+		},
+		{
+			name: "permission denied error",
+			in:   &tickets.RejectRespondIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					RejectRespond(gomock.Any(), uint64(1), uint64(2)).
+					Return(&customerrors.PermissionDeniedError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.PermissionDenied, &customerrors.PermissionDeniedError{}),
+			errorExpected: true,
+		},
+		{
+			name: "internal error",
+			in:   &tickets.RejectRespondIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					RejectRespond(gomock.Any(), uint64(1), uint64(2)).
+					Return(errors.New("internal error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.RejectRespond(context.Background(), tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+				require.IsType(t, &emptypb.Empty{}, resp)
+			}
+		})
+	}
+}
@@ -1,10 +1,14 @@
 package tickets
 
 import (
+	"fmt"
+	"time"
+
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/DKhorkov/hmtm-tickets/api/protobuf/generated/go/tickets"
 	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
 )
 
 func mapTicketToOut(ticket entities.Ticket) *tickets.GetTicketOut {
@@ -31,5 +35,210 @@ func mapTicketToOut(ticket entities.Ticket) *tickets.GetTicketOut {
 		Attachments: attachments,
 		CreatedAt:   timestamppb.New(ticket.CreatedAt),
 		UpdatedAt:   timestamppb.New(ticket.UpdatedAt),
+		Status:      mapTicketStatusToOut(ticket.Status),
+		Version:     ticket.Version,
+	}
+}
+
+func mapTicketStatusToOut(status entities.TicketStatus) tickets.TicketStatus {
+	switch status {
+	case entities.TicketStatusOpen:
+		return tickets.TicketStatus_TICKET_STATUS_OPEN
+	case entities.TicketStatusInProgress:
+		return tickets.TicketStatus_TICKET_STATUS_IN_PROGRESS
+	case entities.TicketStatusCompleted:
+		return tickets.TicketStatus_TICKET_STATUS_COMPLETED
+	case entities.TicketStatusCancelled:
+		return tickets.TicketStatus_TICKET_STATUS_CANCELLED
+	default:
+		return tickets.TicketStatus_TICKET_STATUS_UNSPECIFIED
+	}
+}
+
+func mapTicketEventTypeToOut(eventType entities.TicketEventType) tickets.TicketEventType {
+	switch eventType {
+	case entities.TicketEventTypeSnapshot:
+		return tickets.TicketEventType_TICKET_EVENT_TYPE_SNAPSHOT
+	case entities.TicketEventTypeCreated:
+		return tickets.TicketEventType_TICKET_EVENT_TYPE_CREATED
+	case entities.TicketEventTypeUpdated:
+		return tickets.TicketEventType_TICKET_EVENT_TYPE_UPDATED
+	case entities.TicketEventTypeDeleted:
+		return tickets.TicketEventType_TICKET_EVENT_TYPE_DELETED
+	default:
+		return tickets.TicketEventType_TICKET_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func mapTicketStatusFromIn(status tickets.TicketStatus) entities.TicketStatus {
+	switch status {
+	case tickets.TicketStatus_TICKET_STATUS_OPEN:
+		return entities.TicketStatusOpen
+	case tickets.TicketStatus_TICKET_STATUS_IN_PROGRESS:
+		return entities.TicketStatusInProgress
+	case tickets.TicketStatus_TICKET_STATUS_COMPLETED:
+		return entities.TicketStatusCompleted
+	case tickets.TicketStatus_TICKET_STATUS_CANCELLED:
+		return entities.TicketStatusCancelled
+	default:
+		return ""
+	}
+}
+
+func mapTicketsFiltersFromIn(in *tickets.TicketsFilters) *entities.TicketsFilters {
+	if in == nil {
+		return nil
+	}
+
+	return &entities.TicketsFilters{
+		Search:              in.Search,
+		PriceCeil:           in.PriceCeil,
+		PriceFloor:          in.PriceFloor,
+		QuantityFloor:       in.QuantityFloor,
+		CategoryIDs:         in.CategoryIDs,
+		TagIDs:              in.TagIDs,
+		CreatedAtOrderByAsc: in.CreatedAtOrderByAsc,
+		Statuses:            mapTicketStatusesFromIn(in.GetStatuses()),
+		CreatedAtFrom:       mapTimestampFromIn(in.GetCreatedAtFrom()),
+		CreatedAtTo:         mapTimestampFromIn(in.GetCreatedAtTo()),
+		UpdatedAtFrom:       mapTimestampFromIn(in.GetUpdatedAtFrom()),
+		UpdatedAtTo:         mapTimestampFromIn(in.GetUpdatedAtTo()),
+	}
+}
+
+func mapTimestampFromIn(timestamp *timestamppb.Timestamp) *time.Time {
+	if timestamp == nil {
+		return nil
+	}
+
+	result := timestamp.AsTime()
+
+	return &result
+}
+
+// mapTicketsFiltersWithSortFromIn is used for listing Tickets, where sort keys are provided
+// separately from filters. Unspecified and unknown sort fields are rejected instead of being ignored,
+// as well as relevance sort field without search.
+func mapTicketsFiltersWithSortFromIn(
+	in *tickets.TicketsFilters,
+	sort []*tickets.TicketsSort,
+) (*entities.TicketsFilters, error) {
+	filters := mapTicketsFiltersFromIn(in)
+	if len(sort) == 0 {
+		return filters, nil
+	}
+
+	if filters == nil {
+		filters = &entities.TicketsFilters{}
 	}
+
+	filters.Sort = make([]entities.TicketsSort, len(sort))
+	for i, key := range sort {
+		field := mapTicketsSortFieldFromIn(key.GetField())
+		switch {
+		case field == "":
+			return nil, &customerrors.InvalidSortError{
+				Message: fmt.Sprintf("sort field %s is not allowed", key.GetField()),
+				Index:   i,
+			}
+		case field == entities.TicketsSortFieldRelevance && (filters.Search == nil || *filters.Search == ""):
+			return nil, &customerrors.InvalidSortError{
+				Message: fmt.Sprintf("sort field %s requires search", key.GetField()),
+				Index:   i,
+			}
+		}
+
+		filters.Sort[i] = entities.TicketsSort{
+			Field:     field,
+			Direction: mapSortDirectionFromIn(key.GetDirection()),
+		}
+	}
+
+	return filters, nil
+}
+
+func mapTicketsSortFieldFromIn(field tickets.TicketsSortField) entities.TicketsSortField {
+	switch field {
+	case tickets.TicketsSortField_TICKETS_SORT_FIELD_PRICE:
+		return entities.TicketsSortFieldPrice
+	case tickets.TicketsSortField_TICKETS_SORT_FIELD_QUANTITY:
+		return entities.TicketsSortFieldQuantity
+	case tickets.TicketsSortField_TICKETS_SORT_FIELD_UPDATED_AT:
+		return entities.TicketsSortFieldUpdatedAt
+	case tickets.TicketsSortField_TICKETS_SORT_FIELD_RESPONDS_COUNT:
+		return entities.TicketsSortFieldRespondsCount
+	case tickets.TicketsSortField_TICKETS_SORT_FIELD_NAME:
+		return entities.TicketsSortFieldName
+	case tickets.TicketsSortField_TICKETS_SORT_FIELD_RELEVANCE:
+		return entities.TicketsSortFieldRelevance
+	default:
+		return ""
+	}
+}
+
+func mapSortDirectionFromIn(direction tickets.SortDirection) entities.SortDirection {
+	switch direction {
+	case tickets.SortDirection_SORT_DIRECTION_DESC:
+		return entities.SortDirectionDesc
+	default:
+		return entities.SortDirectionAsc
+	}
+}
+
+func mapTicketStatusesFromIn(statuses []tickets.TicketStatus) []entities.TicketStatus {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	result := make([]entities.TicketStatus, len(statuses))
+	for i, status := range statuses {
+		result[i] = mapTicketStatusFromIn(status)
+	}
+
+	return result
+}
+
+// mapTicketUpdateMaskFromIn returns nil for absent or empty mask, so all fields are updated.
+// Not nullable fields can not be cleared, so they must be provided, if they are listed in mask.
+func mapTicketUpdateMaskFromIn(in *tickets.UpdateTicketIn) ([]entities.TicketUpdateField, error) {
+	paths := in.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	updateMask := make([]entities.TicketUpdateField, len(paths))
+	for i, path := range paths {
+		field := entities.TicketUpdateField(path)
+
+		var provided bool
+
+		switch field {
+		case entities.TicketUpdateFieldCategoryID:
+			provided = in.CategoryID != nil
+		case entities.TicketUpdateFieldName:
+			provided = in.Name != nil
+		case entities.TicketUpdateFieldDescription:
+			provided = in.Description != nil
+		case entities.TicketUpdateFieldQuantity:
+			provided = in.Quantity != nil
+		case entities.TicketUpdateFieldPrice,
+			entities.TicketUpdateFieldTagIDs,
+			entities.TicketUpdateFieldAttachments:
+			provided = true // can be cleared
+		default:
+			return nil, &customerrors.InvalidUpdateMaskError{
+				Message: fmt.Sprintf("unknown update mask path %q", path),
+			}
+		}
+
+		if !provided {
+			return nil, &customerrors.InvalidUpdateMaskError{
+				Message: fmt.Sprintf("field %q is listed in update mask, but not provided", path),
+			}
+		}
+
+		updateMask[i] = field
+	}
+
+	return updateMask, nil
 }
@@ -5,10 +5,12 @@ import (
 	"testing"
 	"time"
 
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/DKhorkov/hmtm-tickets/api/protobuf/generated/go/tickets"
 	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -47,6 +49,8 @@ func TestMapTicketToOut(t *testing.T) {
 				},
 				CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
 				UpdatedAt: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+				Status:    entities.TicketStatusInProgress,
+				Version:   2,
 			},
 			expected: &tickets.GetTicketOut{
 				ID:          1,
@@ -75,6 +79,8 @@ func TestMapTicketToOut(t *testing.T) {
 				},
 				CreatedAt: timestamppb.New(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
 				UpdatedAt: timestamppb.New(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)),
+				Status:    tickets.TicketStatus_TICKET_STATUS_IN_PROGRESS,
+				Version:   2,
 			},
 		},
 		{
@@ -91,6 +97,7 @@ func TestMapTicketToOut(t *testing.T) {
 				Attachments: []entities.Attachment{},
 				CreatedAt:   time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
 				UpdatedAt:   time.Date(2023, 2, 2, 0, 0, 0, 0, time.UTC),
+				Status:      entities.TicketStatusOpen,
 			},
 			expected: &tickets.GetTicketOut{
 				ID:          2,
@@ -104,6 +111,7 @@ func TestMapTicketToOut(t *testing.T) {
 				Attachments: []*tickets.Attachment{},
 				CreatedAt:   timestamppb.New(time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)),
 				UpdatedAt:   timestamppb.New(time.Date(2023, 2, 2, 0, 0, 0, 0, time.UTC)),
+				Status:      tickets.TicketStatus_TICKET_STATUS_OPEN,
 			},
 		},
 		{
@@ -143,6 +151,7 @@ func TestMapTicketToOut(t *testing.T) {
 			require.Equal(t, tc.expected.Price, result.Price)
 			require.Equal(t, tc.expected.Quantity, result.Quantity)
 			require.Equal(t, tc.expected.TagIDs, result.TagIDs)
+			require.Equal(t, tc.expected.Status, result.Status)
 
 			// Проверка вложений
 			require.Equal(t, len(tc.expected.Attachments), len(result.Attachments))
@@ -161,3 +170,342 @@ func TestMapTicketToOut(t *testing.T) {
 		})
 	}
 }
+
+func TestMapTicketStatusFromIn(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   tickets.TicketStatus
+		expected entities.TicketStatus
+	}{
+		{
+			name:     "open",
+			status:   tickets.TicketStatus_TICKET_STATUS_OPEN,
+			expected: entities.TicketStatusOpen,
+		},
+		{
+			name:     "in progress",
+			status:   tickets.TicketStatus_TICKET_STATUS_IN_PROGRESS,
+			expected: entities.TicketStatusInProgress,
+		},
+		{
+			name:     "completed",
+			status:   tickets.TicketStatus_TICKET_STATUS_COMPLETED,
+			expected: entities.TicketStatusCompleted,
+		},
+		{
+			name:     "cancelled",
+			status:   tickets.TicketStatus_TICKET_STATUS_CANCELLED,
+			expected: entities.TicketStatusCancelled,
+		},
+		{
+			name:     "unspecified",
+			status:   tickets.TicketStatus_TICKET_STATUS_UNSPECIFIED,
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := mapTicketStatusFromIn(tc.status)
+			require.Equal(t, tc.expected, result)
+			if tc.expected != "" {
+				require.Equal(t, tc.status, mapTicketStatusToOut(result))
+			}
+		})
+	}
+}
+
+func TestMapTicketEventTypeToOut(t *testing.T) {
+	testCases := []struct {
+		name      string
+		eventType entities.TicketEventType
+		expected  tickets.TicketEventType
+	}{
+		{
+			name:      "snapshot",
+			eventType: entities.TicketEventTypeSnapshot,
+			expected:  tickets.TicketEventType_TICKET_EVENT_TYPE_SNAPSHOT,
+		},
+		{
+			name:      "created",
+			eventType: entities.TicketEventTypeCreated,
+			expected:  tickets.TicketEventType_TICKET_EVENT_TYPE_CREATED,
+		},
+		{
+			name:      "updated",
+			eventType: entities.TicketEventTypeUpdated,
+			expected:  tickets.TicketEventType_TICKET_EVENT_TYPE_UPDATED,
+		},
+		{
+			name:      "deleted",
+			eventType: entities.TicketEventTypeDeleted,
+			expected:  tickets.TicketEventType_TICKET_EVENT_TYPE_DELETED,
+		},
+		{
+			name:      "unknown",
+			eventType: "unknown",
+			expected:  tickets.TicketEventType_TICKET_EVENT_TYPE_UNSPECIFIED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, mapTicketEventTypeToOut(tc.eventType))
+		})
+	}
+}
+
+func TestMapTicketsFiltersFromIn(t *testing.T) {
+	testCases := []struct {
+		name     string
+		in       *tickets.TicketsFilters
+		expected *entities.TicketsFilters
+	}{
+		{
+			name:     "nil filters",
+			in:       nil,
+			expected: nil,
+		},
+		{
+			name: "full filters",
+			in: &tickets.TicketsFilters{
+				Search:              pointers.New("test"),
+				PriceCeil:           pointers.New[float32](100),
+				PriceFloor:          pointers.New[float32](10),
+				QuantityFloor:       pointers.New[uint32](1),
+				CategoryIDs:         []uint32{1},
+				TagIDs:              []uint32{2},
+				CreatedAtOrderByAsc: pointers.New(true),
+				Statuses:            []tickets.TicketStatus{tickets.TicketStatus_TICKET_STATUS_OPEN},
+				CreatedAtFrom:       timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CreatedAtTo:         timestamppb.New(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAtFrom:       timestamppb.New(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAtTo:         timestamppb.New(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expected: &entities.TicketsFilters{
+				Search:              pointers.New("test"),
+				PriceCeil:           pointers.New[float32](100),
+				PriceFloor:          pointers.New[float32](10),
+				QuantityFloor:       pointers.New[uint32](1),
+				CategoryIDs:         []uint32{1},
+				TagIDs:              []uint32{2},
+				CreatedAtOrderByAsc: pointers.New(true),
+				Statuses:            []entities.TicketStatus{entities.TicketStatusOpen},
+				CreatedAtFrom:       pointers.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CreatedAtTo:         pointers.New(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAtFrom:       pointers.New(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAtTo:         pointers.New(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, mapTicketsFiltersFromIn(tc.in))
+		})
+	}
+}
+
+func TestMapTicketsFiltersWithSortFromIn(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *tickets.TicketsFilters
+		sort          []*tickets.TicketsSort
+		expected      *entities.TicketsFilters
+		expectedError error
+	}{
+		{
+			name:     "nil filters without sort",
+			expected: nil,
+		},
+		{
+			name: "filters without sort",
+			in:   &tickets.TicketsFilters{Search: pointers.New("test")},
+			expected: &entities.TicketsFilters{
+				Search: pointers.New("test"),
+			},
+		},
+		{
+			name: "nil filters with sort",
+			sort: []*tickets.TicketsSort{
+				{
+					Field:     tickets.TicketsSortField_TICKETS_SORT_FIELD_PRICE,
+					Direction: tickets.SortDirection_SORT_DIRECTION_DESC,
+				},
+				{
+					Field: tickets.TicketsSortField_TICKETS_SORT_FIELD_NAME,
+				},
+			},
+			expected: &entities.TicketsFilters{
+				Sort: []entities.TicketsSort{
+					{Field: entities.TicketsSortFieldPrice, Direction: entities.SortDirectionDesc},
+					{Field: entities.TicketsSortFieldName, Direction: entities.SortDirectionAsc},
+				},
+			},
+		},
+		{
+			name: "all sort fields",
+			in:   &tickets.TicketsFilters{Search: pointers.New("test")},
+			sort: []*tickets.TicketsSort{
+				{
+					Field:     tickets.TicketsSortField_TICKETS_SORT_FIELD_QUANTITY,
+					Direction: tickets.SortDirection_SORT_DIRECTION_ASC,
+				},
+				{
+					Field:     tickets.TicketsSortField_TICKETS_SORT_FIELD_UPDATED_AT,
+					Direction: tickets.SortDirection_SORT_DIRECTION_DESC,
+				},
+				{
+					Field:     tickets.TicketsSortField_TICKETS_SORT_FIELD_RESPONDS_COUNT,
+					Direction: tickets.SortDirection_SORT_DIRECTION_DESC,
+				},
+				{
+					Field:     tickets.TicketsSortField_TICKETS_SORT_FIELD_RELEVANCE,
+					Direction: tickets.SortDirection_SORT_DIRECTION_DESC,
+				},
+			},
+			expected: &entities.TicketsFilters{
+				Search: pointers.New("test"),
+				Sort: []entities.TicketsSort{
+					{Field: entities.TicketsSortFieldQuantity, Direction: entities.SortDirectionAsc},
+					{Field: entities.TicketsSortFieldUpdatedAt, Direction: entities.SortDirectionDesc},
+					{Field: entities.TicketsSortFieldRespondsCount, Direction: entities.SortDirectionDesc},
+					{Field: entities.TicketsSortFieldRelevance, Direction: entities.SortDirectionDesc},
+				},
+			},
+		},
+		{
+			name: "unspecified sort field",
+			sort: []*tickets.TicketsSort{
+				{
+					Field: tickets.TicketsSortField_TICKETS_SORT_FIELD_PRICE,
+				},
+				{
+					Field: tickets.TicketsSortField_TICKETS_SORT_FIELD_UNSPECIFIED,
+				},
+			},
+			expectedError: &customerrors.InvalidSortError{
+				Message: "sort field TICKETS_SORT_FIELD_UNSPECIFIED is not allowed",
+				Index:   1,
+			},
+		},
+		{
+			name: "unknown sort field",
+			sort: []*tickets.TicketsSort{
+				{
+					Field: tickets.TicketsSortField(100),
+				},
+			},
+			expectedError: &customerrors.InvalidSortError{
+				Message: "sort field 100 is not allowed",
+			},
+		},
+		{
+			name: "relevance sort field without search",
+			in:   &tickets.TicketsFilters{Search: pointers.New("")},
+			sort: []*tickets.TicketsSort{
+				{
+					Field:     tickets.TicketsSortField_TICKETS_SORT_FIELD_RELEVANCE,
+					Direction: tickets.SortDirection_SORT_DIRECTION_DESC,
+				},
+			},
+			expectedError: &customerrors.InvalidSortError{
+				Message: "sort field TICKETS_SORT_FIELD_RELEVANCE requires search",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filters, err := mapTicketsFiltersWithSortFromIn(tc.in, tc.sort)
+			require.Equal(t, tc.expectedError, err)
+			require.Equal(t, tc.expected, filters)
+		})
+	}
+}
+
+func TestMapTicketUpdateMaskFromIn(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *tickets.UpdateTicketIn
+		expected      []entities.TicketUpdateField
+		errorExpected bool
+	}{
+		{
+			name:     "nil input",
+			in:       nil,
+			expected: nil,
+		},
+		{
+			name:     "without mask",
+			in:       &tickets.UpdateTicketIn{Name: pointers.New("Ticket")},
+			expected: nil,
+		},
+		{
+			name: "empty mask",
+			in: &tickets.UpdateTicketIn{
+				Name:       pointers.New("Ticket"),
+				UpdateMask: &fieldmaskpb.FieldMask{},
+			},
+			expected: nil,
+		},
+		{
+			name: "provided fields",
+			in: &tickets.UpdateTicketIn{
+				CategoryID:  pointers.New[uint32](1),
+				Name:        pointers.New("Ticket"),
+				Description: pointers.New("Desc"),
+				Quantity:    pointers.New[uint32](2),
+				UpdateMask: &fieldmaskpb.FieldMask{
+					Paths: []string{"categoryID", "name", "description", "quantity"},
+				},
+			},
+			expected: []entities.TicketUpdateField{
+				entities.TicketUpdateFieldCategoryID,
+				entities.TicketUpdateFieldName,
+				entities.TicketUpdateFieldDescription,
+				entities.TicketUpdateFieldQuantity,
+			},
+		},
+		{
+			name: "cleared fields",
+			in: &tickets.UpdateTicketIn{
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"price", "tagIDs", "attachments"}},
+			},
+			expected: []entities.TicketUpdateField{
+				entities.TicketUpdateFieldPrice,
+				entities.TicketUpdateFieldTagIDs,
+				entities.TicketUpdateFieldAttachments,
+			},
+		},
+		{
+			name: "unknown path",
+			in: &tickets.UpdateTicketIn{
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"userID"}},
+			},
+			errorExpected: true,
+		},
+		{
+			name: "not nullable field is not provided",
+			in: &tickets.UpdateTicketIn{
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			updateMask, err := mapTicketUpdateMaskFromIn(tc.in)
+			if tc.errorExpected {
+				require.Nil(t, updateMask)
+				require.IsType(t, &customerrors.InvalidUpdateMaskError{}, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, updateMask)
+		})
+	}
+}
@@ -10,9 +10,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/emptypb"
 
-	customgrpc "github.com/DKhorkov/libs/grpc"
-
 	"github.com/DKhorkov/hmtm-tickets/api/protobuf/generated/go/tickets"
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+	"github.com/DKhorkov/hmtm-tickets/internal/controllers/grpc/grpcerrors"
+	"github.com/DKhorkov/hmtm-tickets/internal/controllers/grpc/idempotency"
+	"github.com/DKhorkov/hmtm-tickets/internal/cursor"
 	"github.com/DKhorkov/hmtm-tickets/internal/entities"
 	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
 	"github.com/DKhorkov/hmtm-tickets/internal/interfaces"
@@ -23,35 +25,41 @@ var (
 	ticketAlreadyExistsError = &customerrors.TicketAlreadyExistsError{}
 	categoryNotFoundError    = &customerrors.CategoryNotFoundError{}
 	tagNotFoundError         = &customerrors.TagNotFoundError{}
+
+	ticketStatusTransitionNotAllowedError = &customerrors.TicketStatusTransitionNotAllowedError{}
+	permissionDeniedError                 = &customerrors.PermissionDeniedError{}
+	invalidCursorError                    = &customerrors.InvalidCursorError{}
+	versionConflictError                  = &customerrors.VersionConflictError{}
+	tooManyWatchStreamsError              = &customerrors.TooManyWatchStreamsError{}
+	slowWatchStreamError                  = &customerrors.SlowWatchStreamError{}
+	watchSearchNotSupportedError          = &customerrors.WatchSearchNotSupportedError{}
 )
 
 // RegisterServer handler (serverAPI) for TicketsServer to gRPC server:.
-func RegisterServer(gRPCServer *grpc.Server, useCases interfaces.UseCases, logger logging.Logger) {
-	tickets.RegisterTicketsServiceServer(gRPCServer, &ServerAPI{useCases: useCases, logger: logger})
+func RegisterServer(
+	gRPCServer *grpc.Server,
+	useCases interfaces.UseCases,
+	cursorCodec *cursor.Codec,
+	batchConfig config.BatchConfig,
+	logger logging.Logger,
+) {
+	tickets.RegisterTicketsServiceServer(
+		gRPCServer,
+		&ServerAPI{useCases: useCases, cursorCodec: cursorCodec, batchConfig: batchConfig, logger: logger},
+	)
 }
 
 type ServerAPI struct {
 	// Helps to test single endpoints, if others is not implemented yet
 	tickets.UnimplementedTicketsServiceServer
-	useCases interfaces.UseCases
-	logger   logging.Logger
+	useCases    interfaces.UseCases
+	cursorCodec *cursor.Codec
+	batchConfig config.BatchConfig
+	logger      logging.Logger
 }
 
 func (api *ServerAPI) CountTickets(ctx context.Context, in *tickets.CountTicketsIn) (*tickets.CountOut, error) {
-	var filters *entities.TicketsFilters
-	if in.GetFilters() != nil {
-		filters = &entities.TicketsFilters{
-			Search:              in.Filters.Search,
-			PriceCeil:           in.Filters.PriceCeil,
-			PriceFloor:          in.Filters.PriceFloor,
-			QuantityFloor:       in.Filters.QuantityFloor,
-			CategoryIDs:         in.Filters.CategoryIDs,
-			TagIDs:              in.Filters.TagIDs,
-			CreatedAtOrderByAsc: in.Filters.CreatedAtOrderByAsc,
-		}
-	}
-
-	count, err := api.useCases.CountTickets(ctx, filters)
+	count, err := api.useCases.CountTickets(ctx, mapTicketsFiltersFromIn(in.GetFilters()))
 	if err != nil {
 		logging.LogErrorContext(
 			ctx,
@@ -60,27 +68,14 @@ func (api *ServerAPI) CountTickets(ctx context.Context, in *tickets.CountTickets
 			err,
 		)
 
-		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+		return nil, grpcerrors.New(codes.Internal, err)
 	}
 
 	return &tickets.CountOut{Count: count}, nil
 }
 
 func (api *ServerAPI) CountUserTickets(ctx context.Context, in *tickets.CountUserTicketsIn) (*tickets.CountOut, error) {
-	var filters *entities.TicketsFilters
-	if in.GetFilters() != nil {
-		filters = &entities.TicketsFilters{
-			Search:              in.Filters.Search,
-			PriceCeil:           in.Filters.PriceCeil,
-			PriceFloor:          in.Filters.PriceFloor,
-			QuantityFloor:       in.Filters.QuantityFloor,
-			CategoryIDs:         in.Filters.CategoryIDs,
-			TagIDs:              in.Filters.TagIDs,
-			CreatedAtOrderByAsc: in.Filters.CreatedAtOrderByAsc,
-		}
-	}
-
-	count, err := api.useCases.CountUserTickets(ctx, in.GetUserID(), filters)
+	count, err := api.useCases.CountUserTickets(ctx, in.GetUserID(), mapTicketsFiltersFromIn(in.GetFilters()))
 	if err != nil {
 		logging.LogErrorContext(
 			ctx,
@@ -89,7 +84,7 @@ func (api *ServerAPI) CountUserTickets(ctx context.Context, in *tickets.CountUse
 			err,
 		)
 
-		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+		return nil, grpcerrors.New(codes.Internal, err)
 	}
 
 	return &tickets.CountOut{Count: count}, nil
@@ -100,7 +95,7 @@ func (api *ServerAPI) DeleteTicket(
 	ctx context.Context,
 	in *tickets.DeleteTicketIn,
 ) (*emptypb.Empty, error) {
-	if err := api.useCases.DeleteTicket(ctx, in.GetID()); err != nil {
+	if err := api.useCases.DeleteTicket(ctx, in.GetID(), in.GetUserID()); err != nil {
 		logging.LogErrorContext(
 			ctx,
 			api.logger,
@@ -110,9 +105,11 @@ func (api *ServerAPI) DeleteTicket(
 
 		switch {
 		case errors.As(err, &ticketNotFoundError):
-			return nil, &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
+			return nil, grpcerrors.New(codes.NotFound, err)
+		case errors.As(err, &permissionDeniedError):
+			return nil, grpcerrors.New(codes.PermissionDenied, err)
 		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+			return nil, grpcerrors.New(codes.Internal, err)
 		}
 	}
 
@@ -126,6 +123,7 @@ func (api *ServerAPI) UpdateTicket(
 ) (*emptypb.Empty, error) {
 	ticketData := entities.RawUpdateTicketDTO{
 		ID:          in.GetID(),
+		UserID:      in.GetUserID(),
 		TagIDs:      in.GetTagIDs(),
 		Attachments: in.GetAttachments(),
 	}
@@ -136,9 +134,24 @@ func (api *ServerAPI) UpdateTicket(
 		ticketData.Description = in.Description
 		ticketData.Price = in.Price
 		ticketData.Quantity = in.Quantity
+		ticketData.ExpectedVersion = in.ExpectedVersion
+	}
+
+	updateMask, err := mapTicketUpdateMaskFromIn(in)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to process update mask for Ticket with ID=%d", in.GetID()),
+			err,
+		)
+
+		return nil, grpcerrors.New(codes.InvalidArgument, err)
 	}
 
-	if err := api.useCases.UpdateTicket(ctx, ticketData); err != nil {
+	ticketData.UpdateMask = updateMask
+
+	if err = api.useCases.UpdateTicket(ctx, ticketData); err != nil {
 		logging.LogErrorContext(
 			ctx,
 			api.logger,
@@ -147,12 +160,62 @@ func (api *ServerAPI) UpdateTicket(
 		)
 
 		switch {
-		case errors.As(err, &ticketNotFoundError),
-			errors.As(err, &categoryNotFoundError),
+		case errors.As(err, &ticketNotFoundError):
+			return nil, grpcerrors.New(codes.NotFound, err)
+		case errors.As(err, &categoryNotFoundError),
 			errors.As(err, &tagNotFoundError):
-			return nil, &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
+			return nil, grpcerrors.New(codes.InvalidArgument, err)
+		case errors.As(err, &permissionDeniedError):
+			return nil, grpcerrors.New(codes.PermissionDenied, err)
+		case errors.As(err, &versionConflictError):
+			return nil, grpcerrors.New(codes.Aborted, err)
+		default:
+			return nil, grpcerrors.New(codes.Internal, err)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ChangeTicketStatus handler moves Ticket with provided ID to new lifecycle status.
+func (api *ServerAPI) ChangeTicketStatus(
+	ctx context.Context,
+	in *tickets.ChangeTicketStatusIn,
+) (*emptypb.Empty, error) {
+	// Unspecified and unknown statuses are mapped to empty one, which is not a lifecycle status:
+	status := mapTicketStatusFromIn(in.GetStatus())
+	if status == "" {
+		err := &customerrors.InvalidTicketStatusError{
+			Message: fmt.Sprintf("ticket status %s is not allowed", in.GetStatus()),
+		}
+
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to change status for Ticket with ID=%d", in.GetID()),
+			err,
+		)
+
+		return nil, grpcerrors.New(codes.InvalidArgument, err)
+	}
+
+	if err := api.useCases.ChangeTicketStatus(ctx, in.GetID(), in.GetUserID(), status); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to change status for Ticket with ID=%d", in.GetID()),
+			err,
+		)
+
+		switch {
+		case errors.As(err, &ticketNotFoundError):
+			return nil, grpcerrors.New(codes.NotFound, err)
+		case errors.As(err, &ticketStatusTransitionNotAllowedError):
+			return nil, grpcerrors.New(codes.FailedPrecondition, err)
+		case errors.As(err, &permissionDeniedError):
+			return nil, grpcerrors.New(codes.PermissionDenied, err)
 		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+			return nil, grpcerrors.New(codes.Internal, err)
 		}
 	}
 
@@ -164,6 +227,18 @@ func (api *ServerAPI) CreateTicket(
 	ctx context.Context,
 	in *tickets.CreateTicketIn,
 ) (*tickets.CreateTicketOut, error) {
+	idempotencyKey, err := idempotency.Key(ctx, in.GetIdempotencyKey())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			"Error occurred while trying to process idempotency key for new Ticket",
+			err,
+		)
+
+		return nil, grpcerrors.New(codes.InvalidArgument, err)
+	}
+
 	ticketData := entities.CreateTicketDTO{
 		UserID:      in.GetUserID(),
 		CategoryID:  in.GetCategoryID(),
@@ -178,6 +253,13 @@ func (api *ServerAPI) CreateTicket(
 		ticketData.Price = in.Price
 	}
 
+	if idempotencyKey != nil {
+		ticketData.IdempotencyKey = &entities.IdempotencyKeyDTO{
+			UserID: in.GetUserID(),
+			Key:    *idempotencyKey,
+		}
+	}
+
 	ticketID, err := api.useCases.CreateTicket(ctx, ticketData)
 	if err != nil {
 		logging.LogErrorContext(
@@ -188,12 +270,13 @@ func (api *ServerAPI) CreateTicket(
 		)
 
 		switch {
-		case errors.As(err, &ticketAlreadyExistsError),
-			errors.As(err, &categoryNotFoundError),
+		case errors.As(err, &ticketAlreadyExistsError):
+			return nil, grpcerrors.New(codes.AlreadyExists, err)
+		case errors.As(err, &categoryNotFoundError),
 			errors.As(err, &tagNotFoundError):
-			return nil, &customgrpc.BaseError{Status: codes.AlreadyExists, Message: err.Error()}
+			return nil, grpcerrors.New(codes.InvalidArgument, err)
 		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+			return nil, grpcerrors.New(codes.Internal, err)
 		}
 	}
 
@@ -216,38 +299,87 @@ func (api *ServerAPI) GetTicket(
 
 		switch {
 		case errors.As(err, &ticketNotFoundError):
-			return nil, &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
+			return nil, grpcerrors.New(codes.NotFound, err)
 		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+			return nil, grpcerrors.New(codes.Internal, err)
 		}
 	}
 
 	return mapTicketToOut(*ticket), nil
 }
 
+// GetTicketsByIDs handler returns Tickets for provided IDs in the same order and IDs of not found Tickets.
+// Number of IDs is limited by BatchConfig.MaxIDs, so IDs are not logged.
+func (api *ServerAPI) GetTicketsByIDs(
+	ctx context.Context,
+	in *tickets.GetTicketsByIDsIn,
+) (*tickets.GetTicketsByIDsOut, error) {
+	if len(in.GetIDs()) > api.batchConfig.MaxIDs {
+		err := &customerrors.TooManyIDsError{
+			Message: fmt.Sprintf("at most %d IDs can be requested at once", api.batchConfig.MaxIDs),
+		}
+
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to get %d Tickets by IDs", len(in.GetIDs())),
+			err,
+		)
+
+		return nil, grpcerrors.New(codes.InvalidArgument, err)
+	}
+
+	foundTickets, missingIDs, err := api.useCases.GetTicketsByIDs(ctx, in.GetIDs())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to get %d Tickets by IDs", len(in.GetIDs())),
+			err,
+		)
+
+		return nil, grpcerrors.New(codes.Internal, err)
+	}
+
+	processedTickets := make([]*tickets.GetTicketOut, len(foundTickets))
+	for i, ticket := range foundTickets {
+		processedTickets[i] = mapTicketToOut(ticket)
+	}
+
+	return &tickets.GetTicketsByIDsOut{Tickets: processedTickets, MissingIDs: missingIDs}, nil
+}
+
 // GetTickets handler returns all Tickets.
 func (api *ServerAPI) GetTickets(
 	ctx context.Context,
 	in *tickets.GetTicketsIn,
 ) (*tickets.GetTicketsOut, error) {
-	var filters *entities.TicketsFilters
-	if in.GetFilters() != nil {
-		filters = &entities.TicketsFilters{
-			Search:              in.Filters.Search,
-			PriceCeil:           in.Filters.PriceCeil,
-			PriceFloor:          in.Filters.PriceFloor,
-			QuantityFloor:       in.Filters.QuantityFloor,
-			CategoryIDs:         in.Filters.CategoryIDs,
-			TagIDs:              in.Filters.TagIDs,
-			CreatedAtOrderByAsc: in.Filters.CreatedAtOrderByAsc,
-		}
+	filters, err := mapTicketsFiltersWithSortFromIn(in.GetFilters(), in.GetSort())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			"Error occurred while trying to process sort for Tickets",
+			err,
+		)
+
+		return nil, grpcerrors.New(codes.InvalidArgument, err)
 	}
 
-	var pagination *entities.Pagination
-	if in.GetPagination() != nil {
-		pagination = &entities.Pagination{
-			Limit:  in.Pagination.Limit,
-			Offset: in.Pagination.Offset,
+	pagination, err := api.mapPaginationFromIn(in.GetPagination(), filters)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			"Error occurred while trying to process pagination for Tickets",
+			err,
+		)
+
+		switch {
+		case errors.As(err, &invalidCursorError):
+			return nil, grpcerrors.New(codes.InvalidArgument, err)
+		default:
+			return nil, grpcerrors.New(codes.Internal, err)
 		}
 	}
 
@@ -260,7 +392,7 @@ func (api *ServerAPI) GetTickets(
 			err,
 		)
 
-		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+		return nil, grpcerrors.New(codes.Internal, err)
 	}
 
 	processedTickets := make([]*tickets.GetTicketOut, len(allTickets))
@@ -268,7 +400,19 @@ func (api *ServerAPI) GetTickets(
 		processedTickets[i] = mapTicketToOut(ticket)
 	}
 
-	return &tickets.GetTicketsOut{Tickets: processedTickets}, nil
+	nextCursor, err := api.nextCursor(allTickets, pagination, filters)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			"Error occurred while trying to create next page cursor for Tickets",
+			err,
+		)
+
+		return nil, grpcerrors.New(codes.Internal, err)
+	}
+
+	return &tickets.GetTicketsOut{Tickets: processedTickets, NextCursor: nextCursor}, nil
 }
 
 // GetUserTickets handler returns Tickets for User with provided ID.
@@ -276,24 +420,38 @@ func (api *ServerAPI) GetUserTickets(
 	ctx context.Context,
 	in *tickets.GetUserTicketsIn,
 ) (*tickets.GetTicketsOut, error) {
-	var filters *entities.TicketsFilters
-	if in.GetFilters() != nil {
-		filters = &entities.TicketsFilters{
-			Search:              in.Filters.Search,
-			PriceCeil:           in.Filters.PriceCeil,
-			PriceFloor:          in.Filters.PriceFloor,
-			QuantityFloor:       in.Filters.QuantityFloor,
-			CategoryIDs:         in.Filters.CategoryIDs,
-			TagIDs:              in.Filters.TagIDs,
-			CreatedAtOrderByAsc: in.Filters.CreatedAtOrderByAsc,
-		}
+	filters, err := mapTicketsFiltersWithSortFromIn(in.GetFilters(), in.GetSort())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to process sort for Tickets for User with ID=%d",
+				in.GetUserID(),
+			),
+			err,
+		)
+
+		return nil, grpcerrors.New(codes.InvalidArgument, err)
 	}
 
-	var pagination *entities.Pagination
-	if in.GetPagination() != nil {
-		pagination = &entities.Pagination{
-			Limit:  in.Pagination.Limit,
-			Offset: in.Pagination.Offset,
+	pagination, err := api.mapPaginationFromIn(in.GetPagination(), filters)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to process pagination for Tickets for User with ID=%d",
+				in.GetUserID(),
+			),
+			err,
+		)
+
+		switch {
+		case errors.As(err, &invalidCursorError):
+			return nil, grpcerrors.New(codes.InvalidArgument, err)
+		default:
+			return nil, grpcerrors.New(codes.Internal, err)
 		}
 	}
 
@@ -309,7 +467,7 @@ func (api *ServerAPI) GetUserTickets(
 			err,
 		)
 
-		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+		return nil, grpcerrors.New(codes.Internal, err)
 	}
 
 	processedTickets := make([]*tickets.GetTicketOut, len(userTickets))
@@ -317,5 +475,130 @@ func (api *ServerAPI) GetUserTickets(
 		processedTickets[i] = mapTicketToOut(ticket)
 	}
 
-	return &tickets.GetTicketsOut{Tickets: processedTickets}, nil
+	nextCursor, err := api.nextCursor(userTickets, pagination, filters)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to create next page cursor for Tickets for User with ID=%d",
+				in.GetUserID(),
+			),
+			err,
+		)
+
+		return nil, grpcerrors.New(codes.Internal, err)
+	}
+
+	return &tickets.GetTicketsOut{Tickets: processedTickets, NextCursor: nextCursor}, nil
+}
+
+// WatchTickets handler streams Tickets, matching provided filters, until client cancels stream.
+func (api *ServerAPI) WatchTickets(
+	in *tickets.WatchTicketsIn,
+	stream tickets.TicketsService_WatchTicketsServer,
+) error {
+	ctx := stream.Context()
+
+	err := api.useCases.WatchTickets(
+		ctx,
+		mapTicketsFiltersFromIn(in.GetFilters()),
+		in.GetSnapshot(),
+		func(event entities.TicketEvent) error {
+			return stream.Send(
+				&tickets.WatchTicketsOut{
+					Type:   mapTicketEventTypeToOut(event.Type),
+					Ticket: mapTicketToOut(event.Ticket),
+				},
+			)
+		},
+	)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			"Error occurred while trying to watch Tickets",
+			err,
+		)
+
+		switch {
+		case errors.As(err, &tooManyWatchStreamsError), errors.As(err, &slowWatchStreamError):
+			return grpcerrors.New(codes.ResourceExhausted, err)
+		case errors.As(err, &watchSearchNotSupportedError):
+			return grpcerrors.New(codes.InvalidArgument, err)
+		default:
+			return grpcerrors.New(codes.Internal, err)
+		}
+	}
+
+	return nil
+}
+
+// mapPaginationFromIn decodes keyset pagination cursor, if it was provided. Cursor mode can not be combined
+// with offset, because cursor already points to page start, and with sort keys, because cursor contains
+// only creation time and ID.
+func (api *ServerAPI) mapPaginationFromIn(
+	in *tickets.Pagination,
+	filters *entities.TicketsFilters,
+) (*entities.Pagination, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	pagination := &entities.Pagination{
+		Limit:  in.Limit,
+		Offset: in.Offset,
+	}
+
+	if in.Cursor == nil {
+		return pagination, nil
+	}
+
+	if in.Offset != nil {
+		return nil, &customerrors.InvalidCursorError{Message: "pagination cursor can not be combined with offset"}
+	}
+
+	if hasSort(filters) {
+		return nil, &customerrors.InvalidCursorError{
+			Message: "pagination cursor can not be combined with sort",
+		}
+	}
+
+	decodedCursor, err := api.cursorCodec.Decode(in.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	pagination.Cursor = decodedCursor
+
+	return pagination, nil
+}
+
+// nextCursor returns cursor, pointing to the last Ticket of the page. Cursor is returned only for full pages,
+// because page with less Tickets than limit is the last one.
+func (api *ServerAPI) nextCursor(
+	page []entities.Ticket,
+	pagination *entities.Pagination,
+	filters *entities.TicketsFilters,
+) (*string, error) {
+	if pagination == nil ||
+		pagination.Limit == nil ||
+		*pagination.Limit == 0 ||
+		uint64(len(page)) != *pagination.Limit ||
+		hasSort(filters) {
+		return nil, nil
+	}
+
+	lastTicket := page[len(page)-1]
+
+	token, err := api.cursorCodec.Encode(entities.Cursor{CreatedAt: lastTicket.CreatedAt, ID: lastTicket.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func hasSort(filters *entities.TicketsFilters) bool {
+	return filters != nil && len(filters.Sort) > 0
 }
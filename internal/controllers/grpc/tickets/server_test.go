@@ -4,20 +4,25 @@ import (
 	"context"
 	"errors"
 	"google.golang.org/grpc/status"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
-	customgrpc "github.com/DKhorkov/libs/grpc"
 	mocklogging "github.com/DKhorkov/libs/logging/mocks"
 	"github.com/DKhorkov/libs/pointers"
 
 	"github.com/DKhorkov/hmtm-tickets/api/protobuf/generated/go/tickets"
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+	"github.com/DKhorkov/hmtm-tickets/internal/controllers/grpc/grpcerrors"
+	"github.com/DKhorkov/hmtm-tickets/internal/cursor"
 	"github.com/DKhorkov/hmtm-tickets/internal/entities"
 	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
 	mockusecases "github.com/DKhorkov/hmtm-tickets/mocks/usecases"
@@ -41,11 +46,11 @@ func TestServerAPI_DeleteTicket(t *testing.T) {
 	}{
 		{
 			name: "success",
-			in:   &tickets.DeleteTicketIn{ID: 1},
+			in:   &tickets.DeleteTicketIn{ID: 1, UserID: 2},
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				useCases.
 					EXPECT().
-					DeleteTicket(gomock.Any(), uint64(1)).
+					DeleteTicket(gomock.Any(), uint64(1), uint64(2)).
 					Return(nil).
 					Times(1)
 			},
@@ -54,11 +59,11 @@ func TestServerAPI_DeleteTicket(t *testing.T) {
 		},
 		{
 			name: "not found error",
-			in:   &tickets.DeleteTicketIn{ID: 1},
+			in:   &tickets.DeleteTicketIn{ID: 1, UserID: 2},
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				useCases.
 					EXPECT().
-					DeleteTicket(gomock.Any(), uint64(1)).
+					DeleteTicket(gomock.Any(), uint64(1), uint64(2)).
 					Return(&customerrors.TicketNotFoundError{Message: "ticket with ID=1 not found"}).
 					Times(1)
 
@@ -67,16 +72,34 @@ func TestServerAPI_DeleteTicket(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.NotFound, Message: "ticket with ID=1 not found"},
+			expectedErr:   grpcerrors.New(codes.NotFound, &customerrors.TicketNotFoundError{Message: "ticket with ID=1 not found"}),
+			errorExpected: true,
+		},
+		{
+			name: "permission denied error",
+			in:   &tickets.DeleteTicketIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					DeleteTicket(gomock.Any(), uint64(1), uint64(2)).
+					Return(&customerrors.PermissionDeniedError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.PermissionDenied, &customerrors.PermissionDeniedError{}),
 			errorExpected: true,
 		},
 		{
 			name: "internal error",
-			in:   &tickets.DeleteTicketIn{ID: 1},
+			in:   &tickets.DeleteTicketIn{ID: 1, UserID: 2},
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				useCases.
 					EXPECT().
-					DeleteTicket(gomock.Any(), uint64(1)).
+					DeleteTicket(gomock.Any(), uint64(1), uint64(2)).
 					Return(errors.New("internal error")).
 					Times(1)
 
@@ -85,7 +108,7 @@ func TestServerAPI_DeleteTicket(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "internal error"},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
 			errorExpected: true,
 		},
 	}
@@ -172,11 +195,11 @@ func TestServerAPI_UpdateTicket(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.NotFound, Message: "ticket with ID=1 not found"},
+			expectedErr:   grpcerrors.New(codes.NotFound, &customerrors.TicketNotFoundError{Message: "ticket with ID=1 not found"}),
 			errorExpected: true,
 		},
 		{
-			name: "not found error (category)",
+			name: "invalid argument error (category)",
 			in:   &tickets.UpdateTicketIn{ID: 1, CategoryID: pointers.New[uint32](2)},
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				useCases.
@@ -193,7 +216,86 @@ func TestServerAPI_UpdateTicket(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.NotFound, Message: "category with ID=2 not found"},
+			expectedErr:   grpcerrors.New(codes.InvalidArgument, &customerrors.CategoryNotFoundError{Message: "2"}),
+			errorExpected: true,
+		},
+		{
+			name: "permission denied error",
+			in:   &tickets.UpdateTicketIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateTicket(gomock.Any(), entities.RawUpdateTicketDTO{ID: 1, UserID: 2}).
+					Return(&customerrors.PermissionDeniedError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.PermissionDenied, &customerrors.PermissionDeniedError{}),
+			errorExpected: true,
+		},
+		{
+			name: "success with update mask",
+			in: &tickets.UpdateTicketIn{
+				ID:         1,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"price", "tagIDs"}},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateTicket(gomock.Any(), entities.RawUpdateTicketDTO{
+						ID: 1,
+						UpdateMask: []entities.TicketUpdateField{
+							entities.TicketUpdateFieldPrice,
+							entities.TicketUpdateFieldTagIDs,
+						},
+					}).
+					Return(nil).
+					Times(1)
+			},
+			expectedErr:   nil,
+			errorExpected: false,
+		},
+		{
+			name: "invalid update mask error",
+			in: &tickets.UpdateTicketIn{
+				ID:         1,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status"}},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr: grpcerrors.New(
+				codes.InvalidArgument,
+				&customerrors.InvalidUpdateMaskError{Message: `unknown update mask path "status"`},
+			),
+			errorExpected: true,
+		},
+		{
+			name: "version conflict error",
+			in:   &tickets.UpdateTicketIn{ID: 1, ExpectedVersion: pointers.New[uint64](2)},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateTicket(gomock.Any(), entities.RawUpdateTicketDTO{
+						ID:              1,
+						ExpectedVersion: pointers.New[uint64](2),
+					}).
+					Return(&customerrors.VersionConflictError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.Aborted, &customerrors.VersionConflictError{}),
 			errorExpected: true,
 		},
 		{
@@ -211,7 +313,7 @@ func TestServerAPI_UpdateTicket(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "internal error"},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
 			errorExpected: true,
 		},
 	}
@@ -236,6 +338,179 @@ func TestServerAPI_UpdateTicket(t *testing.T) {
 	}
 }
 
+func TestServerAPI_ChangeTicketStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	testCases := []struct {
+		name          string
+		in            *tickets.ChangeTicketStatusIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			in: &tickets.ChangeTicketStatusIn{
+				ID:     1,
+				UserID: 2,
+				Status: tickets.TicketStatus_TICKET_STATUS_IN_PROGRESS,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					ChangeTicketStatus(gomock.Any(), uint64(1), uint64(2), entities.TicketStatusInProgress).
+					Return(nil).
+					Times(1)
+			},
+			expectedErr:   nil,
+			errorExpected: false,
+		},
+		{
+			name: "not found error",
+			in: &tickets.ChangeTicketStatusIn{
+				ID:     1,
+				UserID: 2,
+				Status: tickets.TicketStatus_TICKET_STATUS_IN_PROGRESS,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					ChangeTicketStatus(gomock.Any(), uint64(1), uint64(2), entities.TicketStatusInProgress).
+					Return(&customerrors.TicketNotFoundError{Message: "ticket with ID=1 not found"}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.NotFound, &customerrors.TicketNotFoundError{Message: "ticket with ID=1 not found"}),
+			errorExpected: true,
+		},
+		{
+			name: "transition not allowed error",
+			in: &tickets.ChangeTicketStatusIn{
+				ID:     1,
+				UserID: 2,
+				Status: tickets.TicketStatus_TICKET_STATUS_OPEN,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					ChangeTicketStatus(gomock.Any(), uint64(1), uint64(2), entities.TicketStatusOpen).
+					Return(&customerrors.TicketStatusTransitionNotAllowedError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.FailedPrecondition, &customerrors.TicketStatusTransitionNotAllowedError{}),
+			errorExpected: true,
+		},
+		{
+			name: "permission denied error",
+			in: &tickets.ChangeTicketStatusIn{
+				ID:     1,
+				UserID: 2,
+				Status: tickets.TicketStatus_TICKET_STATUS_COMPLETED,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					ChangeTicketStatus(gomock.Any(), uint64(1), uint64(2), entities.TicketStatusCompleted).
+					Return(&customerrors.PermissionDeniedError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.PermissionDenied, &customerrors.PermissionDeniedError{}),
+			errorExpected: true,
+		},
+		{
+			name: "unspecified status error",
+			in:   &tickets.ChangeTicketStatusIn{ID: 1, UserID: 2},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr: grpcerrors.New(
+				codes.InvalidArgument,
+				&customerrors.InvalidTicketStatusError{Message: "ticket status TICKET_STATUS_UNSPECIFIED is not allowed"},
+			),
+			errorExpected: true,
+		},
+		{
+			name: "unknown status error",
+			in:   &tickets.ChangeTicketStatusIn{ID: 1, UserID: 2, Status: tickets.TicketStatus(100)},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr: grpcerrors.New(
+				codes.InvalidArgument,
+				&customerrors.InvalidTicketStatusError{Message: "ticket status 100 is not allowed"},
+			),
+			errorExpected: true,
+		},
+		{
+			name: "internal error",
+			in: &tickets.ChangeTicketStatusIn{
+				ID:     1,
+				UserID: 2,
+				Status: tickets.TicketStatus_TICKET_STATUS_CANCELLED,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					ChangeTicketStatus(gomock.Any(), uint64(1), uint64(2), entities.TicketStatusCancelled).
+					Return(errors.New("internal error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.ChangeTicketStatus(context.Background(), tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+				require.IsType(t, &emptypb.Empty{}, resp)
+			}
+		})
+	}
+}
+
 func TestServerAPI_CreateTicket(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	useCases := mockusecases.NewMockUseCases(ctrl)
@@ -285,6 +560,46 @@ func TestServerAPI_CreateTicket(t *testing.T) {
 			expectedErr:   nil,
 			errorExpected: false,
 		},
+		{
+			name: "with idempotency key",
+			in: &tickets.CreateTicketIn{
+				UserID:         1,
+				Name:           "New Ticket",
+				IdempotencyKey: pointers.New("key"),
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					CreateTicket(gomock.Any(), entities.CreateTicketDTO{
+						UserID:         1,
+						Name:           "New Ticket",
+						IdempotencyKey: &entities.IdempotencyKeyDTO{UserID: 1, Key: "key"},
+					}).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+			expectedOut:   &tickets.CreateTicketOut{TicketID: 1},
+			errorExpected: false,
+		},
+		{
+			name: "invalid idempotency key",
+			in: &tickets.CreateTicketIn{
+				UserID:         1,
+				Name:           "New Ticket",
+				IdempotencyKey: pointers.New(strings.Repeat("k", 256)),
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr: grpcerrors.New(
+				codes.InvalidArgument,
+				&customerrors.InvalidIdempotencyKeyError{Message: "idempotency key must not be longer than 255 characters"},
+			),
+			errorExpected: true,
+		},
 		{
 			name: "already exists error",
 			in:   &tickets.CreateTicketIn{UserID: 1, Name: "New Ticket"},
@@ -303,7 +618,32 @@ func TestServerAPI_CreateTicket(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.AlreadyExists, Message: "ticket already exists"},
+			expectedErr:   grpcerrors.New(codes.AlreadyExists, &customerrors.TicketAlreadyExistsError{}),
+			errorExpected: true,
+		},
+		{
+			name: "invalid argument error (tag)",
+			in:   &tickets.CreateTicketIn{UserID: 1, Name: "New Ticket", TagIDs: []uint32{1, 7}},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					CreateTicket(gomock.Any(), entities.CreateTicketDTO{
+						UserID: 1,
+						Name:   "New Ticket",
+						TagIDs: []uint32{1, 7},
+					}).
+					Return(uint64(0), &customerrors.TagNotFoundError{Message: "7", Index: 1}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr: grpcerrors.New(
+				codes.InvalidArgument,
+				&customerrors.TagNotFoundError{Message: "7", Index: 1},
+			),
 			errorExpected: true,
 		},
 		{
@@ -324,7 +664,7 @@ func TestServerAPI_CreateTicket(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "internal error"},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
 			errorExpected: true,
 		},
 	}
@@ -424,7 +764,7 @@ func TestServerAPI_GetTicket(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.NotFound, Message: "ticket with ID=1 not found"},
+			expectedErr:   grpcerrors.New(codes.NotFound, &customerrors.TicketNotFoundError{Message: "ticket with ID=1 not found"}),
 			errorExpected: true,
 		},
 		{
@@ -442,7 +782,7 @@ func TestServerAPI_GetTicket(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "internal error"},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
 			errorExpected: true,
 		},
 	}
@@ -466,15 +806,155 @@ func TestServerAPI_GetTicket(t *testing.T) {
 	}
 }
 
+func TestServerAPI_GetTicketsByIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases:    useCases,
+		batchConfig: config.BatchConfig{MaxIDs: 3},
+		logger:      logger,
+	}
+
+	testCases := []struct {
+		name          string
+		in            *tickets.GetTicketsByIDsIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedOut   *tickets.GetTicketsByIDsOut
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			in:   &tickets.GetTicketsByIDsIn{IDs: []uint64{2, 3, 1}},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				foundTickets := []entities.Ticket{
+					{
+						ID:          2,
+						UserID:      1,
+						Name:        "Second Ticket",
+						TagIDs:      []uint32{1},
+						Attachments: []entities.Attachment{{ID: 1, TicketID: 2, Link: "attachment.jpg"}},
+						CreatedAt:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt:   time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:        1,
+						UserID:    1,
+						Name:      "First Ticket",
+						CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+				}
+
+				useCases.
+					EXPECT().
+					GetTicketsByIDs(gomock.Any(), []uint64{2, 3, 1}).
+					Return(foundTickets, []uint64{3}, nil).
+					Times(1)
+			},
+			expectedOut: &tickets.GetTicketsByIDsOut{
+				Tickets: []*tickets.GetTicketOut{
+					{
+						ID:     2,
+						UserID: 1,
+						Name:   "Second Ticket",
+						TagIDs: []uint32{1},
+						Attachments: []*tickets.Attachment{
+							{
+								ID:        1,
+								TicketID:  2,
+								Link:      "attachment.jpg",
+								CreatedAt: timestamppb.New(time.Time{}),
+								UpdatedAt: timestamppb.New(time.Time{}),
+							},
+						},
+						CreatedAt: timestamppb.New(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+						UpdatedAt: timestamppb.New(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)),
+					},
+					{
+						ID:          1,
+						UserID:      1,
+						Name:        "First Ticket",
+						Attachments: []*tickets.Attachment{},
+						CreatedAt:   timestamppb.New(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+						UpdatedAt:   timestamppb.New(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)),
+					},
+				},
+				MissingIDs: []uint64{3},
+			},
+			errorExpected: false,
+		},
+		{
+			name: "too many IDs",
+			in:   &tickets.GetTicketsByIDsIn{IDs: []uint64{1, 2, 3, 4}},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr: grpcerrors.New(
+				codes.InvalidArgument,
+				&customerrors.TooManyIDsError{Message: "at most 3 IDs can be requested at once"},
+			),
+			errorExpected: true,
+		},
+		{
+			name: "internal error",
+			in:   &tickets.GetTicketsByIDsIn{IDs: []uint64{1}},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					GetTicketsByIDs(gomock.Any(), []uint64{1}).
+					Return(nil, nil, errors.New("internal error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.GetTicketsByIDs(context.Background(), tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, resp)
+			}
+		})
+	}
+}
+
 func TestServerAPI_GetTickets(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	useCases := mockusecases.NewMockUseCases(ctrl)
 	logger := mocklogging.NewMockLogger(ctrl)
+	cursorCodec := cursor.New("secret")
 	api := &ServerAPI{
-		useCases: useCases,
-		logger:   logger,
+		useCases:    useCases,
+		cursorCodec: cursorCodec,
+		logger:      logger,
 	}
 
+	nextCursor, err := cursorCodec.Encode(
+		entities.Cursor{CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), ID: 1},
+	)
+	require.NoError(t, err)
+
 	testCases := []struct {
 		name          string
 		in            *tickets.GetTicketsIn
@@ -552,55 +1032,218 @@ func TestServerAPI_GetTickets(t *testing.T) {
 						UpdatedAt:   timestamppb.New(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)),
 					},
 				},
+				NextCursor: pointers.New(nextCursor),
+			},
+			expectedErr:   nil,
+			errorExpected: false,
+		},
+		{
+			name: "internal error",
+			in: &tickets.GetTicketsIn{
+				Pagination: &tickets.Pagination{
+					Limit:  pointers.New[uint64](1),
+					Offset: pointers.New[uint64](1),
+				},
+				Filters: &tickets.TicketsFilters{
+					Search:              pointers.New("ticket"),
+					PriceCeil:           pointers.New[float32](1000),
+					PriceFloor:          pointers.New[float32](10),
+					QuantityFloor:       pointers.New[uint32](1),
+					CategoryIDs:         []uint32{1},
+					TagIDs:              []uint32{1},
+					CreatedAtOrderByAsc: pointers.New(true),
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					GetTickets(
+						gomock.Any(),
+						&entities.Pagination{
+							Limit:  pointers.New[uint64](1),
+							Offset: pointers.New[uint64](1),
+						},
+						&entities.TicketsFilters{
+							Search:              pointers.New("ticket"),
+							PriceCeil:           pointers.New[float32](1000),
+							PriceFloor:          pointers.New[float32](10),
+							QuantityFloor:       pointers.New[uint32](1),
+							CategoryIDs:         []uint32{1},
+							TagIDs:              []uint32{1},
+							CreatedAtOrderByAsc: pointers.New(true),
+						},
+					).
+					Return(nil, errors.New("internal error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
+			errorExpected: true,
+		},
+		{
+			name: "success with cursor",
+			in: &tickets.GetTicketsIn{
+				Pagination: &tickets.Pagination{
+					Limit:  pointers.New[uint64](2),
+					Cursor: pointers.New(nextCursor),
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					GetTickets(
+						gomock.Any(),
+						&entities.Pagination{
+							Limit: pointers.New[uint64](2),
+							Cursor: &entities.Cursor{
+								CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+								ID:        1,
+							},
+						},
+						nil,
+					).
+					Return(
+						[]entities.Ticket{
+							{
+								ID:        2,
+								UserID:    3,
+								CreatedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+								UpdatedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+							},
+						},
+						nil,
+					).
+					Times(1)
+			},
+			expectedOut: &tickets.GetTicketsOut{
+				Tickets: []*tickets.GetTicketOut{
+					{
+						ID:          2,
+						UserID:      3,
+						Attachments: []*tickets.Attachment{},
+						CreatedAt:   timestamppb.New(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+						UpdatedAt:   timestamppb.New(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+					},
+				},
+			},
+			errorExpected: false,
+		},
+		{
+			name: "cursor with offset",
+			in: &tickets.GetTicketsIn{
+				Pagination: &tickets.Pagination{
+					Limit:  pointers.New[uint64](2),
+					Offset: pointers.New[uint64](2),
+					Cursor: pointers.New(nextCursor),
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr: grpcerrors.New(
+				codes.InvalidArgument,
+				&customerrors.InvalidCursorError{Message: "pagination cursor can not be combined with offset"},
+			),
+			errorExpected: true,
+		},
+		{
+			name: "cursor with relevance sort",
+			in: &tickets.GetTicketsIn{
+				Pagination: &tickets.Pagination{
+					Limit:  pointers.New[uint64](2),
+					Cursor: pointers.New(nextCursor),
+				},
+				Filters: &tickets.TicketsFilters{
+					Search: pointers.New("ticket"),
+				},
+				Sort: []*tickets.TicketsSort{
+					{
+						Field:     tickets.TicketsSortField_TICKETS_SORT_FIELD_RELEVANCE,
+						Direction: tickets.SortDirection_SORT_DIRECTION_DESC,
+					},
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr: grpcerrors.New(
+				codes.InvalidArgument,
+				&customerrors.InvalidCursorError{Message: "pagination cursor can not be combined with sort"},
+			),
+			errorExpected: true,
+		},
+		{
+			name: "unspecified sort field",
+			in: &tickets.GetTicketsIn{
+				Sort: []*tickets.TicketsSort{
+					{
+						Field: tickets.TicketsSortField_TICKETS_SORT_FIELD_UNSPECIFIED,
+					},
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
 			},
-			expectedErr:   nil,
-			errorExpected: false,
+			expectedErr: grpcerrors.New(
+				codes.InvalidArgument,
+				&customerrors.InvalidSortError{Message: "sort field TICKETS_SORT_FIELD_UNSPECIFIED is not allowed"},
+			),
+			errorExpected: true,
 		},
 		{
-			name: "internal error",
+			name: "cursor with sort",
 			in: &tickets.GetTicketsIn{
 				Pagination: &tickets.Pagination{
-					Limit:  pointers.New[uint64](1),
-					Offset: pointers.New[uint64](1),
+					Limit:  pointers.New[uint64](2),
+					Cursor: pointers.New(nextCursor),
 				},
-				Filters: &tickets.TicketsFilters{
-					Search:              pointers.New("ticket"),
-					PriceCeil:           pointers.New[float32](1000),
-					PriceFloor:          pointers.New[float32](10),
-					QuantityFloor:       pointers.New[uint32](1),
-					CategoryIDs:         []uint32{1},
-					TagIDs:              []uint32{1},
-					CreatedAtOrderByAsc: pointers.New(true),
+				Sort: []*tickets.TicketsSort{
+					{
+						Field:     tickets.TicketsSortField_TICKETS_SORT_FIELD_PRICE,
+						Direction: tickets.SortDirection_SORT_DIRECTION_DESC,
+					},
 				},
 			},
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
-				useCases.
+				logger.
 					EXPECT().
-					GetTickets(
-						gomock.Any(),
-						&entities.Pagination{
-							Limit:  pointers.New[uint64](1),
-							Offset: pointers.New[uint64](1),
-						},
-						&entities.TicketsFilters{
-							Search:              pointers.New("ticket"),
-							PriceCeil:           pointers.New[float32](1000),
-							PriceFloor:          pointers.New[float32](10),
-							QuantityFloor:       pointers.New[uint32](1),
-							CategoryIDs:         []uint32{1},
-							TagIDs:              []uint32{1},
-							CreatedAtOrderByAsc: pointers.New(true),
-						},
-					).
-					Return(nil, errors.New("internal error")).
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
-
+			},
+			expectedErr: grpcerrors.New(
+				codes.InvalidArgument,
+				&customerrors.InvalidCursorError{Message: "pagination cursor can not be combined with sort"},
+			),
+			errorExpected: true,
+		},
+		{
+			name: "invalid cursor",
+			in: &tickets.GetTicketsIn{
+				Pagination: &tickets.Pagination{
+					Limit:  pointers.New[uint64](2),
+					Cursor: pointers.New("invalid"),
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				logger.
 					EXPECT().
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "internal error"},
+			expectedErr:   grpcerrors.New(codes.InvalidArgument, &customerrors.InvalidCursorError{}),
 			errorExpected: true,
 		},
 	}
@@ -740,11 +1383,18 @@ func TestServerAPI_GetUserTickets(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	useCases := mockusecases.NewMockUseCases(ctrl)
 	logger := mocklogging.NewMockLogger(ctrl)
+	cursorCodec := cursor.New("secret")
 	api := &ServerAPI{
-		useCases: useCases,
-		logger:   logger,
+		useCases:    useCases,
+		cursorCodec: cursorCodec,
+		logger:      logger,
 	}
 
+	nextCursor, err := cursorCodec.Encode(
+		entities.Cursor{CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), ID: 1},
+	)
+	require.NoError(t, err)
+
 	testCases := []struct {
 		name          string
 		in            *tickets.GetUserTicketsIn
@@ -824,6 +1474,7 @@ func TestServerAPI_GetUserTickets(t *testing.T) {
 						UpdatedAt:   timestamppb.New(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)),
 					},
 				},
+				NextCursor: pointers.New(nextCursor),
 			},
 			expectedErr:   nil,
 			errorExpected: false,
@@ -874,7 +1525,149 @@ func TestServerAPI_GetUserTickets(t *testing.T) {
 					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1)
 			},
-			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "internal error"},
+			expectedErr:   grpcerrors.New(codes.Internal, errors.New("internal error")),
+			errorExpected: true,
+		},
+		{
+			name: "success with cursor",
+			in: &tickets.GetUserTicketsIn{
+				UserID: 1,
+				Pagination: &tickets.Pagination{
+					Limit:  pointers.New[uint64](2),
+					Cursor: pointers.New(nextCursor),
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					GetUserTickets(
+						gomock.Any(),
+						uint64(1),
+						&entities.Pagination{
+							Limit: pointers.New[uint64](2),
+							Cursor: &entities.Cursor{
+								CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+								ID:        1,
+							},
+						},
+						nil,
+					).
+					Return(
+						[]entities.Ticket{
+							{
+								ID:        2,
+								UserID:    1,
+								CreatedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+								UpdatedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+							},
+						},
+						nil,
+					).
+					Times(1)
+			},
+			expectedOut: &tickets.GetTicketsOut{
+				Tickets: []*tickets.GetTicketOut{
+					{
+						ID:          2,
+						UserID:      1,
+						Attachments: []*tickets.Attachment{},
+						CreatedAt:   timestamppb.New(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+						UpdatedAt:   timestamppb.New(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+					},
+				},
+			},
+			errorExpected: false,
+		},
+		{
+			name: "cursor with offset",
+			in: &tickets.GetUserTicketsIn{
+				UserID: 1,
+				Pagination: &tickets.Pagination{
+					Limit:  pointers.New[uint64](2),
+					Offset: pointers.New[uint64](2),
+					Cursor: pointers.New(nextCursor),
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr: grpcerrors.New(
+				codes.InvalidArgument,
+				&customerrors.InvalidCursorError{Message: "pagination cursor can not be combined with offset"},
+			),
+			errorExpected: true,
+		},
+		{
+			name: "cursor with relevance sort",
+			in: &tickets.GetUserTicketsIn{
+				UserID: 1,
+				Pagination: &tickets.Pagination{
+					Limit:  pointers.New[uint64](2),
+					Cursor: pointers.New(nextCursor),
+				},
+				Filters: &tickets.TicketsFilters{
+					Search: pointers.New("ticket"),
+				},
+				Sort: []*tickets.TicketsSort{
+					{
+						Field:     tickets.TicketsSortField_TICKETS_SORT_FIELD_RELEVANCE,
+						Direction: tickets.SortDirection_SORT_DIRECTION_DESC,
+					},
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr: grpcerrors.New(
+				codes.InvalidArgument,
+				&customerrors.InvalidCursorError{Message: "pagination cursor can not be combined with sort"},
+			),
+			errorExpected: true,
+		},
+		{
+			name: "unspecified sort field",
+			in: &tickets.GetUserTicketsIn{
+				UserID: 1,
+				Sort: []*tickets.TicketsSort{
+					{
+						Field: tickets.TicketsSortField_TICKETS_SORT_FIELD_UNSPECIFIED,
+					},
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr: grpcerrors.New(
+				codes.InvalidArgument,
+				&customerrors.InvalidSortError{Message: "sort field TICKETS_SORT_FIELD_UNSPECIFIED is not allowed"},
+			),
+			errorExpected: true,
+		},
+		{
+			name: "invalid cursor",
+			in: &tickets.GetUserTicketsIn{
+				UserID: 1,
+				Pagination: &tickets.Pagination{
+					Limit:  pointers.New[uint64](2),
+					Cursor: pointers.New("invalid"),
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   grpcerrors.New(codes.InvalidArgument, &customerrors.InvalidCursorError{}),
 			errorExpected: true,
 		},
 	}
@@ -1012,3 +1805,179 @@ func TestServerAPI_CountUserTickets(t *testing.T) {
 		})
 	}
 }
+
+// watchTicketsStream collects messages, sent to server stream.
+type watchTicketsStream struct {
+	grpc.ServerStream
+	sent []*tickets.WatchTicketsOut
+}
+
+func (stream *watchTicketsStream) Context() context.Context {
+	return context.Background()
+}
+
+func (stream *watchTicketsStream) Send(out *tickets.WatchTicketsOut) error {
+	stream.sent = append(stream.sent, out)
+
+	return nil
+}
+
+func TestServerAPI_WatchTickets(t *testing.T) {
+	ticket := entities.Ticket{ID: 1, Status: entities.TicketStatusOpen}
+
+	testCases := []struct {
+		name          string
+		in            *tickets.WatchTicketsIn
+		setupMocks    func(usecases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expected      []*tickets.WatchTicketsOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &tickets.WatchTicketsIn{
+				Filters:  &tickets.TicketsFilters{CategoryIDs: []uint32{1}},
+				Snapshot: true,
+			},
+			setupMocks: func(usecases *mockusecases.MockUseCases, _ *mocklogging.MockLogger) {
+				usecases.
+					EXPECT().
+					WatchTickets(gomock.Any(), &entities.TicketsFilters{CategoryIDs: []uint32{1}}, true, gomock.Any()).
+					DoAndReturn(
+						func(
+							_ context.Context,
+							_ *entities.TicketsFilters,
+							_ bool,
+							send func(event entities.TicketEvent) error,
+						) error {
+							if err := send(
+								entities.TicketEvent{Type: entities.TicketEventTypeSnapshot, Ticket: ticket},
+							); err != nil {
+								return err
+							}
+
+							return send(entities.TicketEvent{Type: entities.TicketEventTypeDeleted, Ticket: ticket})
+						},
+					).
+					Times(1)
+			},
+			expected: []*tickets.WatchTicketsOut{
+				{
+					Type:   tickets.TicketEventType_TICKET_EVENT_TYPE_SNAPSHOT,
+					Ticket: mapTicketToOut(ticket),
+				},
+				{
+					Type:   tickets.TicketEventType_TICKET_EVENT_TYPE_DELETED,
+					Ticket: mapTicketToOut(ticket),
+				},
+			},
+		},
+		{
+			name: "too many streams",
+			in:   &tickets.WatchTicketsIn{},
+			setupMocks: func(usecases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				usecases.
+					EXPECT().
+					WatchTickets(gomock.Any(), nil, false, gomock.Any()).
+					Return(&customerrors.TooManyWatchStreamsError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.ResourceExhausted,
+		},
+		{
+			name: "search is not supported",
+			in: &tickets.WatchTicketsIn{
+				Filters: &tickets.TicketsFilters{Search: pointers.New("horse")},
+			},
+			setupMocks: func(usecases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				usecases.
+					EXPECT().
+					WatchTickets(
+						gomock.Any(),
+						&entities.TicketsFilters{Search: pointers.New("horse")},
+						false,
+						gomock.Any(),
+					).
+					Return(&customerrors.WatchSearchNotSupportedError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.InvalidArgument,
+		},
+		{
+			name: "slow stream",
+			in:   &tickets.WatchTicketsIn{},
+			setupMocks: func(usecases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				usecases.
+					EXPECT().
+					WatchTickets(gomock.Any(), nil, false, gomock.Any()).
+					Return(&customerrors.SlowWatchStreamError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.ResourceExhausted,
+		},
+		{
+			name: "error",
+			in:   &tickets.WatchTicketsIn{},
+			setupMocks: func(usecases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				usecases.
+					EXPECT().
+					WatchTickets(gomock.Any(), nil, false, gomock.Any()).
+					Return(errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	usecases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		logger:   logger,
+		useCases: usecases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(usecases, logger)
+			}
+
+			stream := &watchTicketsStream{}
+
+			err := api.WatchTickets(tc.in, stream)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, stream.sent)
+		})
+	}
+}
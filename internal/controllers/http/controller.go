@@ -0,0 +1,95 @@
+package httpcontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+)
+
+// readHeaderTimeout protects gateway from clients, which send headers too slowly.
+const readHeaderTimeout = 10 * time.Second
+
+// New creates an instance of HTTP/JSON gateway Controller. Gateway translates REST requests to calls
+// of gRPC server, available at the same host and grpcPort, so all gRPC interceptors are applied to them too.
+func New(
+	host string,
+	grpcPort int,
+	gatewayConfig config.GatewayConfig,
+	logger logging.Logger,
+) (*Controller, error) {
+	connection, err := grpc.NewClient(
+		fmt.Sprintf("%s:%d", host, grpcPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		logging.LogError(logger, "Failed to create gRPC client for HTTP gateway", err)
+
+		return nil, err
+	}
+
+	handler, err := newHandler(context.Background(), connection)
+	if err != nil {
+		logging.LogError(logger, "Failed to register HTTP gateway handlers", err)
+
+		return nil, err
+	}
+
+	return &Controller{
+		httpServer: &http.Server{
+			Addr:              fmt.Sprintf("%s:%d", host, gatewayConfig.Port),
+			Handler:           handler,
+			ReadHeaderTimeout: readHeaderTimeout,
+		},
+		connection:      connection,
+		shutdownTimeout: gatewayConfig.ShutdownTimeout,
+		logger:          logger,
+	}, nil
+}
+
+type Controller struct {
+	httpServer      *http.Server
+	connection      *grpc.ClientConn
+	shutdownTimeout time.Duration
+	logger          logging.Logger
+}
+
+// Run HTTP server.
+func (controller *Controller) Run() {
+	logging.LogInfo(
+		controller.logger,
+		fmt.Sprintf("Starting HTTP gateway at http://%s", controller.httpServer.Addr),
+	)
+
+	if err := controller.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logging.LogError(controller.logger, "Error occurred while listening to HTTP gateway", err)
+		panic(err)
+	}
+
+	logging.LogInfo(controller.logger, "Stopped serving new HTTP connections.")
+}
+
+// Stop HTTP server gracefully (graceful shutdown). Should be called before stopping gRPC server,
+// so active requests can be proxied to it.
+func (controller *Controller) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), controller.shutdownTimeout)
+	defer cancel()
+
+	// Stops accepting new requests and waits for already received requests to be processed:
+	if err := controller.httpServer.Shutdown(ctx); err != nil {
+		logging.LogError(controller.logger, "Failed to shutdown HTTP gateway gracefully", err)
+	}
+
+	if err := controller.connection.Close(); err != nil {
+		logging.LogError(controller.logger, "Failed to close gRPC connection of HTTP gateway", err)
+	}
+
+	logging.LogInfo(controller.logger, "HTTP gateway graceful shutdown completed.")
+}
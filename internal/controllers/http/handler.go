@@ -0,0 +1,74 @@
+package httpcontroller
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/DKhorkov/hmtm-tickets/api/openapi"
+	"github.com/DKhorkov/hmtm-tickets/api/protobuf/generated/go/tickets"
+	"github.com/DKhorkov/hmtm-tickets/internal/controllers/grpc/idempotency"
+)
+
+const openAPIPath = "/openapi.json"
+
+// newHandler creates handler, which serves REST routes of TicketsService and RespondsService,
+// described in .proto files, and OpenAPI document of these routes.
+func newHandler(ctx context.Context, connection *grpc.ClientConn) (http.Handler, error) {
+	gatewayMux := runtime.NewServeMux(
+		runtime.WithErrorHandler(errorHandler),
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+	)
+
+	if err := tickets.RegisterTicketsServiceHandler(ctx, gatewayMux, connection); err != nil {
+		return nil, err
+	}
+
+	if err := tickets.RegisterRespondsServiceHandler(ctx, gatewayMux, connection); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+openAPIPath, serveOpenAPI)
+	mux.Handle("/", gatewayMux)
+
+	return mux, nil
+}
+
+func serveOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(openapi.Document)
+}
+
+// errorHandler maps gRPC codes, which are returned by gRPC controllers for customerrors types, to HTTP statuses.
+// FailedPrecondition is used for actions, which are not allowed in current state of Ticket or Respond,
+// so it is mapped to 409 Conflict instead of default 400 Bad Request. Other codes use default mapping:
+// InvalidArgument - 400, PermissionDenied - 403, NotFound - 404, AlreadyExists and Aborted - 409.
+func errorHandler(
+	ctx context.Context,
+	mux *runtime.ServeMux,
+	marshaler runtime.Marshaler,
+	w http.ResponseWriter,
+	r *http.Request,
+	err error,
+) {
+	if status.Code(err) == codes.FailedPrecondition {
+		err = &runtime.HTTPStatusError{HTTPStatus: http.StatusConflict, Err: err}
+	}
+
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+// headerMatcher passes Idempotency-Key header to gRPC metadata in addition to default headers.
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, idempotency.MetadataKey) {
+		return idempotency.MetadataKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -0,0 +1,229 @@
+package httpcontroller
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/DKhorkov/hmtm-tickets/api/protobuf/generated/go/tickets"
+	"github.com/DKhorkov/hmtm-tickets/internal/controllers/grpc/grpcerrors"
+	"github.com/DKhorkov/hmtm-tickets/internal/controllers/grpc/idempotency"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
+)
+
+type ticketsServer struct {
+	tickets.UnimplementedTicketsServiceServer
+	err error
+}
+
+func (server *ticketsServer) GetTicket(_ context.Context, in *tickets.GetTicketIn) (*tickets.GetTicketOut, error) {
+	if server.err != nil {
+		return nil, server.err
+	}
+
+	return &tickets.GetTicketOut{ID: in.GetID(), Name: "Ticket"}, nil
+}
+
+type respondsServer struct {
+	tickets.UnimplementedRespondsServiceServer
+	ticketID       uint64
+	idempotencyKey string
+}
+
+func (server *respondsServer) RespondToTicket(
+	ctx context.Context,
+	in *tickets.RespondToTicketIn,
+) (*tickets.RespondToTicketOut, error) {
+	server.ticketID = in.GetTicketID()
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(idempotency.MetadataKey)) > 0 {
+		server.idempotencyKey = md.Get(idempotency.MetadataKey)[0]
+	}
+
+	return &tickets.RespondToTicketOut{RespondID: 1}, nil
+}
+
+func newTestHandler(t *testing.T, ticketsServer *ticketsServer, respondsServer *respondsServer) http.Handler {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	tickets.RegisterTicketsServiceServer(grpcServer, ticketsServer)
+	tickets.RegisterRespondsServiceServer(grpcServer, respondsServer)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	t.Cleanup(grpcServer.Stop)
+
+	connection, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = connection.Close()
+	})
+
+	handler, err := newHandler(context.Background(), connection)
+	require.NoError(t, err)
+
+	return handler
+}
+
+func TestHandler_GetTicket(t *testing.T) {
+	handler := newTestHandler(t, &ticketsServer{}, &respondsServer{})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/tickets/1", nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	require.Equal(t, "1", body["ID"]) // uint64 is encoded as string according to proto3 JSON mapping
+	require.Equal(t, "Ticket", body["name"])
+}
+
+func TestHandler_ErrorStatuses(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            error
+		expectedStatus int
+	}{
+		{
+			name:           "invalid argument",
+			err:            status.Error(codes.InvalidArgument, "invalid argument"),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "permission denied",
+			err:            status.Error(codes.PermissionDenied, "permission denied"),
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "not found",
+			err:            status.Error(codes.NotFound, "ticket not found"),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "already exists",
+			err:            status.Error(codes.AlreadyExists, "ticket already exists"),
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "failed precondition",
+			err:            status.Error(codes.FailedPrecondition, "ticket is not open"),
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "aborted",
+			err:            status.Error(codes.Aborted, "version conflict"),
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "internal",
+			err:            status.Error(codes.Internal, "internal error"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := newTestHandler(t, &ticketsServer{err: tc.err}, &respondsServer{})
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/tickets/1", nil))
+
+			require.Equal(t, tc.expectedStatus, recorder.Code)
+
+			var body map[string]any
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+			require.Equal(t, status.Convert(tc.err).Message(), body["message"])
+		})
+	}
+}
+
+func TestHandler_ErrorDetails(t *testing.T) {
+	handler := newTestHandler(
+		t,
+		&ticketsServer{err: grpcerrors.New(codes.InvalidArgument, &customerrors.TagNotFoundError{Message: "7", Index: 1})},
+		&respondsServer{},
+	)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/tickets/1", nil))
+
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var body struct {
+		Details []struct {
+			Type            string            `json:"@type"`
+			Reason          string            `json:"reason"`
+			Metadata        map[string]string `json:"metadata"`
+			FieldViolations []struct {
+				Field string `json:"field"`
+			} `json:"fieldViolations"`
+		} `json:"details"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	require.Len(t, body.Details, 2)
+
+	require.Equal(t, "type.googleapis.com/google.rpc.ErrorInfo", body.Details[0].Type)
+	require.Equal(t, customerrors.ReasonTagNotFound, body.Details[0].Reason)
+	require.Equal(t, map[string]string{"tagID": "7"}, body.Details[0].Metadata)
+
+	require.Equal(t, "type.googleapis.com/google.rpc.BadRequest", body.Details[1].Type)
+	require.Len(t, body.Details[1].FieldViolations, 1)
+	require.Equal(t, "tagIDs[1]", body.Details[1].FieldViolations[0].Field)
+}
+
+func TestHandler_RespondToTicket(t *testing.T) {
+	responds := &respondsServer{}
+	handler := newTestHandler(t, &ticketsServer{}, responds)
+
+	request := httptest.NewRequest(
+		http.MethodPost,
+		"/v1/tickets/2/responds",
+		strings.NewReader(`{"userID": "1", "price": 100}`),
+	)
+	request.Header.Set("Idempotency-Key", "key")
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, uint64(2), responds.ticketID)
+	require.Equal(t, "key", responds.idempotencyKey)
+}
+
+func TestHandler_OpenAPI(t *testing.T) {
+	handler := newTestHandler(t, &ticketsServer{}, &respondsServer{})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, openAPIPath, nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+	var document map[string]any
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &document))
+	require.Contains(t, document["paths"], "/v1/tickets/{ID}")
+	require.Contains(t, document["paths"], "/v1/tickets/{ticketID}/responds")
+}
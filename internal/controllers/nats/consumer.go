@@ -0,0 +1,228 @@
+package natscontroller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/DKhorkov/libs/logging"
+	"github.com/nats-io/nats.go/jetstream"
+
+	customnats "github.com/DKhorkov/libs/nats"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+)
+
+// ackWaitFactor makes JetStream wait for acknowledgement longer than handler is allowed to process message,
+// so message is not redelivered to other instance, while it is still being processed.
+const ackWaitFactor = 2
+
+// Message provides subject and data of message, received via durable consumer or core NATS subscription.
+type Message interface {
+	Subject() string
+	Data() []byte
+}
+
+// MessageHandler processes received message. Message, received via durable consumer, is redelivered,
+// if error is returned, so handlers must be idempotent.
+type MessageHandler func(ctx context.Context, message Message) error
+
+// CreateStream creates or updates subscriber stream, which stores events with provided subjects until they are
+// processed. Stream has work queue retention, so each event is deleted after its acknowledgement.
+func CreateStream(
+	ctx context.Context,
+	js jetstream.JetStream,
+	subscriberConfig config.NATSSubscriber,
+	subjects []string,
+) error {
+	_, err := js.CreateOrUpdateStream(
+		ctx,
+		jetstream.StreamConfig{
+			Name:      subscriberConfig.StreamName,
+			Subjects:  subjects,
+			Storage:   jetstream.FileStorage,
+			Retention: jetstream.WorkQueuePolicy,
+		},
+	)
+
+	return err
+}
+
+// NewConsumer creates Worker, which processes messages with provided subject from subscriber stream via durable
+// consumer. Durable consumer is shared by all service instances, so, like with queue group, each message is
+// processed by one instance. Message is acknowledged only after successful processing. Message, which has not
+// been processed in MaxDeliveries attempts, is terminated, so it is not redelivered forever.
+func NewConsumer(
+	ctx context.Context,
+	js jetstream.JetStream,
+	subscriberConfig config.NATSSubscriber,
+	subject string,
+	handler MessageHandler,
+	logger logging.Logger,
+) (*Consumer, error) {
+	consumer, err := js.CreateOrUpdateConsumer(
+		ctx,
+		subscriberConfig.StreamName,
+		jetstream.ConsumerConfig{
+			Durable:       durableName(subscriberConfig.Name, subject),
+			FilterSubject: subject,
+			AckPolicy:     jetstream.AckExplicitPolicy,
+			AckWait:       subscriberConfig.HandlerTimeout * ackWaitFactor,
+			MaxDeliver:    subscriberConfig.MaxDeliveries,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Consumer{
+		consumer:       consumer,
+		config:         subscriberConfig,
+		handler:        handler,
+		logger:         logger,
+		messageChannel: make(chan jetstream.Msg, subscriberConfig.MessageChannelBufferSize),
+		wg:             new(sync.WaitGroup),
+	}, nil
+}
+
+type Consumer struct {
+	consumer       jetstream.Consumer
+	consumeContext jetstream.ConsumeContext
+	config         config.NATSSubscriber
+	handler        MessageHandler
+	logger         logging.Logger
+	messageChannel chan jetstream.Msg
+	wg             *sync.WaitGroup
+	isRunning      bool
+	isStopped      bool
+}
+
+// Run starts processing messages in GoroutinesPoolSize goroutines, so it does not block.
+func (consumer *Consumer) Run() error {
+	if consumer.isRunning {
+		return &customnats.WorkerAlreadyRunningError{}
+	}
+
+	consumer.wg.Add(consumer.config.GoroutinesPoolSize)
+
+	for range consumer.config.GoroutinesPoolSize {
+		go func() {
+			defer consumer.wg.Done()
+
+			for message := range consumer.messageChannel {
+				consumer.processMessage(message)
+			}
+		}()
+	}
+
+	consumeContext, err := consumer.consumer.Consume(
+		func(message jetstream.Msg) {
+			consumer.messageChannel <- message
+		},
+	)
+	if err != nil {
+		close(consumer.messageChannel)
+		consumer.wg.Wait()
+
+		return err
+	}
+
+	consumer.consumeContext = consumeContext
+	consumer.isRunning = true
+
+	return nil
+}
+
+// Stop stops receiving messages and waits for already received messages to be processed.
+func (consumer *Consumer) Stop() error {
+	if !consumer.isRunning || consumer.isStopped {
+		return &customnats.WorkerAlreadyStoppedError{}
+	}
+
+	consumer.consumeContext.Stop()
+	<-consumer.consumeContext.Closed()
+
+	close(consumer.messageChannel)
+	consumer.wg.Wait()
+
+	consumer.isStopped = true
+
+	return nil
+}
+
+func (consumer *Consumer) processMessage(message jetstream.Msg) {
+	ctx, cancel := context.WithTimeout(context.Background(), consumer.config.HandlerTimeout)
+	defer cancel()
+
+	// Handler has already logged processing error, so message is only scheduled for redelivery:
+	if err := consumer.handler(ctx, message); err != nil {
+		if consumer.isLastDelivery(message) {
+			consumer.terminate(ctx, message, err)
+
+			return
+		}
+
+		if err = message.NakWithDelay(consumer.config.RetryDelay); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				consumer.logger,
+				fmt.Sprintf("Failed to nak message from subject=%s", message.Subject()),
+				err,
+			)
+		}
+
+		return
+	}
+
+	if err := message.Ack(); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			consumer.logger,
+			fmt.Sprintf("Failed to ack message from subject=%s", message.Subject()),
+			err,
+		)
+	}
+}
+
+// isLastDelivery checks whether message has been delivered MaxDeliveries times, so JetStream will not
+// redeliver it anymore.
+func (consumer *Consumer) isLastDelivery(message jetstream.Msg) bool {
+	metadata, err := message.Metadata()
+	if err != nil {
+		return false
+	}
+
+	return metadata.NumDelivered >= uint64(consumer.config.MaxDeliveries)
+}
+
+// terminate removes message, which can not be processed, from stream. Message data is logged, so event
+// can be processed manually. JetStream also publishes advisory about terminated message.
+func (consumer *Consumer) terminate(ctx context.Context, message jetstream.Msg, processingErr error) {
+	logging.LogErrorContext(
+		ctx,
+		consumer.logger,
+		fmt.Sprintf(
+			"Message from subject=%s has not been processed in %d attempts and is dropped: %s",
+			message.Subject(),
+			consumer.config.MaxDeliveries,
+			message.Data(),
+		),
+		processingErr,
+	)
+
+	if err := message.Term(); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			consumer.logger,
+			fmt.Sprintf("Failed to terminate message from subject=%s", message.Subject()),
+			err,
+		)
+	}
+}
+
+// durableName builds consumer name, which is the same for all service instances. Dots are not allowed
+// in consumer names, but can be used in subjects.
+func durableName(subscriberName, subject string) string {
+	return strings.ReplaceAll(fmt.Sprintf("%s-%s", subscriberName, subject), ".", "_")
+}
@@ -0,0 +1,170 @@
+package natscontroller
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	natsserver "github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+)
+
+const testSubject = "user-deleted"
+
+var testSubscriberConfig = config.NATSSubscriber{
+	Name:                     "hmtm-tickets-subscriber",
+	GoroutinesPoolSize:       1,
+	MessageChannelBufferSize: 1,
+	StreamName:               "HMTM_TICKETS_EVENTS",
+	HandlerTimeout:           time.Second,
+	RetryDelay:               10 * time.Millisecond,
+	MaxDeliveries:            5,
+}
+
+func TestConsumer_Redelivery(t *testing.T) {
+	_, js := runJetStream(t)
+	ctx := context.Background()
+	require.NoError(t, CreateStream(ctx, js, testSubscriberConfig, []string{testSubject}))
+
+	var (
+		attempts    atomic.Int32
+		hasDeadline atomic.Bool
+	)
+
+	processed := make(chan []byte, 1)
+	handler := func(ctx context.Context, message Message) error {
+		_, ok := ctx.Deadline()
+		hasDeadline.Store(ok)
+
+		// First attempt fails, so message must be redelivered:
+		if attempts.Add(1) == 1 {
+			return errors.New("test error")
+		}
+
+		processed <- message.Data()
+
+		return nil
+	}
+
+	consumer, err := NewConsumer(
+		ctx,
+		js,
+		testSubscriberConfig,
+		testSubject,
+		handler,
+		mocklogging.NewMockLogger(gomock.NewController(t)),
+	)
+	require.NoError(t, err)
+	require.NoError(t, consumer.Run())
+
+	_, err = js.Publish(ctx, testSubject, []byte(`{"userId":1}`))
+	require.NoError(t, err)
+
+	select {
+	case data := <-processed:
+		require.Equal(t, []byte(`{"userId":1}`), data)
+	case <-time.After(5 * time.Second):
+		t.Fatal("message has not been redelivered")
+	}
+
+	require.NoError(t, consumer.Stop())
+	require.Error(t, consumer.Stop())
+	require.Equal(t, int32(2), attempts.Load())
+	require.True(t, hasDeadline.Load(), "handler context must be bounded")
+
+	// Acknowledged message is removed from work queue stream:
+	require.Eventually(
+		t,
+		func() bool {
+			stream, err := js.Stream(ctx, testSubscriberConfig.StreamName)
+			if err != nil {
+				return false
+			}
+
+			info, err := stream.Info(ctx)
+
+			return err == nil && info.State.Msgs == 0
+		},
+		5*time.Second,
+		10*time.Millisecond,
+	)
+}
+
+func TestConsumer_MaxDeliveries(t *testing.T) {
+	_, js := runJetStream(t)
+	ctx := context.Background()
+	require.NoError(t, CreateStream(ctx, js, testSubscriberConfig, []string{testSubject}))
+
+	var attempts atomic.Int32
+	handler := func(_ context.Context, _ Message) error {
+		attempts.Add(1)
+
+		return errors.New("test error")
+	}
+
+	logger := mocklogging.NewMockLogger(gomock.NewController(t))
+
+	// Message is terminated with logging of its data after last failed attempt:
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	consumer, err := NewConsumer(ctx, js, testSubscriberConfig, testSubject, handler, logger)
+	require.NoError(t, err)
+	require.NoError(t, consumer.Run())
+
+	_, err = js.Publish(ctx, testSubject, []byte(`{"userId":1}`))
+	require.NoError(t, err)
+
+	// Terminated message is removed from work queue stream:
+	require.Eventually(
+		t,
+		func() bool {
+			stream, err := js.Stream(ctx, testSubscriberConfig.StreamName)
+			if err != nil {
+				return false
+			}
+
+			info, err := stream.Info(ctx)
+
+			return err == nil && info.State.Msgs == 0
+		},
+		5*time.Second,
+		10*time.Millisecond,
+	)
+
+	require.NoError(t, consumer.Stop())
+	require.Equal(t, int32(testSubscriberConfig.MaxDeliveries), attempts.Load())
+}
+
+func runJetStream(t *testing.T) (*nats.Conn, jetstream.JetStream) {
+	t.Helper()
+
+	options := natsserver.DefaultTestOptions
+	options.Port = server.RANDOM_PORT
+	options.JetStream = true
+	options.StoreDir = t.TempDir()
+
+	natsServer := natsserver.RunServer(&options)
+	t.Cleanup(natsServer.Shutdown)
+
+	connection, err := nats.Connect(natsServer.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(connection.Close)
+
+	js, err := jetstream.New(connection)
+	require.NoError(t, err)
+
+	return connection, js
+}
@@ -0,0 +1,44 @@
+package natscontroller
+
+import (
+	"github.com/DKhorkov/libs/logging"
+
+	customnats "github.com/DKhorkov/libs/nats"
+)
+
+// New creates an instance of NATS Controller, which processes events from other services via provided workers.
+func New(logger logging.Logger, workers ...customnats.Worker) *Controller {
+	return &Controller{
+		workers: workers,
+		logger:  logger,
+	}
+}
+
+type Controller struct {
+	workers []customnats.Worker
+	logger  logging.Logger
+}
+
+// Run starts all workers. Workers process messages in their own goroutines, so Run does not block.
+func (controller *Controller) Run() error {
+	for _, worker := range controller.workers {
+		if err := worker.Run(); err != nil {
+			return err
+		}
+	}
+
+	logging.LogInfo(controller.logger, "Started NATS workers.")
+
+	return nil
+}
+
+// Stop stops all workers and waits for already received messages to be processed.
+func (controller *Controller) Stop() {
+	for _, worker := range controller.workers {
+		if err := worker.Stop(); err != nil {
+			logging.LogError(controller.logger, "Failed to stop NATS worker", err)
+		}
+	}
+
+	logging.LogInfo(controller.logger, "Stopped NATS workers.")
+}
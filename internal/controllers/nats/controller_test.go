@@ -0,0 +1,74 @@
+package natscontroller
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	mocknats "github.com/DKhorkov/libs/nats/mocks"
+)
+
+func TestController_Run(t *testing.T) {
+	testCases := []struct {
+		name          string
+		setupMocks    func(first, second *mocknats.MockWorker, logger *mocklogging.MockLogger)
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(first, second *mocknats.MockWorker, logger *mocklogging.MockLogger) {
+				first.EXPECT().Run().Return(nil).Times(1)
+				second.EXPECT().Run().Return(nil).Times(1)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name: "worker error",
+			setupMocks: func(first, second *mocknats.MockWorker, logger *mocklogging.MockLogger) {
+				first.EXPECT().Run().Return(errors.New("test error")).Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			first := mocknats.NewMockWorker(ctrl)
+			second := mocknats.NewMockWorker(ctrl)
+			logger := mocklogging.NewMockLogger(ctrl)
+			controller := New(logger, first, second)
+
+			if tc.setupMocks != nil {
+				tc.setupMocks(first, second, logger)
+			}
+
+			err := controller.Run()
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestController_Stop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	first := mocknats.NewMockWorker(ctrl)
+	second := mocknats.NewMockWorker(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	controller := New(logger, first, second)
+
+	// Error of one worker should not prevent other workers from stopping:
+	first.EXPECT().Stop().Return(errors.New("test error")).Times(1)
+	second.EXPECT().Stop().Return(nil).Times(1)
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).Times(1)
+
+	controller.Stop()
+}
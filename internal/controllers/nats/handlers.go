@@ -0,0 +1,180 @@
+package natscontroller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-tickets/dto"
+	"github.com/DKhorkov/hmtm-tickets/internal/interfaces"
+)
+
+// errFallbackCategoryDeleted is logged, when deleted Category is fallback one, since Tickets can not be moved
+// from Category to itself.
+var errFallbackCategoryDeleted = errors.New("fallback category has been deleted")
+
+// NewHandlers creates handlers for hmtm-toys and hmtm-sso events. Tickets of deleted Category are moved
+// to Category with fallbackCategoryID.
+func NewHandlers(useCases interfaces.UseCases, fallbackCategoryID uint32, logger logging.Logger) *Handlers {
+	return &Handlers{
+		useCases:           useCases,
+		fallbackCategoryID: fallbackCategoryID,
+		logger:             logger,
+	}
+}
+
+// Handlers process events about entities, deleted in hmtm-toys and hmtm-sso. In JetStream mode events are
+// redelivered, if processing has failed, so all handlers are idempotent. Invalid message can not be processed
+// on redelivery too, so it is only logged and acknowledged.
+type Handlers struct {
+	useCases           interfaces.UseCases
+	fallbackCategoryID uint32
+	logger             logging.Logger
+}
+
+// HandleCategoryDeleted moves Tickets of deleted Category to fallback Category. Event about deletion
+// of fallback Category can not be processed on redelivery, so it is only logged and acknowledged.
+func (handlers *Handlers) HandleCategoryDeleted(ctx context.Context, message Message) error {
+	var categoryDeletedDTO dto.CategoryDeletedDTO
+	if err := json.Unmarshal(message.Data(), &categoryDeletedDTO); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			handlers.logger,
+			fmt.Sprintf("Failed to parse message from subject=%s", message.Subject()),
+			err,
+		)
+
+		return nil
+	}
+
+	if categoryDeletedDTO.CategoryID == handlers.fallbackCategoryID {
+		logging.LogErrorContext(
+			ctx,
+			handlers.logger,
+			fmt.Sprintf(
+				"Tickets can not be moved from deleted fallback Category with ID=%d",
+				categoryDeletedDTO.CategoryID,
+			),
+			errFallbackCategoryDeleted,
+		)
+
+		return nil
+	}
+
+	err := handlers.useCases.UpdateTicketsCategory(
+		ctx,
+		categoryDeletedDTO.CategoryID,
+		handlers.fallbackCategoryID,
+	)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			handlers.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to move Tickets from deleted Category with ID=%d",
+				categoryDeletedDTO.CategoryID,
+			),
+			err,
+		)
+
+		return err
+	}
+
+	return nil
+}
+
+// HandleTagDeleted removes deleted Tag from all Tickets.
+func (handlers *Handlers) HandleTagDeleted(ctx context.Context, message Message) error {
+	var tagDeletedDTO dto.TagDeletedDTO
+	if err := json.Unmarshal(message.Data(), &tagDeletedDTO); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			handlers.logger,
+			fmt.Sprintf("Failed to parse message from subject=%s", message.Subject()),
+			err,
+		)
+
+		return nil
+	}
+
+	if err := handlers.useCases.DeleteTagFromTickets(ctx, tagDeletedDTO.TagID); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			handlers.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to remove deleted Tag with ID=%d from Tickets",
+				tagDeletedDTO.TagID,
+			),
+			err,
+		)
+
+		return err
+	}
+
+	return nil
+}
+
+// HandleMasterDeleted withdraws pending Responds of deleted Master.
+func (handlers *Handlers) HandleMasterDeleted(ctx context.Context, message Message) error {
+	var masterDeletedDTO dto.MasterDeletedDTO
+	if err := json.Unmarshal(message.Data(), &masterDeletedDTO); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			handlers.logger,
+			fmt.Sprintf("Failed to parse message from subject=%s", message.Subject()),
+			err,
+		)
+
+		return nil
+	}
+
+	if err := handlers.useCases.WithdrawMasterResponds(ctx, masterDeletedDTO.MasterID); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			handlers.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to withdraw Responds of deleted Master with ID=%d",
+				masterDeletedDTO.MasterID,
+			),
+			err,
+		)
+
+		return err
+	}
+
+	return nil
+}
+
+// HandleUserDeleted purges personal data of deleted User.
+func (handlers *Handlers) HandleUserDeleted(ctx context.Context, message Message) error {
+	var userDeletedDTO dto.UserDeletedDTO
+	if err := json.Unmarshal(message.Data(), &userDeletedDTO); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			handlers.logger,
+			fmt.Sprintf("Failed to parse message from subject=%s", message.Subject()),
+			err,
+		)
+
+		return nil
+	}
+
+	if err := handlers.useCases.DeleteUserData(ctx, userDeletedDTO.UserID); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			handlers.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to delete data of deleted User with ID=%d",
+				userDeletedDTO.UserID,
+			),
+			err,
+		)
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,399 @@
+package natscontroller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+
+	mockusecases "github.com/DKhorkov/hmtm-tickets/mocks/usecases"
+)
+
+const fallbackCategoryID uint32 = 1
+
+func TestHandlers_HandleCategoryDeleted(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       Message
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		errorExpected bool
+	}{
+		{
+			name:    "success",
+			message: &testMessage{subject: "category-deleted", data: []byte(`{"categoryId":2}`)},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateTicketsCategory(gomock.Any(), uint32(2), fallbackCategoryID).
+					Return(nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name:    "invalid message is not redelivered",
+			message: &testMessage{subject: "category-deleted", data: []byte(`invalid`)},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name:    "fallback category is not redelivered",
+			message: &testMessage{subject: "category-deleted", data: []byte(`{"categoryId":1}`)},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name:    "use cases error",
+			message: &testMessage{subject: "category-deleted", data: []byte(`{"categoryId":2}`)},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateTicketsCategory(gomock.Any(), uint32(2), fallbackCategoryID).
+					Return(errors.New("test error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCases := mockusecases.NewMockUseCases(ctrl)
+			logger := mocklogging.NewMockLogger(ctrl)
+			handlers := NewHandlers(useCases, fallbackCategoryID, logger)
+
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			err := handlers.HandleCategoryDeleted(context.Background(), tc.message)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHandlers_HandleTagDeleted(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       Message
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		errorExpected bool
+	}{
+		{
+			name:    "success",
+			message: &testMessage{subject: "tag-deleted", data: []byte(`{"tagId":3}`)},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					DeleteTagFromTickets(gomock.Any(), uint32(3)).
+					Return(nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name:    "invalid message is not redelivered",
+			message: &testMessage{subject: "tag-deleted", data: []byte(`invalid`)},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name:    "use cases error",
+			message: &testMessage{subject: "tag-deleted", data: []byte(`{"tagId":3}`)},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					DeleteTagFromTickets(gomock.Any(), uint32(3)).
+					Return(errors.New("test error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCases := mockusecases.NewMockUseCases(ctrl)
+			logger := mocklogging.NewMockLogger(ctrl)
+			handlers := NewHandlers(useCases, fallbackCategoryID, logger)
+
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			err := handlers.HandleTagDeleted(context.Background(), tc.message)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHandlers_HandleMasterDeleted(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       Message
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		errorExpected bool
+	}{
+		{
+			name:    "success",
+			message: &testMessage{subject: "master-deleted", data: []byte(`{"masterId":4}`)},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					WithdrawMasterResponds(gomock.Any(), uint64(4)).
+					Return(nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name:    "invalid message is not redelivered",
+			message: &testMessage{subject: "master-deleted", data: []byte(`invalid`)},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name:    "use cases error",
+			message: &testMessage{subject: "master-deleted", data: []byte(`{"masterId":4}`)},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					WithdrawMasterResponds(gomock.Any(), uint64(4)).
+					Return(errors.New("test error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCases := mockusecases.NewMockUseCases(ctrl)
+			logger := mocklogging.NewMockLogger(ctrl)
+			handlers := NewHandlers(useCases, fallbackCategoryID, logger)
+
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			err := handlers.HandleMasterDeleted(context.Background(), tc.message)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHandlers_HandleUserDeleted(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       Message
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		errorExpected bool
+	}{
+		{
+			name:    "success",
+			message: &testMessage{subject: "user-deleted", data: []byte(`{"userId":4}`)},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					DeleteUserData(gomock.Any(), uint64(4)).
+					Return(nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name:    "invalid message is not redelivered",
+			message: &testMessage{subject: "user-deleted", data: []byte(`invalid`)},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name:    "use cases error",
+			message: &testMessage{subject: "user-deleted", data: []byte(`{"userId":4}`)},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					DeleteUserData(gomock.Any(), uint64(4)).
+					Return(errors.New("test error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCases := mockusecases.NewMockUseCases(ctrl)
+			logger := mocklogging.NewMockLogger(ctrl)
+			handlers := NewHandlers(useCases, fallbackCategoryID, logger)
+
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			err := handlers.HandleUserDeleted(context.Background(), tc.message)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHandlers_HandleCategoryDeletedViaConsumers(t *testing.T) {
+	connection, js := runJetStream(t)
+	ctx := context.Background()
+	subject := "category-deleted"
+	require.NoError(t, CreateStream(ctx, js, testSubscriberConfig, []string{subject}))
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	handlers := NewHandlers(useCases, fallbackCategoryID, logger)
+
+	processed := make(chan struct{})
+	gomock.InOrder(
+		// Failed processing must be redelivered:
+		useCases.
+			EXPECT().
+			UpdateTicketsCategory(gomock.Any(), uint32(2), fallbackCategoryID).
+			Return(errors.New("test error")).
+			Times(1),
+		useCases.
+			EXPECT().
+			UpdateTicketsCategory(gomock.Any(), uint32(2), fallbackCategoryID).
+			DoAndReturn(
+				func(_ context.Context, _, _ uint32) error {
+					close(processed)
+
+					return nil
+				},
+			).
+			Times(1),
+	)
+
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	// Consumers of two service instances share durable consumer, so event is processed by one of them:
+	consumers := make([]*Consumer, 0, 2)
+	for range 2 {
+		consumer, err := NewConsumer(ctx, js, testSubscriberConfig, subject, handlers.HandleCategoryDeleted, logger)
+		require.NoError(t, err)
+		require.NoError(t, consumer.Run())
+
+		consumers = append(consumers, consumer)
+	}
+
+	// hmtm-toys publishes events via core NATS, so they are stored by stream without JetStream API:
+	require.NoError(t, connection.Publish(subject, []byte(`{"categoryId":2}`)))
+
+	select {
+	case <-processed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("message has not been processed")
+	}
+
+	require.Eventually(
+		t,
+		func() bool {
+			stream, err := js.Stream(ctx, testSubscriberConfig.StreamName)
+			if err != nil {
+				return false
+			}
+
+			info, err := stream.Info(ctx)
+
+			return err == nil && info.State.Msgs == 0
+		},
+		5*time.Second,
+		10*time.Millisecond,
+	)
+
+	for _, consumer := range consumers {
+		require.NoError(t, consumer.Stop())
+	}
+}
+
+// testMessage provides subject and data of message, which are used by handlers.
+type testMessage struct {
+	subject string
+	data    []byte
+}
+
+func (message *testMessage) Subject() string {
+	return message.subject
+}
+
+func (message *testMessage) Data() []byte {
+	return message.data
+}
@@ -0,0 +1,145 @@
+package natscontroller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/DKhorkov/libs/logging"
+	"github.com/nats-io/nats.go"
+
+	customnats "github.com/DKhorkov/libs/nats"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+)
+
+// NewQueueSubscriber creates Worker, which processes messages with provided subject via core NATS queue
+// subscription. All service instances join queue group with subscriber name, so each message is processed
+// by one instance. Core NATS does not store messages, so failed message is only logged by handler.
+func NewQueueSubscriber(
+	connection *nats.Conn,
+	subscriberConfig config.NATSSubscriber,
+	subject string,
+	handler MessageHandler,
+	logger logging.Logger,
+) *QueueSubscriber {
+	return &QueueSubscriber{
+		connection:     connection,
+		subject:        subject,
+		config:         subscriberConfig,
+		handler:        handler,
+		logger:         logger,
+		messageChannel: make(chan *nats.Msg, subscriberConfig.MessageChannelBufferSize),
+		wg:             new(sync.WaitGroup),
+		mutex:          new(sync.RWMutex),
+	}
+}
+
+type QueueSubscriber struct {
+	connection     *nats.Conn
+	subscription   *nats.Subscription
+	subject        string
+	config         config.NATSSubscriber
+	handler        MessageHandler
+	logger         logging.Logger
+	messageChannel chan *nats.Msg
+	wg             *sync.WaitGroup
+	// mutex guards messageChannel from closing, while subscription callback is sending message to it:
+	mutex     *sync.RWMutex
+	isRunning bool
+	isStopped bool
+}
+
+// Run starts processing messages in GoroutinesPoolSize goroutines, so it does not block.
+func (subscriber *QueueSubscriber) Run() error {
+	if subscriber.isRunning {
+		return &customnats.WorkerAlreadyRunningError{}
+	}
+
+	subscriber.wg.Add(subscriber.config.GoroutinesPoolSize)
+
+	for range subscriber.config.GoroutinesPoolSize {
+		go func() {
+			defer subscriber.wg.Done()
+
+			for message := range subscriber.messageChannel {
+				subscriber.processMessage(message)
+			}
+		}()
+	}
+
+	subscription, err := subscriber.connection.QueueSubscribe(
+		subscriber.subject,
+		subscriber.config.Name,
+		func(message *nats.Msg) {
+			subscriber.mutex.RLock()
+			defer subscriber.mutex.RUnlock()
+
+			if subscriber.isStopped {
+				return
+			}
+
+			subscriber.messageChannel <- message
+		},
+	)
+	if err != nil {
+		close(subscriber.messageChannel)
+		subscriber.wg.Wait()
+
+		return err
+	}
+
+	subscriber.subscription = subscription
+	subscriber.isRunning = true
+
+	return nil
+}
+
+// Stop stops receiving messages and waits for already received messages to be processed.
+func (subscriber *QueueSubscriber) Stop() error {
+	if !subscriber.isRunning || subscriber.isStopped {
+		return &customnats.WorkerAlreadyStoppedError{}
+	}
+
+	if err := subscriber.subscription.Unsubscribe(); err != nil {
+		return err
+	}
+
+	// Callback can still be running after unsubscribing, so channel is closed only after it has finished:
+	subscriber.mutex.Lock()
+	subscriber.isStopped = true
+	close(subscriber.messageChannel)
+	subscriber.mutex.Unlock()
+
+	subscriber.wg.Wait()
+
+	return nil
+}
+
+func (subscriber *QueueSubscriber) processMessage(message *nats.Msg) {
+	ctx, cancel := context.WithTimeout(context.Background(), subscriber.config.HandlerTimeout)
+	defer cancel()
+
+	// Handler has already logged processing error and message can not be redelivered via core NATS:
+	if err := subscriber.handler(ctx, coreMessage{message: message}); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			subscriber.logger,
+			fmt.Sprintf("Message from subject=%s has not been processed and is dropped", message.Subject),
+			err,
+		)
+	}
+}
+
+// coreMessage provides subject and data of core NATS message via Message interface.
+type coreMessage struct {
+	message *nats.Msg
+}
+
+func (message coreMessage) Subject() string {
+	return message.message.Subject
+}
+
+func (message coreMessage) Data() []byte {
+	return message.message.Data
+}
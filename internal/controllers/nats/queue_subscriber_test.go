@@ -0,0 +1,77 @@
+package natscontroller
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	natsserver "github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+)
+
+func TestQueueSubscriber(t *testing.T) {
+	// JetStream is not enabled, so only core NATS is available:
+	natsServer := natsserver.RunRandClientPortServer()
+	t.Cleanup(natsServer.Shutdown)
+
+	connection, err := nats.Connect(natsServer.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(connection.Close)
+
+	var (
+		attempts    atomic.Int32
+		hasDeadline atomic.Bool
+	)
+
+	processed := make(chan []byte, 2)
+	handler := func(ctx context.Context, message Message) error {
+		_, ok := ctx.Deadline()
+		hasDeadline.Store(ok)
+		attempts.Add(1)
+		processed <- message.Data()
+
+		return errors.New("test error")
+	}
+
+	logger := mocklogging.NewMockLogger(gomock.NewController(t))
+
+	// Failed message can not be redelivered, so it is only logged:
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	// Subscribers of two service instances join the same queue group, so message is processed by one of them:
+	subscribers := make([]*QueueSubscriber, 0, 2)
+	for range 2 {
+		subscriber := NewQueueSubscriber(connection, testSubscriberConfig, testSubject, handler, logger)
+		require.NoError(t, subscriber.Run())
+		require.Error(t, subscriber.Run())
+
+		subscribers = append(subscribers, subscriber)
+	}
+
+	require.NoError(t, connection.Publish(testSubject, []byte(`{"userId":1}`)))
+	require.NoError(t, connection.Flush())
+
+	select {
+	case data := <-processed:
+		require.Equal(t, []byte(`{"userId":1}`), data)
+	case <-time.After(5 * time.Second):
+		t.Fatal("message has not been processed")
+	}
+
+	for _, subscriber := range subscribers {
+		require.NoError(t, subscriber.Stop())
+		require.Error(t, subscriber.Stop())
+	}
+
+	require.Equal(t, int32(1), attempts.Load())
+	require.True(t, hasDeadline.Load(), "handler context must be bounded")
+}
@@ -0,0 +1,71 @@
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
+)
+
+const separator = "."
+
+// Codec converts keyset pagination cursors to opaque tokens and back.
+// Tokens are signed with HMAC-SHA256, so clients can not tamper with them.
+type Codec struct {
+	secret []byte
+}
+
+func New(secret string) *Codec {
+	return &Codec{secret: []byte(secret)}
+}
+
+// Encode returns token in "<payload>.<signature>" format, where both parts are base64url encoded.
+func (codec *Codec) Encode(cursor entities.Cursor) (string, error) {
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload) +
+		separator +
+		base64.RawURLEncoding.EncodeToString(codec.sign(payload)), nil
+}
+
+func (codec *Codec) Decode(token string) (*entities.Cursor, error) {
+	encodedPayload, encodedSignature, found := strings.Cut(token, separator)
+	if !found {
+		return nil, &customerrors.InvalidCursorError{}
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, &customerrors.InvalidCursorError{BaseErr: err}
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return nil, &customerrors.InvalidCursorError{BaseErr: err}
+	}
+
+	if !hmac.Equal(signature, codec.sign(payload)) {
+		return nil, &customerrors.InvalidCursorError{Message: "pagination cursor signature mismatch"}
+	}
+
+	var cursor entities.Cursor
+	if err = json.Unmarshal(payload, &cursor); err != nil {
+		return nil, &customerrors.InvalidCursorError{BaseErr: err}
+	}
+
+	return &cursor, nil
+}
+
+func (codec *Codec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, codec.secret)
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
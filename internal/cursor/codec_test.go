@@ -0,0 +1,79 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
+)
+
+func TestCodec_EncodeDecode(t *testing.T) {
+	codec := New("secret")
+	cursor := entities.Cursor{
+		CreatedAt: time.Date(2025, 5, 10, 12, 30, 15, 123456000, time.UTC),
+		ID:        42,
+	}
+
+	token, err := codec.Encode(cursor)
+	require.NoError(t, err)
+
+	decoded, err := codec.Decode(token)
+	require.NoError(t, err)
+	require.Equal(t, cursor, *decoded)
+}
+
+func TestCodec_Decode(t *testing.T) {
+	codec := New("secret")
+	validToken, err := codec.Encode(entities.Cursor{CreatedAt: time.Now().UTC(), ID: 1})
+	require.NoError(t, err)
+
+	payload, signature, _ := strings.Cut(validToken, separator)
+	tamperedPayload := base64.RawURLEncoding.EncodeToString(
+		[]byte(`{"createdAt":"2025-01-01T00:00:00Z","id":1000}`),
+	)
+
+	anotherSecretToken, err := New("another secret").Encode(entities.Cursor{ID: 1})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		token string
+	}{
+		{
+			name:  "without separator",
+			token: payload,
+		},
+		{
+			name:  "invalid payload encoding",
+			token: "!!!" + separator + signature,
+		},
+		{
+			name:  "invalid signature encoding",
+			token: payload + separator + "!!!",
+		},
+		{
+			name:  "tampered payload",
+			token: tamperedPayload + separator + signature,
+		},
+		{
+			name:  "signed with another secret",
+			token: anotherSecretToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cursor, err := codec.Decode(tc.token)
+			require.Nil(t, cursor)
+
+			var invalidCursorError *customerrors.InvalidCursorError
+			require.True(t, errors.As(err, &invalidCursorError))
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package entities
+
+// IdempotencyKeyDTO identifies create request, which can be retried by client. Keys are scoped by User,
+// so different Users can use the same keys.
+type IdempotencyKeyDTO struct {
+	UserID uint64 `json:"userId"`
+	Key    string `json:"key"`
+}
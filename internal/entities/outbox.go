@@ -0,0 +1,26 @@
+package entities
+
+import "time"
+
+type OutboxMessage struct {
+	ID            uint64     `json:"id"`
+	Subject       string     `json:"subject"`
+	Payload       []byte     `json:"payload"`
+	Attempts      uint32     `json:"attempts"`
+	LastError     *string    `json:"lastError,omitempty"`
+	NextAttemptAt time.Time  `json:"nextAttemptAt"`
+	SentAt        *time.Time `json:"sentAt,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+	// DeduplicationID is used as Nats-Msg-Id header in JetStream mode:
+	DeduplicationID *string `json:"deduplicationId,omitempty"`
+}
+
+type CreateOutboxMessageDTO struct {
+	Subject         string  `json:"subject"`
+	Payload         []byte  `json:"payload"`
+	DeduplicationID *string `json:"deduplicationId,omitempty"`
+}
+
+// OutboxMessageBuilder creates outbox message for entity, which ID is known only after its creation.
+type OutboxMessageBuilder func(id uint64) (CreateOutboxMessageDTO, error)
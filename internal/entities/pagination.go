@@ -1,6 +1,15 @@
 package entities
 
+import "time"
+
 type Pagination struct {
 	Limit  *uint64 `json:"limit,omitempty"`
 	Offset *uint64 `json:"offset,omitempty"`
+	Cursor *Cursor `json:"cursor,omitempty"` // keyset pagination, used instead of Offset
+}
+
+// Cursor points to the last Ticket of previous page for keyset pagination.
+type Cursor struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        uint64    `json:"id"`
 }
@@ -2,14 +2,32 @@ package entities
 
 import "time"
 
+type RespondStatus string
+
+const (
+	RespondStatusPending   RespondStatus = "pending"
+	RespondStatusAccepted  RespondStatus = "accepted"
+	RespondStatusRejected  RespondStatus = "rejected"
+	RespondStatusWithdrawn RespondStatus = "withdrawn"
+)
+
+type RespondsSortField string
+
+const (
+	RespondsSortFieldPrice     RespondsSortField = "price"
+	RespondsSortFieldCreatedAt RespondsSortField = "created_at"
+)
+
 type Respond struct {
-	ID        uint64    `json:"id"`
-	TicketID  uint64    `json:"ticketId"`
-	MasterID  uint64    `json:"masterId"`
-	Price     float32   `json:"price"`
-	Comment   *string   `json:"comment,omitempty"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID        uint64        `json:"id"`
+	TicketID  uint64        `json:"ticketId"`
+	MasterID  uint64        `json:"masterId"`
+	Price     float32       `json:"price"`
+	Comment   *string       `json:"comment,omitempty"`
+	CreatedAt time.Time     `json:"createdAt"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+	Status    RespondStatus `json:"status"`
+	Version   uint64        `json:"version"`
 }
 
 type RespondToTicketDTO struct {
@@ -17,17 +35,45 @@ type RespondToTicketDTO struct {
 	MasterID uint64  `json:"masterId"`
 	Price    float32 `json:"price"`
 	Comment  *string `json:"comment,omitempty"`
+	// IdempotencyKey is optional. If it is provided, it is saved with created Respond ID:
+	IdempotencyKey *IdempotencyKeyDTO `json:"idempotencyKey,omitempty"`
 }
 
 type RawRespondToTicketDTO struct {
-	TicketID uint64  `json:"ticketId"`
-	UserID   uint64  `json:"userId"`
-	Price    float32 `json:"price"`
-	Comment  *string `json:"comment,omitempty"`
+	TicketID       uint64  `json:"ticketId"`
+	UserID         uint64  `json:"userId"`
+	Price          float32 `json:"price"`
+	Comment        *string `json:"comment,omitempty"`
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
 }
 
+// UpdateRespondDTO is applied only if Respond still has ExpectedVersion.
 type UpdateRespondDTO struct {
+	ID              uint64   `json:"id"`
+	Price           *float32 `json:"price,omitempty"`
+	Comment         *string  `json:"comment,omitempty"`
+	ExpectedVersion uint64   `json:"expectedVersion"`
+}
+
+type RawUpdateRespondDTO struct {
 	ID      uint64   `json:"id"`
+	UserID  uint64   `json:"userId"`
 	Price   *float32 `json:"price,omitempty"`
 	Comment *string  `json:"comment,omitempty"`
+	// ExpectedVersion is optional. If it is not provided, version of Respond, read before update, is used:
+	ExpectedVersion *uint64 `json:"expectedVersion,omitempty"`
+}
+
+type RespondsFilters struct {
+	PriceCeil     *float32        `json:"priceCeil,omitempty"`  // max price
+	PriceFloor    *float32        `json:"priceFloor,omitempty"` // min price
+	Statuses      []RespondStatus `json:"statuses,omitempty"`
+	CreatedAtFrom *time.Time      `json:"createdAtFrom,omitempty"` // inclusive
+	CreatedAtTo   *time.Time      `json:"createdAtTo,omitempty"`   // exclusive
+	Sort          []RespondsSort  `json:"sort,omitempty"`          // sort keys in priority order
+}
+
+type RespondsSort struct {
+	Field     RespondsSortField `json:"field"`
+	Direction SortDirection     `json:"direction"`
 }
@@ -2,6 +2,39 @@ package entities
 
 import "time"
 
+type TicketStatus string
+
+const (
+	TicketStatusOpen       TicketStatus = "open"
+	TicketStatusInProgress TicketStatus = "in_progress"
+	TicketStatusCompleted  TicketStatus = "completed"
+	TicketStatusCancelled  TicketStatus = "cancelled"
+)
+
+type TicketsSortField string
+
+const (
+	TicketsSortFieldPrice         TicketsSortField = "price"
+	TicketsSortFieldQuantity      TicketsSortField = "quantity"
+	TicketsSortFieldUpdatedAt     TicketsSortField = "updated_at"
+	TicketsSortFieldRespondsCount TicketsSortField = "responds_count"
+	TicketsSortFieldName          TicketsSortField = "name"
+	TicketsSortFieldRelevance     TicketsSortField = "relevance" // works only with Search
+)
+
+// TicketUpdateField is name of Ticket field, which can be listed in update mask.
+type TicketUpdateField string
+
+const (
+	TicketUpdateFieldCategoryID  TicketUpdateField = "categoryID"
+	TicketUpdateFieldName        TicketUpdateField = "name"
+	TicketUpdateFieldDescription TicketUpdateField = "description"
+	TicketUpdateFieldPrice       TicketUpdateField = "price"
+	TicketUpdateFieldQuantity    TicketUpdateField = "quantity"
+	TicketUpdateFieldTagIDs      TicketUpdateField = "tagIDs"
+	TicketUpdateFieldAttachments TicketUpdateField = "attachments"
+)
+
 type Ticket struct {
 	ID          uint64       `json:"id"`
 	UserID      uint64       `json:"userId"`
@@ -12,6 +45,8 @@ type Ticket struct {
 	Quantity    uint32       `json:"quantity"`
 	CreatedAt   time.Time    `json:"createdAt"`
 	UpdatedAt   time.Time    `json:"updatedAt"`
+	Status      TicketStatus `json:"status"`
+	Version     uint64       `json:"version"`
 	TagIDs      []uint32     `json:"tagIds,omitempty"`
 	Attachments []Attachment `json:"attachments,omitempty"`
 }
@@ -25,6 +60,8 @@ type CreateTicketDTO struct {
 	Quantity    uint32   `json:"quantity"`
 	TagIDs      []uint32 `json:"tagIds,omitempty"`
 	Attachments []string `json:"attachments,omitempty"`
+	// IdempotencyKey is optional. If it is provided, it is saved with created Ticket ID:
+	IdempotencyKey *IdempotencyKeyDTO `json:"idempotencyKey,omitempty"`
 }
 
 type Attachment struct {
@@ -35,21 +72,25 @@ type Attachment struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// UpdateTicketDTO contains new state of Ticket Tags and Attachments, which is compared with current one
+// within update transaction. Ticket is updated only if it still has ExpectedVersion.
+// Only fields, listed in UpdateMask, are updated. Price is cleared, if it is listed in UpdateMask, but is nil.
 type UpdateTicketDTO struct {
-	ID                    uint64   `json:"id"`
-	CategoryID            *uint32  `json:"categoryId,omitempty"`
-	Name                  *string  `json:"name,omitempty"`
-	Description           *string  `json:"description,omitempty"`
-	Price                 *float32 `json:"price,omitempty"`
-	Quantity              *uint32  `json:"quantity,omitempty"`
-	TagIDsToAdd           []uint32 `json:"tagIdsToAdd,omitempty"`
-	TagIDsToDelete        []uint32 `json:"tagIdsToDelete,omitempty"`
-	AttachmentsToAdd      []string `json:"attachmentsToAdd,omitempty"`
-	AttachmentIDsToDelete []uint64 `json:"attachmentIdsToDelete,omitempty"`
+	ID              uint64              `json:"id"`
+	CategoryID      *uint32             `json:"categoryId,omitempty"`
+	Name            *string             `json:"name,omitempty"`
+	Description     *string             `json:"description,omitempty"`
+	Price           *float32            `json:"price,omitempty"`
+	Quantity        *uint32             `json:"quantity,omitempty"`
+	TagIDs          []uint32            `json:"tagIds,omitempty"`
+	Attachments     []string            `json:"attachments,omitempty"`
+	ExpectedVersion uint64              `json:"expectedVersion"`
+	UpdateMask      []TicketUpdateField `json:"updateMask"`
 }
 
 type RawUpdateTicketDTO struct {
 	ID          uint64   `json:"id"`
+	UserID      uint64   `json:"userId"`
 	CategoryID  *uint32  `json:"categoryId,omitempty"`
 	Name        *string  `json:"name,omitempty"`
 	Description *string  `json:"description,omitempty"`
@@ -57,14 +98,46 @@ type RawUpdateTicketDTO struct {
 	Quantity    *uint32  `json:"quantity,omitempty"`
 	TagIDs      []uint32 `json:"tagIds,omitempty"`
 	Attachments []string `json:"attachments,omitempty"`
+	// ExpectedVersion is optional. If it is not provided, version of Ticket, read before update, is used:
+	ExpectedVersion *uint64 `json:"expectedVersion,omitempty"`
+	// UpdateMask is optional. If it is not provided, all fields are updated:
+	UpdateMask []TicketUpdateField `json:"updateMask,omitempty"`
 }
 
 type TicketsFilters struct {
-	Search              *string  `json:"search,omitempty"`
-	PriceCeil           *float32 `json:"priceCeil,omitempty"`     // max price
-	PriceFloor          *float32 `json:"priceFloor,omitempty"`    // min price
-	QuantityFloor       *uint32  `json:"quantityFloor,omitempty"` // min quantity
-	CategoryIDs         []uint32 `json:"categoryIds,omitempty"`
-	TagIDs              []uint32 `json:"tagIds,omitempty"`
-	CreatedAtOrderByAsc *bool    `json:"createdAtOrderByAsc,omitempty"`
+	Search              *string        `json:"search,omitempty"`
+	PriceCeil           *float32       `json:"priceCeil,omitempty"`     // max price
+	PriceFloor          *float32       `json:"priceFloor,omitempty"`    // min price
+	QuantityFloor       *uint32        `json:"quantityFloor,omitempty"` // min quantity
+	CategoryIDs         []uint32       `json:"categoryIds,omitempty"`
+	TagIDs              []uint32       `json:"tagIds,omitempty"`
+	CreatedAtOrderByAsc *bool          `json:"createdAtOrderByAsc,omitempty"`
+	Statuses            []TicketStatus `json:"statuses,omitempty"`
+	Sort                []TicketsSort  `json:"sort,omitempty"`          // sort keys in priority order
+	CreatedAtFrom       *time.Time     `json:"createdAtFrom,omitempty"` // inclusive
+	CreatedAtTo         *time.Time     `json:"createdAtTo,omitempty"`   // exclusive
+	UpdatedAtFrom       *time.Time     `json:"updatedAtFrom,omitempty"` // inclusive
+	UpdatedAtTo         *time.Time     `json:"updatedAtTo,omitempty"`   // exclusive
+}
+
+type TicketsSort struct {
+	Field     TicketsSortField `json:"field"`
+	Direction SortDirection    `json:"direction"`
+}
+
+type TicketEventType string
+
+const (
+	TicketEventTypeSnapshot TicketEventType = "snapshot"
+	TicketEventTypeCreated  TicketEventType = "created"
+	TicketEventTypeUpdated  TicketEventType = "updated"
+	TicketEventTypeDeleted  TicketEventType = "deleted"
+)
+
+// TicketEvent describes Ticket change for watch streams. Ticket is a state after change, except of deleted
+// Tickets, for which last known state is provided. PreviousTicket is provided only for updated Tickets.
+type TicketEvent struct {
+	Type           TicketEventType `json:"type"`
+	Ticket         Ticket          `json:"ticket"`
+	PreviousTicket *Ticket         `json:"previousTicket,omitempty"`
 }
@@ -0,0 +1,19 @@
+package entities
+
+// DeleteUserDataDTO describes User, deleted in SSO service. MasterID is nil, if User was not a Master.
+type DeleteUserDataDTO struct {
+	UserID   uint64  `json:"userId"`
+	MasterID *uint64 `json:"masterId,omitempty"`
+}
+
+// DeletedUserDataDTO contains numbers of rows, deleted during User data purge.
+type DeletedUserDataDTO struct {
+	TicketsCount          uint64 `json:"ticketsCount"`
+	RespondsCount         uint64 `json:"respondsCount"`
+	AttachmentsCount      uint64 `json:"attachmentsCount"`
+	TagsAssociationsCount uint64 `json:"tagsAssociationsCount"`
+	IdempotencyKeysCount  uint64 `json:"idempotencyKeysCount"`
+}
+
+// DeletedUserDataOutboxMessageBuilder creates outbox message with summary, which is known only after purge.
+type DeletedUserDataOutboxMessageBuilder func(deletedData DeletedUserDataDTO) (CreateOutboxMessageDTO, error)
@@ -0,0 +1,191 @@
+package errors
+
+import "fmt"
+
+type PermissionDeniedError struct {
+	Message string
+	BaseErr error
+}
+
+func (e PermissionDeniedError) Error() string {
+	template := "permission denied"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e PermissionDeniedError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e PermissionDeniedError) Reason() string {
+	return ReasonPermissionDenied
+}
+
+type InvalidCursorError struct {
+	Message string
+	BaseErr error
+}
+
+func (e InvalidCursorError) Error() string {
+	template := "invalid pagination cursor"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e InvalidCursorError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e InvalidCursorError) Reason() string {
+	return ReasonInvalidCursor
+}
+
+// VersionConflictError means that entity has been changed by someone else since it has been read.
+type VersionConflictError struct {
+	Message string
+	BaseErr error
+}
+
+func (e VersionConflictError) Error() string {
+	template := "entity has been modified concurrently"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e VersionConflictError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e VersionConflictError) Reason() string {
+	return ReasonVersionConflict
+}
+
+// IdempotencyKeyNotFoundError means that there is no result of request with provided idempotency key,
+// so request should be processed as a new one.
+type IdempotencyKeyNotFoundError struct {
+	Message string
+	BaseErr error
+}
+
+func (e IdempotencyKeyNotFoundError) Error() string {
+	template := "idempotency key not found"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e IdempotencyKeyNotFoundError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e IdempotencyKeyNotFoundError) Reason() string {
+	return ReasonIdempotencyKeyNotFound
+}
+
+type InvalidIdempotencyKeyError struct {
+	Message string
+	BaseErr error
+}
+
+func (e InvalidIdempotencyKeyError) Error() string {
+	template := "invalid idempotency key"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e InvalidIdempotencyKeyError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e InvalidIdempotencyKeyError) Reason() string {
+	return ReasonInvalidIdempotencyKey
+}
+
+// DependencyUnavailableError means that one of service dependencies (database, broker, another service)
+// can't be reached, so service is not ready to process requests.
+type DependencyUnavailableError struct {
+	Message string
+	BaseErr error
+}
+
+func (e DependencyUnavailableError) Error() string {
+	template := "dependency is unavailable"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e DependencyUnavailableError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e DependencyUnavailableError) Reason() string {
+	return ReasonDependencyUnavailable
+}
+
+// TooManyIDsError means that number of IDs in batch request exceeds configured limit.
+type TooManyIDsError struct {
+	Message string
+	BaseErr error
+}
+
+func (e TooManyIDsError) Error() string {
+	template := "too many IDs"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e TooManyIDsError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e TooManyIDsError) Reason() string {
+	return ReasonTooManyIDs
+}
@@ -0,0 +1,386 @@
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionDeniedError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            PermissionDeniedError
+		expectedString string
+		expectedBase   error
+	}{
+		{
+			name:           "default message, no base error",
+			err:            PermissionDeniedError{},
+			expectedString: "permission denied",
+			expectedBase:   nil,
+		},
+		{
+			name:           "custom message, no base error",
+			err:            PermissionDeniedError{Message: "custom message"},
+			expectedString: "custom message",
+			expectedBase:   nil,
+		},
+		{
+			name:           "default message, with base error",
+			err:            PermissionDeniedError{BaseErr: errors.New("base error")},
+			expectedString: "permission denied. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "custom message, with base error",
+			err:            PermissionDeniedError{Message: "custom error", BaseErr: errors.New("base error")},
+			expectedString: "custom error. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Проверка строки ошибки
+			require.Equal(t, tc.expectedString, tc.err.Error())
+
+			// Проверка базовой ошибки через Unwrap
+			baseErr := tc.err.Unwrap()
+			if tc.expectedBase == nil {
+				require.Nil(t, baseErr)
+			} else {
+				require.Equal(t, tc.expectedBase.Error(), baseErr.Error())
+			}
+
+			// Проверка, что ошибка реализует интерфейс error
+			var err interface{} = tc.err
+			_, ok := err.(error)
+			require.True(t, ok, "PermissionDeniedError should implement error interface")
+		})
+	}
+}
+
+func TestInvalidCursorError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            InvalidCursorError
+		expectedString string
+		expectedBase   error
+	}{
+		{
+			name:           "default message, no base error",
+			err:            InvalidCursorError{},
+			expectedString: "invalid pagination cursor",
+			expectedBase:   nil,
+		},
+		{
+			name:           "custom message, no base error",
+			err:            InvalidCursorError{Message: "custom message"},
+			expectedString: "custom message",
+			expectedBase:   nil,
+		},
+		{
+			name:           "default message, with base error",
+			err:            InvalidCursorError{BaseErr: errors.New("base error")},
+			expectedString: "invalid pagination cursor. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "custom message, with base error",
+			err:            InvalidCursorError{Message: "custom error", BaseErr: errors.New("base error")},
+			expectedString: "custom error. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Проверка строки ошибки
+			require.Equal(t, tc.expectedString, tc.err.Error())
+
+			// Проверка базовой ошибки через Unwrap
+			baseErr := tc.err.Unwrap()
+			if tc.expectedBase == nil {
+				require.Nil(t, baseErr)
+			} else {
+				require.Equal(t, tc.expectedBase.Error(), baseErr.Error())
+			}
+
+			// Проверка, что ошибка реализует интерфейс error
+			var err interface{} = tc.err
+			_, ok := err.(error)
+			require.True(t, ok, "InvalidCursorError should implement error interface")
+		})
+	}
+}
+
+func TestVersionConflictError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            VersionConflictError
+		expectedString string
+		expectedBase   error
+	}{
+		{
+			name:           "default message, no base error",
+			err:            VersionConflictError{},
+			expectedString: "entity has been modified concurrently",
+			expectedBase:   nil,
+		},
+		{
+			name:           "custom message, no base error",
+			err:            VersionConflictError{Message: "custom message"},
+			expectedString: "custom message",
+			expectedBase:   nil,
+		},
+		{
+			name:           "default message, with base error",
+			err:            VersionConflictError{BaseErr: errors.New("base error")},
+			expectedString: "entity has been modified concurrently. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "custom message, with base error",
+			err:            VersionConflictError{Message: "custom error", BaseErr: errors.New("base error")},
+			expectedString: "custom error. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Проверка строки ошибки
+			require.Equal(t, tc.expectedString, tc.err.Error())
+
+			// Проверка базовой ошибки через Unwrap
+			baseErr := tc.err.Unwrap()
+			if tc.expectedBase == nil {
+				require.Nil(t, baseErr)
+			} else {
+				require.Equal(t, tc.expectedBase.Error(), baseErr.Error())
+			}
+
+			// Проверка, что ошибка реализует интерфейс error
+			var err interface{} = tc.err
+			_, ok := err.(error)
+			require.True(t, ok, "VersionConflictError should implement error interface")
+		})
+	}
+}
+
+func TestIdempotencyKeyNotFoundError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            IdempotencyKeyNotFoundError
+		expectedString string
+		expectedBase   error
+	}{
+		{
+			name:           "default message, no base error",
+			err:            IdempotencyKeyNotFoundError{},
+			expectedString: "idempotency key not found",
+			expectedBase:   nil,
+		},
+		{
+			name:           "custom message, no base error",
+			err:            IdempotencyKeyNotFoundError{Message: "custom message"},
+			expectedString: "custom message",
+			expectedBase:   nil,
+		},
+		{
+			name:           "default message, with base error",
+			err:            IdempotencyKeyNotFoundError{BaseErr: errors.New("base error")},
+			expectedString: "idempotency key not found. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "custom message, with base error",
+			err:            IdempotencyKeyNotFoundError{Message: "custom error", BaseErr: errors.New("base error")},
+			expectedString: "custom error. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Проверка строки ошибки
+			require.Equal(t, tc.expectedString, tc.err.Error())
+
+			// Проверка базовой ошибки через Unwrap
+			baseErr := tc.err.Unwrap()
+			if tc.expectedBase == nil {
+				require.Nil(t, baseErr)
+			} else {
+				require.Equal(t, tc.expectedBase.Error(), baseErr.Error())
+			}
+
+			// Проверка, что ошибка реализует интерфейс error
+			var err interface{} = tc.err
+			_, ok := err.(error)
+			require.True(t, ok, "IdempotencyKeyNotFoundError should implement error interface")
+		})
+	}
+}
+
+func TestInvalidIdempotencyKeyError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            InvalidIdempotencyKeyError
+		expectedString string
+		expectedBase   error
+	}{
+		{
+			name:           "default message, no base error",
+			err:            InvalidIdempotencyKeyError{},
+			expectedString: "invalid idempotency key",
+			expectedBase:   nil,
+		},
+		{
+			name:           "custom message, no base error",
+			err:            InvalidIdempotencyKeyError{Message: "custom message"},
+			expectedString: "custom message",
+			expectedBase:   nil,
+		},
+		{
+			name:           "default message, with base error",
+			err:            InvalidIdempotencyKeyError{BaseErr: errors.New("base error")},
+			expectedString: "invalid idempotency key. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "custom message, with base error",
+			err:            InvalidIdempotencyKeyError{Message: "custom error", BaseErr: errors.New("base error")},
+			expectedString: "custom error. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Проверка строки ошибки
+			require.Equal(t, tc.expectedString, tc.err.Error())
+
+			// Проверка базовой ошибки через Unwrap
+			baseErr := tc.err.Unwrap()
+			if tc.expectedBase == nil {
+				require.Nil(t, baseErr)
+			} else {
+				require.Equal(t, tc.expectedBase.Error(), baseErr.Error())
+			}
+
+			// Проверка, что ошибка реализует интерфейс error
+			var err interface{} = tc.err
+			_, ok := err.(error)
+			require.True(t, ok, "InvalidIdempotencyKeyError should implement error interface")
+		})
+	}
+}
+
+func TestDependencyUnavailableError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            DependencyUnavailableError
+		expectedString string
+		expectedBase   error
+	}{
+		{
+			name:           "default message, no base error",
+			err:            DependencyUnavailableError{},
+			expectedString: "dependency is unavailable",
+			expectedBase:   nil,
+		},
+		{
+			name:           "custom message, no base error",
+			err:            DependencyUnavailableError{Message: "custom message"},
+			expectedString: "custom message",
+			expectedBase:   nil,
+		},
+		{
+			name:           "default message, with base error",
+			err:            DependencyUnavailableError{BaseErr: errors.New("base error")},
+			expectedString: "dependency is unavailable. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "custom message, with base error",
+			err:            DependencyUnavailableError{Message: "custom error", BaseErr: errors.New("base error")},
+			expectedString: "custom error. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Проверка строки ошибки
+			require.Equal(t, tc.expectedString, tc.err.Error())
+
+			// Проверка базовой ошибки через Unwrap
+			baseErr := tc.err.Unwrap()
+			if tc.expectedBase == nil {
+				require.Nil(t, baseErr)
+			} else {
+				require.Equal(t, tc.expectedBase.Error(), baseErr.Error())
+			}
+
+			// Проверка, что ошибка реализует интерфейс error
+			var err interface{} = tc.err
+			_, ok := err.(error)
+			require.True(t, ok, "DependencyUnavailableError should implement error interface")
+		})
+	}
+}
+
+func TestTooManyIDsError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            TooManyIDsError
+		expectedString string
+		expectedBase   error
+	}{
+		{
+			name:           "default message, no base error",
+			err:            TooManyIDsError{},
+			expectedString: "too many IDs",
+			expectedBase:   nil,
+		},
+		{
+			name:           "custom message, no base error",
+			err:            TooManyIDsError{Message: "custom message"},
+			expectedString: "custom message",
+			expectedBase:   nil,
+		},
+		{
+			name:           "default message, with base error",
+			err:            TooManyIDsError{BaseErr: errors.New("base error")},
+			expectedString: "too many IDs. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "custom message, with base error",
+			err:            TooManyIDsError{Message: "custom error", BaseErr: errors.New("base error")},
+			expectedString: "custom error. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Проверка строки ошибки
+			require.Equal(t, tc.expectedString, tc.err.Error())
+
+			// Проверка базовой ошибки через Unwrap
+			baseErr := tc.err.Unwrap()
+			if tc.expectedBase == nil {
+				require.Nil(t, baseErr)
+			} else {
+				require.Equal(t, tc.expectedBase.Error(), baseErr.Error())
+			}
+
+			// Проверка, что ошибка реализует интерфейс error
+			var err interface{} = tc.err
+			_, ok := err.(error)
+			require.True(t, ok, "TooManyIDsError should implement error interface")
+		})
+	}
+}
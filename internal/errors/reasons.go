@@ -0,0 +1,37 @@
+package errors
+
+// Reasons are stable codes of errors, which are sent to clients in gRPC error details. Clients rely on them
+// instead of error messages, so existing reasons must never be changed.
+const (
+	// Common reasons:
+	ReasonPermissionDenied       = "PERMISSION_DENIED"
+	ReasonInvalidCursor          = "INVALID_CURSOR"
+	ReasonVersionConflict        = "VERSION_CONFLICT"
+	ReasonIdempotencyKeyNotFound = "IDEMPOTENCY_KEY_NOT_FOUND"
+	ReasonInvalidIdempotencyKey  = "INVALID_IDEMPOTENCY_KEY"
+	ReasonDependencyUnavailable  = "DEPENDENCY_UNAVAILABLE"
+	ReasonTooManyIDs             = "TOO_MANY_IDS"
+
+	// Responds reasons:
+	ReasonRespondNotFound      = "RESPOND_NOT_FOUND"
+	ReasonRespondAlreadyExists = "RESPOND_ALREADY_EXISTS"
+	ReasonRespondToOwnTicket   = "RESPOND_TO_OWN_TICKET"
+	ReasonRespondIsNotPending  = "RESPOND_IS_NOT_PENDING"
+
+	// Tickets reasons:
+	ReasonTicketNotFound                   = "TICKET_NOT_FOUND"
+	ReasonTicketAlreadyExists              = "TICKET_ALREADY_EXISTS"
+	ReasonTicketStatusTransitionNotAllowed = "TICKET_STATUS_TRANSITION_NOT_ALLOWED"
+	ReasonTicketIsNotOpen                  = "TICKET_IS_NOT_OPEN"
+	ReasonInvalidUpdateMask                = "INVALID_UPDATE_MASK"
+	ReasonInvalidTicketStatus              = "INVALID_TICKET_STATUS"
+	ReasonInvalidSort                      = "INVALID_SORT"
+	ReasonTooManyWatchStreams              = "TOO_MANY_WATCH_STREAMS"
+	ReasonSlowWatchStream                  = "SLOW_WATCH_STREAM"
+	ReasonWatchSearchNotSupported          = "WATCH_SEARCH_NOT_SUPPORTED"
+
+	// Toys reasons:
+	ReasonCategoryNotFound = "CATEGORY_NOT_FOUND"
+	ReasonTagNotFound      = "TAG_NOT_FOUND"
+	ReasonMasterNotFound   = "MASTER_NOT_FOUND"
+)
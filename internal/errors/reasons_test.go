@@ -0,0 +1,51 @@
+package errors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReasons(t *testing.T) {
+	testCases := []struct {
+		err      interface{ Reason() string }
+		expected string
+	}{
+		{err: PermissionDeniedError{}, expected: "PERMISSION_DENIED"},
+		{err: InvalidCursorError{}, expected: "INVALID_CURSOR"},
+		{err: VersionConflictError{}, expected: "VERSION_CONFLICT"},
+		{err: IdempotencyKeyNotFoundError{}, expected: "IDEMPOTENCY_KEY_NOT_FOUND"},
+		{err: InvalidIdempotencyKeyError{}, expected: "INVALID_IDEMPOTENCY_KEY"},
+		{err: DependencyUnavailableError{}, expected: "DEPENDENCY_UNAVAILABLE"},
+		{err: TooManyIDsError{}, expected: "TOO_MANY_IDS"},
+		{err: RespondNotFoundError{}, expected: "RESPOND_NOT_FOUND"},
+		{err: RespondAlreadyExistsError{}, expected: "RESPOND_ALREADY_EXISTS"},
+		{err: RespondToOwnTicketError{}, expected: "RESPOND_TO_OWN_TICKET"},
+		{err: RespondIsNotPendingError{}, expected: "RESPOND_IS_NOT_PENDING"},
+		{err: TicketNotFoundError{}, expected: "TICKET_NOT_FOUND"},
+		{err: TicketAlreadyExistsError{}, expected: "TICKET_ALREADY_EXISTS"},
+		{err: TicketStatusTransitionNotAllowedError{}, expected: "TICKET_STATUS_TRANSITION_NOT_ALLOWED"},
+		{err: TicketIsNotOpenError{}, expected: "TICKET_IS_NOT_OPEN"},
+		{err: InvalidUpdateMaskError{}, expected: "INVALID_UPDATE_MASK"},
+		{err: InvalidTicketStatusError{}, expected: "INVALID_TICKET_STATUS"},
+		{err: InvalidSortError{}, expected: "INVALID_SORT"},
+		{err: TooManyWatchStreamsError{}, expected: "TOO_MANY_WATCH_STREAMS"},
+		{err: SlowWatchStreamError{}, expected: "SLOW_WATCH_STREAM"},
+		{err: WatchSearchNotSupportedError{}, expected: "WATCH_SEARCH_NOT_SUPPORTED"},
+		{err: CategoryNotFoundError{}, expected: "CATEGORY_NOT_FOUND"},
+		{err: TagNotFoundError{}, expected: "TAG_NOT_FOUND"},
+		{err: MasterNotFoundError{}, expected: "MASTER_NOT_FOUND"},
+	}
+
+	// Reasons are part of API, so they are compared with literals to catch accidental changes:
+	reasons := make(map[string]struct{}, len(testCases))
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.err.Reason())
+		})
+
+		reasons[tc.err.Reason()] = struct{}{}
+	}
+
+	require.Len(t, reasons, len(testCases), "reasons must be unique")
+}
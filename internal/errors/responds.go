@@ -24,6 +24,10 @@ func (e RespondNotFoundError) Unwrap() error {
 	return e.BaseErr
 }
 
+func (e RespondNotFoundError) Reason() string {
+	return ReasonRespondNotFound
+}
+
 type RespondAlreadyExistsError struct {
 	Message string
 	BaseErr error
@@ -46,6 +50,10 @@ func (e RespondAlreadyExistsError) Unwrap() error {
 	return e.BaseErr
 }
 
+func (e RespondAlreadyExistsError) Reason() string {
+	return ReasonRespondAlreadyExists
+}
+
 type RespondToOwnTicketError struct {
 	Message string
 	BaseErr error
@@ -67,3 +75,33 @@ func (e RespondToOwnTicketError) Error() string {
 func (e RespondToOwnTicketError) Unwrap() error {
 	return e.BaseErr
 }
+
+func (e RespondToOwnTicketError) Reason() string {
+	return ReasonRespondToOwnTicket
+}
+
+type RespondIsNotPendingError struct {
+	Message string
+	BaseErr error
+}
+
+func (e RespondIsNotPendingError) Error() string {
+	template := "respond is not pending"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e RespondIsNotPendingError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e RespondIsNotPendingError) Reason() string {
+	return ReasonRespondIsNotPending
+}
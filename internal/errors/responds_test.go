@@ -168,3 +168,57 @@ func TestRespondToOwnTicketError(t *testing.T) {
 		})
 	}
 }
+
+func TestRespondIsNotPendingError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            RespondIsNotPendingError
+		expectedString string
+		expectedBase   error
+	}{
+		{
+			name:           "default message, no base error",
+			err:            RespondIsNotPendingError{},
+			expectedString: "respond is not pending",
+			expectedBase:   nil,
+		},
+		{
+			name:           "custom message, no base error",
+			err:            RespondIsNotPendingError{Message: "custom message"},
+			expectedString: "custom message",
+			expectedBase:   nil,
+		},
+		{
+			name:           "default message, with base error",
+			err:            RespondIsNotPendingError{BaseErr: errors.New("base error")},
+			expectedString: "respond is not pending. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "custom message, with base error",
+			err:            RespondIsNotPendingError{Message: "custom error", BaseErr: errors.New("base error")},
+			expectedString: "custom error. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Проверка строки ошибки
+			require.Equal(t, tc.expectedString, tc.err.Error())
+
+			// Проверка базовой ошибки через Unwrap
+			baseErr := tc.err.Unwrap()
+			if tc.expectedBase == nil {
+				require.Nil(t, baseErr)
+			} else {
+				require.Equal(t, tc.expectedBase.Error(), baseErr.Error())
+			}
+
+			// Проверка, что ошибка реализует интерфейс error
+			var err interface{} = tc.err
+			_, ok := err.(error)
+			require.True(t, ok, "RespondIsNotPendingError should implement error interface")
+		})
+	}
+}
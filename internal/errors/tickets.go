@@ -24,6 +24,10 @@ func (e TicketNotFoundError) Unwrap() error {
 	return e.BaseErr
 }
 
+func (e TicketNotFoundError) Reason() string {
+	return ReasonTicketNotFound
+}
+
 type TicketAlreadyExistsError struct {
 	Message string
 	BaseErr error
@@ -45,3 +49,224 @@ func (e TicketAlreadyExistsError) Error() string {
 func (e TicketAlreadyExistsError) Unwrap() error {
 	return e.BaseErr
 }
+
+func (e TicketAlreadyExistsError) Reason() string {
+	return ReasonTicketAlreadyExists
+}
+
+type TicketStatusTransitionNotAllowedError struct {
+	Message string
+	BaseErr error
+}
+
+func (e TicketStatusTransitionNotAllowedError) Error() string {
+	template := "ticket status transition is not allowed"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e TicketStatusTransitionNotAllowedError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e TicketStatusTransitionNotAllowedError) Reason() string {
+	return ReasonTicketStatusTransitionNotAllowed
+}
+
+type TicketIsNotOpenError struct {
+	Message string
+	BaseErr error
+}
+
+func (e TicketIsNotOpenError) Error() string {
+	template := "ticket is not open"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e TicketIsNotOpenError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e TicketIsNotOpenError) Reason() string {
+	return ReasonTicketIsNotOpen
+}
+
+type InvalidUpdateMaskError struct {
+	Message string
+	BaseErr error
+}
+
+func (e InvalidUpdateMaskError) Error() string {
+	template := "invalid update mask"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e InvalidUpdateMaskError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e InvalidUpdateMaskError) Reason() string {
+	return ReasonInvalidUpdateMask
+}
+
+// InvalidTicketStatusError means that requested Ticket status is unspecified or unknown.
+type InvalidTicketStatusError struct {
+	Message string
+	BaseErr error
+}
+
+func (e InvalidTicketStatusError) Error() string {
+	template := "invalid ticket status"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e InvalidTicketStatusError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e InvalidTicketStatusError) Reason() string {
+	return ReasonInvalidTicketStatus
+}
+
+// InvalidSortError means that requested sort key is unspecified, unknown or can not be applied.
+// Position of this key in request is stored in Index.
+type InvalidSortError struct {
+	Message string
+	Index   int
+	BaseErr error
+}
+
+func (e InvalidSortError) Error() string {
+	template := "invalid sort"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e InvalidSortError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e InvalidSortError) Reason() string {
+	return ReasonInvalidSort
+}
+
+// TooManyWatchStreamsError means that limit of simultaneously opened Tickets watch streams has been reached.
+type TooManyWatchStreamsError struct {
+	Message string
+	BaseErr error
+}
+
+func (e TooManyWatchStreamsError) Error() string {
+	template := "too many watch streams"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e TooManyWatchStreamsError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e TooManyWatchStreamsError) Reason() string {
+	return ReasonTooManyWatchStreams
+}
+
+// SlowWatchStreamError means that watch stream has been closed, because its client did not read
+// Tickets events fast enough and events buffer has overflowed.
+type SlowWatchStreamError struct {
+	Message string
+	BaseErr error
+}
+
+func (e SlowWatchStreamError) Error() string {
+	template := "watch stream is too slow"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e SlowWatchStreamError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e SlowWatchStreamError) Reason() string {
+	return ReasonSlowWatchStream
+}
+
+// WatchSearchNotSupportedError means that search filter is provided for Tickets watch stream. Tickets are searched
+// in database via full-text search, which can not be applied to Tickets events, so search is not supported by stream.
+type WatchSearchNotSupportedError struct {
+	Message string
+	BaseErr error
+}
+
+func (e WatchSearchNotSupportedError) Error() string {
+	template := "search is not supported by watch stream"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e WatchSearchNotSupportedError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e WatchSearchNotSupportedError) Reason() string {
+	return ReasonWatchSearchNotSupported
+}
@@ -114,3 +114,381 @@ func TestTicketAlreadyExistsError(t *testing.T) {
 		})
 	}
 }
+
+func TestTicketStatusTransitionNotAllowedError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            TicketStatusTransitionNotAllowedError
+		expectedString string
+		expectedBase   error
+	}{
+		{
+			name:           "default message, no base error",
+			err:            TicketStatusTransitionNotAllowedError{},
+			expectedString: "ticket status transition is not allowed",
+			expectedBase:   nil,
+		},
+		{
+			name:           "custom message, no base error",
+			err:            TicketStatusTransitionNotAllowedError{Message: "custom message"},
+			expectedString: "custom message",
+			expectedBase:   nil,
+		},
+		{
+			name:           "default message, with base error",
+			err:            TicketStatusTransitionNotAllowedError{BaseErr: errors.New("base error")},
+			expectedString: "ticket status transition is not allowed. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "custom message, with base error",
+			err:            TicketStatusTransitionNotAllowedError{Message: "custom error", BaseErr: errors.New("base error")},
+			expectedString: "custom error. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Проверка строки ошибки
+			require.Equal(t, tc.expectedString, tc.err.Error())
+
+			// Проверка базовой ошибки через Unwrap
+			baseErr := tc.err.Unwrap()
+			if tc.expectedBase == nil {
+				require.Nil(t, baseErr)
+			} else {
+				require.Equal(t, tc.expectedBase.Error(), baseErr.Error())
+			}
+
+			// Проверка, что ошибка реализует интерфейс error
+			var err interface{} = tc.err
+			_, ok := err.(error)
+			require.True(t, ok, "TicketStatusTransitionNotAllowedError should implement error interface")
+		})
+	}
+}
+
+func TestTicketIsNotOpenError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            TicketIsNotOpenError
+		expectedString string
+		expectedBase   error
+	}{
+		{
+			name:           "default message, no base error",
+			err:            TicketIsNotOpenError{},
+			expectedString: "ticket is not open",
+			expectedBase:   nil,
+		},
+		{
+			name:           "custom message, no base error",
+			err:            TicketIsNotOpenError{Message: "custom message"},
+			expectedString: "custom message",
+			expectedBase:   nil,
+		},
+		{
+			name:           "default message, with base error",
+			err:            TicketIsNotOpenError{BaseErr: errors.New("base error")},
+			expectedString: "ticket is not open. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "custom message, with base error",
+			err:            TicketIsNotOpenError{Message: "custom error", BaseErr: errors.New("base error")},
+			expectedString: "custom error. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Проверка строки ошибки
+			require.Equal(t, tc.expectedString, tc.err.Error())
+
+			// Проверка базовой ошибки через Unwrap
+			baseErr := tc.err.Unwrap()
+			if tc.expectedBase == nil {
+				require.Nil(t, baseErr)
+			} else {
+				require.Equal(t, tc.expectedBase.Error(), baseErr.Error())
+			}
+
+			// Проверка, что ошибка реализует интерфейс error
+			var err interface{} = tc.err
+			_, ok := err.(error)
+			require.True(t, ok, "TicketIsNotOpenError should implement error interface")
+		})
+	}
+}
+
+func TestInvalidUpdateMaskError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            InvalidUpdateMaskError
+		expectedString string
+		expectedBase   error
+	}{
+		{
+			name:           "default message, no base error",
+			err:            InvalidUpdateMaskError{},
+			expectedString: "invalid update mask",
+			expectedBase:   nil,
+		},
+		{
+			name:           "custom message, no base error",
+			err:            InvalidUpdateMaskError{Message: "custom message"},
+			expectedString: "custom message",
+			expectedBase:   nil,
+		},
+		{
+			name:           "default message, with base error",
+			err:            InvalidUpdateMaskError{BaseErr: errors.New("base error")},
+			expectedString: "invalid update mask. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "custom message, with base error",
+			err:            InvalidUpdateMaskError{Message: "custom error", BaseErr: errors.New("base error")},
+			expectedString: "custom error. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Проверка строки ошибки
+			require.Equal(t, tc.expectedString, tc.err.Error())
+
+			// Проверка базовой ошибки через Unwrap
+			baseErr := tc.err.Unwrap()
+			if tc.expectedBase == nil {
+				require.Nil(t, baseErr)
+			} else {
+				require.Equal(t, tc.expectedBase.Error(), baseErr.Error())
+			}
+
+			// Проверка, что ошибка реализует интерфейс error
+			var err interface{} = tc.err
+			_, ok := err.(error)
+			require.True(t, ok, "InvalidUpdateMaskError should implement error interface")
+		})
+	}
+}
+
+func TestInvalidSortError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            InvalidSortError
+		expectedString string
+		expectedBase   error
+	}{
+		{
+			name:           "default message, no base error",
+			err:            InvalidSortError{},
+			expectedString: "invalid sort",
+			expectedBase:   nil,
+		},
+		{
+			name:           "custom message, no base error",
+			err:            InvalidSortError{Message: "custom message"},
+			expectedString: "custom message",
+			expectedBase:   nil,
+		},
+		{
+			name:           "default message, with base error",
+			err:            InvalidSortError{BaseErr: errors.New("base error")},
+			expectedString: "invalid sort. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "custom message, with base error",
+			err:            InvalidSortError{Message: "custom error", BaseErr: errors.New("base error")},
+			expectedString: "custom error. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Проверка строки ошибки
+			require.Equal(t, tc.expectedString, tc.err.Error())
+
+			// Проверка базовой ошибки через Unwrap
+			baseErr := tc.err.Unwrap()
+			if tc.expectedBase == nil {
+				require.Nil(t, baseErr)
+			} else {
+				require.Equal(t, tc.expectedBase.Error(), baseErr.Error())
+			}
+
+			// Проверка, что ошибка реализует интерфейс error
+			var err interface{} = tc.err
+			_, ok := err.(error)
+			require.True(t, ok, "InvalidSortError should implement error interface")
+		})
+	}
+}
+
+func TestTooManyWatchStreamsError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            TooManyWatchStreamsError
+		expectedString string
+		expectedBase   error
+	}{
+		{
+			name:           "default message, no base error",
+			err:            TooManyWatchStreamsError{},
+			expectedString: "too many watch streams",
+			expectedBase:   nil,
+		},
+		{
+			name:           "custom message, no base error",
+			err:            TooManyWatchStreamsError{Message: "custom message"},
+			expectedString: "custom message",
+			expectedBase:   nil,
+		},
+		{
+			name:           "default message, with base error",
+			err:            TooManyWatchStreamsError{BaseErr: errors.New("base error")},
+			expectedString: "too many watch streams. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "custom message, with base error",
+			err:            TooManyWatchStreamsError{Message: "custom error", BaseErr: errors.New("base error")},
+			expectedString: "custom error. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Проверка строки ошибки
+			require.Equal(t, tc.expectedString, tc.err.Error())
+
+			// Проверка базовой ошибки через Unwrap
+			baseErr := tc.err.Unwrap()
+			if tc.expectedBase == nil {
+				require.Nil(t, baseErr)
+			} else {
+				require.Equal(t, tc.expectedBase.Error(), baseErr.Error())
+			}
+
+			// Проверка, что ошибка реализует интерфейс error
+			var err interface{} = tc.err
+			_, ok := err.(error)
+			require.True(t, ok, "TooManyWatchStreamsError should implement error interface")
+		})
+	}
+}
+
+func TestSlowWatchStreamError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            SlowWatchStreamError
+		expectedString string
+		expectedBase   error
+	}{
+		{
+			name:           "default message, no base error",
+			err:            SlowWatchStreamError{},
+			expectedString: "watch stream is too slow",
+			expectedBase:   nil,
+		},
+		{
+			name:           "custom message, no base error",
+			err:            SlowWatchStreamError{Message: "custom message"},
+			expectedString: "custom message",
+			expectedBase:   nil,
+		},
+		{
+			name:           "default message, with base error",
+			err:            SlowWatchStreamError{BaseErr: errors.New("base error")},
+			expectedString: "watch stream is too slow. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "custom message, with base error",
+			err:            SlowWatchStreamError{Message: "custom error", BaseErr: errors.New("base error")},
+			expectedString: "custom error. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Проверка строки ошибки
+			require.Equal(t, tc.expectedString, tc.err.Error())
+
+			// Проверка базовой ошибки через Unwrap
+			baseErr := tc.err.Unwrap()
+			if tc.expectedBase == nil {
+				require.Nil(t, baseErr)
+			} else {
+				require.Equal(t, tc.expectedBase.Error(), baseErr.Error())
+			}
+
+			// Проверка, что ошибка реализует интерфейс error
+			var err interface{} = tc.err
+			_, ok := err.(error)
+			require.True(t, ok, "SlowWatchStreamError should implement error interface")
+		})
+	}
+}
+
+func TestWatchSearchNotSupportedError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            WatchSearchNotSupportedError
+		expectedString string
+		expectedBase   error
+	}{
+		{
+			name:           "default message, no base error",
+			err:            WatchSearchNotSupportedError{},
+			expectedString: "search is not supported by watch stream",
+			expectedBase:   nil,
+		},
+		{
+			name:           "custom message, no base error",
+			err:            WatchSearchNotSupportedError{Message: "custom message"},
+			expectedString: "custom message",
+			expectedBase:   nil,
+		},
+		{
+			name:           "default message, with base error",
+			err:            WatchSearchNotSupportedError{BaseErr: errors.New("base error")},
+			expectedString: "search is not supported by watch stream. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "custom message, with base error",
+			err:            WatchSearchNotSupportedError{Message: "custom error", BaseErr: errors.New("base error")},
+			expectedString: "custom error. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Проверка строки ошибки
+			require.Equal(t, tc.expectedString, tc.err.Error())
+
+			// Проверка базовой ошибки через Unwrap
+			baseErr := tc.err.Unwrap()
+			if tc.expectedBase == nil {
+				require.Nil(t, baseErr)
+			} else {
+				require.Equal(t, tc.expectedBase.Error(), baseErr.Error())
+			}
+
+			// Проверка, что ошибка реализует интерфейс error
+			var err interface{} = tc.err
+			_, ok := err.(error)
+			require.True(t, ok, "WatchSearchNotSupportedError should implement error interface")
+		})
+	}
+}
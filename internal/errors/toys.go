@@ -20,8 +20,14 @@ func (e CategoryNotFoundError) Unwrap() error {
 	return e.BaseErr
 }
 
+func (e CategoryNotFoundError) Reason() string {
+	return ReasonCategoryNotFound
+}
+
+// TagNotFoundError stores not found Tag ID in Message and position of this ID in request in Index.
 type TagNotFoundError struct {
 	Message string
+	Index   int
 	BaseErr error
 }
 
@@ -37,3 +43,29 @@ func (e TagNotFoundError) Error() string {
 func (e TagNotFoundError) Unwrap() error {
 	return e.BaseErr
 }
+
+func (e TagNotFoundError) Reason() string {
+	return ReasonTagNotFound
+}
+
+type MasterNotFoundError struct {
+	Message string
+	BaseErr error
+}
+
+func (e MasterNotFoundError) Error() string {
+	template := "master for user with ID=%s not found"
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.Message, e.BaseErr)
+	}
+
+	return fmt.Sprintf(template, e.Message)
+}
+
+func (e MasterNotFoundError) Unwrap() error {
+	return e.BaseErr
+}
+
+func (e MasterNotFoundError) Reason() string {
+	return ReasonMasterNotFound
+}
@@ -126,3 +126,63 @@ func TestTagNotFoundError(t *testing.T) {
 		})
 	}
 }
+
+func TestMasterNotFoundError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            MasterNotFoundError
+		expectedString string
+		expectedBase   error
+	}{
+		{
+			name:           "default message, no base error",
+			err:            MasterNotFoundError{Message: "1"},
+			expectedString: "master for user with ID=1 not found",
+			expectedBase:   nil,
+		},
+		{
+			name:           "custom message, no base error",
+			err:            MasterNotFoundError{Message: "42"},
+			expectedString: "master for user with ID=42 not found",
+			expectedBase:   nil,
+		},
+		{
+			name:           "default message, with base error",
+			err:            MasterNotFoundError{Message: "1", BaseErr: errors.New("base error")},
+			expectedString: "master for user with ID=1 not found. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "custom message, with base error",
+			err:            MasterNotFoundError{Message: "42", BaseErr: errors.New("base error")},
+			expectedString: "master for user with ID=42 not found. Base error: base error",
+			expectedBase:   errors.New("base error"),
+		},
+		{
+			name:           "empty message, no base error",
+			err:            MasterNotFoundError{},
+			expectedString: "master for user with ID= not found",
+			expectedBase:   nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Проверка строки ошибки
+			require.Equal(t, tc.expectedString, tc.err.Error())
+
+			// Проверка базовой ошибки через Unwrap
+			baseErr := tc.err.Unwrap()
+			if tc.expectedBase == nil {
+				require.Nil(t, baseErr)
+			} else {
+				require.Equal(t, tc.expectedBase.Error(), baseErr.Error())
+			}
+
+			// Проверка, что ошибка реализует интерфейс error
+			var err interface{} = tc.err
+			_, ok := err.(error)
+			require.True(t, ok, "MasterNotFoundError should implement error interface")
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package eventbus
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
+)
+
+// Bus is in-process Tickets events bus. It delivers only changes, made by current service instance.
+// Publish never blocks: subscriber, which buffer is full, is dropped and its events channel is closed,
+// so one slow watch stream does not delay requests and other streams.
+type Bus struct {
+	config        config.WatchConfig
+	mutex         sync.RWMutex
+	subscriptions map[uint64]chan entities.TicketEvent
+	nextID        uint64
+}
+
+func New(config config.WatchConfig) *Bus {
+	return &Bus{
+		config:        config,
+		subscriptions: make(map[uint64]chan entities.TicketEvent),
+	}
+}
+
+func (bus *Bus) Publish(event entities.TicketEvent) {
+	bus.mutex.Lock()
+	defer bus.mutex.Unlock()
+
+	for id, events := range bus.subscriptions {
+		select {
+		case events <- event:
+		default:
+			delete(bus.subscriptions, id)
+			close(events)
+		}
+	}
+}
+
+// Subscribe returns channel with events, published after subscription, and function for unsubscribing,
+// which should be called, when events are not needed anymore.
+func (bus *Bus) Subscribe() (<-chan entities.TicketEvent, func(), error) {
+	bus.mutex.Lock()
+	defer bus.mutex.Unlock()
+
+	if len(bus.subscriptions) >= bus.config.MaxStreams {
+		return nil, nil, &customerrors.TooManyWatchStreamsError{
+			Message: fmt.Sprintf("limit of %d watch streams has been reached", bus.config.MaxStreams),
+		}
+	}
+
+	id := bus.nextID
+	bus.nextID++
+
+	events := make(chan entities.TicketEvent, bus.config.BufferSize)
+	bus.subscriptions[id] = events
+
+	unsubscribe := func() {
+		bus.mutex.Lock()
+		defer bus.mutex.Unlock()
+
+		// Subscription could be already dropped due to buffer overflow, so channel should not be closed twice:
+		if _, ok := bus.subscriptions[id]; ok {
+			delete(bus.subscriptions, id)
+			close(events)
+		}
+	}
+
+	return events, unsubscribe, nil
+}
+
+// HasSubscribers allows publishers to skip preparing events, which nobody waits for.
+func (bus *Bus) HasSubscribers() bool {
+	bus.mutex.RLock()
+	defer bus.mutex.RUnlock()
+
+	return len(bus.subscriptions) > 0
+}
@@ -0,0 +1,86 @@
+package eventbus
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
+)
+
+func TestBus_Publish(t *testing.T) {
+	bus := New(config.WatchConfig{MaxStreams: 2, BufferSize: 2})
+	require.False(t, bus.HasSubscribers())
+
+	firstEvents, firstUnsubscribe, err := bus.Subscribe()
+	require.NoError(t, err)
+
+	defer firstUnsubscribe()
+
+	secondEvents, secondUnsubscribe, err := bus.Subscribe()
+	require.NoError(t, err)
+
+	defer secondUnsubscribe()
+
+	require.True(t, bus.HasSubscribers())
+
+	event := entities.TicketEvent{Type: entities.TicketEventTypeCreated, Ticket: entities.Ticket{ID: 1}}
+	bus.Publish(event)
+
+	require.Equal(t, event, <-firstEvents)
+	require.Equal(t, event, <-secondEvents)
+}
+
+func TestBus_SubscribeLimit(t *testing.T) {
+	bus := New(config.WatchConfig{MaxStreams: 1, BufferSize: 1})
+
+	_, unsubscribe, err := bus.Subscribe()
+	require.NoError(t, err)
+
+	_, _, err = bus.Subscribe()
+
+	var tooManyWatchStreamsError *customerrors.TooManyWatchStreamsError
+	require.True(t, errors.As(err, &tooManyWatchStreamsError))
+
+	// Slot is released after unsubscribe:
+	unsubscribe()
+	require.False(t, bus.HasSubscribers())
+
+	_, unsubscribe, err = bus.Subscribe()
+	require.NoError(t, err)
+	unsubscribe()
+}
+
+func TestBus_SlowSubscriber(t *testing.T) {
+	bus := New(config.WatchConfig{MaxStreams: 2, BufferSize: 1})
+
+	slowEvents, slowUnsubscribe, err := bus.Subscribe()
+	require.NoError(t, err)
+
+	fastEvents, fastUnsubscribe, err := bus.Subscribe()
+	require.NoError(t, err)
+
+	defer fastUnsubscribe()
+
+	firstEvent := entities.TicketEvent{Type: entities.TicketEventTypeCreated, Ticket: entities.Ticket{ID: 1}}
+	secondEvent := entities.TicketEvent{Type: entities.TicketEventTypeUpdated, Ticket: entities.Ticket{ID: 1}}
+
+	bus.Publish(firstEvent)
+	require.Equal(t, firstEvent, <-fastEvents)
+
+	// Buffer of slow subscriber is full, so it is dropped instead of blocking publisher:
+	bus.Publish(secondEvent)
+	require.Equal(t, secondEvent, <-fastEvents)
+
+	require.Equal(t, firstEvent, <-slowEvents)
+
+	_, ok := <-slowEvents
+	require.False(t, ok)
+
+	// Unsubscribe of dropped subscriber must not panic:
+	slowUnsubscribe()
+	require.True(t, bus.HasSubscribers())
+}
@@ -0,0 +1,81 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/DKhorkov/libs/contextlib"
+	"github.com/DKhorkov/libs/requestid"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/DKhorkov/hmtm-tickets/dto"
+)
+
+const (
+	specVersion     = "1.0"
+	source          = "hmtm-tickets"
+	dataContentType = "application/json"
+	schemasBaseURI  = "https://github.com/DKhorkov/hmtm-tickets/blob/main/api/events"
+	traceParentKey  = "traceparent"
+	traceStateKey   = "tracestate"
+)
+
+// schemaVersions contains current data schema version for each event type. Version should be incremented
+// with new schema file under api/events directory on every backward incompatible data change.
+var schemaVersions = map[string]uint32{
+	dto.TicketCreatedEventType:   1,
+	dto.TicketUpdatedEventType:   1,
+	dto.TicketDeletedEventType:   1,
+	dto.RespondCreatedEventType:  1,
+	dto.RespondUpdatedEventType:  1,
+	dto.RespondDeletedEventType:  1,
+	dto.RespondAcceptedEventType: 1,
+	dto.RespondRejectedEventType: 1,
+	dto.UserDataDeletedEventType: 1,
+}
+
+// Marshal wraps event data into envelope with request ID and trace context from provided context.
+func Marshal(ctx context.Context, eventType string, data any) ([]byte, error) {
+	schemaVersion, ok := schemaVersions[eventType]
+	if !ok {
+		return nil, fmt.Errorf("unknown event type %q", eventType)
+	}
+
+	content, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Request ID is absent for events, which are not caused by gRPC request:
+	requestID, err := contextlib.ValueFromContext[string](ctx, requestid.Key)
+	if err != nil {
+		requestID = ""
+	}
+
+	traceContext := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, traceContext)
+
+	return json.Marshal(
+		dto.EventEnvelope{
+			SpecVersion:     specVersion,
+			ID:              requestid.New(),
+			Source:          source,
+			Type:            eventType,
+			Time:            time.Now().UTC(),
+			DataContentType: dataContentType,
+			DataSchema:      SchemaURI(eventType, schemaVersion),
+			SchemaVersion:   schemaVersion,
+			RequestID:       requestID,
+			TraceParent:     traceContext.Get(traceParentKey),
+			TraceState:      traceContext.Get(traceStateKey),
+			Data:            content,
+		},
+	)
+}
+
+// SchemaURI returns location of JSON schema for provided event type and schema version.
+func SchemaURI(eventType string, schemaVersion uint32) string {
+	return fmt.Sprintf("%s/%s.v%d.schema.json", schemasBaseURI, eventType, schemaVersion)
+}
@@ -0,0 +1,114 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/DKhorkov/libs/contextlib"
+	"github.com/DKhorkov/libs/requestid"
+
+	"github.com/DKhorkov/hmtm-tickets/dto"
+)
+
+func TestMarshal(t *testing.T) {
+	spanContext := trace.NewSpanContext(
+		trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			SpanID:     trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+			TraceFlags: trace.FlagsSampled,
+		},
+	)
+
+	testCases := []struct {
+		name                string
+		ctx                 context.Context
+		eventType           string
+		expectedRequestID   string
+		expectedTraceParent string
+		errorExpected       bool
+	}{
+		{
+			name: "with request ID and trace context",
+			ctx: trace.ContextWithSpanContext(
+				contextlib.WithValue(context.Background(), requestid.Key, "request-id"),
+				spanContext,
+			),
+			eventType:           dto.TicketDeletedEventType,
+			expectedRequestID:   "request-id",
+			expectedTraceParent: "00-0102030405060708090a0b0c0d0e0f10-0102030405060708-01",
+			errorExpected:       false,
+		},
+		{
+			name:          "without request ID and trace context",
+			ctx:           context.Background(),
+			eventType:     dto.UserDataDeletedEventType,
+			errorExpected: false,
+		},
+		{
+			name:          "unknown event type",
+			ctx:           context.Background(),
+			eventType:     "unknown",
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			content, err := Marshal(
+				tc.ctx,
+				tc.eventType,
+				dto.TicketCreatedDTO{TicketID: 1, TicketOwnerID: 2, CategoryID: 3},
+			)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Nil(t, content)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			var envelope dto.EventEnvelope
+			require.NoError(t, json.Unmarshal(content, &envelope))
+			require.Equal(t, specVersion, envelope.SpecVersion)
+			require.NotEmpty(t, envelope.ID)
+			require.Equal(t, source, envelope.Source)
+			require.Equal(t, tc.eventType, envelope.Type)
+			require.False(t, envelope.Time.IsZero())
+			require.Equal(t, dataContentType, envelope.DataContentType)
+			require.Equal(t, SchemaURI(tc.eventType, 1), envelope.DataSchema)
+			require.Equal(t, uint32(1), envelope.SchemaVersion)
+			require.Equal(t, tc.expectedRequestID, envelope.RequestID)
+			require.Equal(t, tc.expectedTraceParent, envelope.TraceParent)
+			require.JSONEq(t, `{"ticketId":1,"ticketOwnerId":2,"categoryId":3}`, string(envelope.Data))
+		})
+	}
+}
+
+// TestSchemasArePublished checks that schema file exists for current version of every event type.
+func TestSchemasArePublished(t *testing.T) {
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+
+	schemasPath := path.Join(path.Dir(path.Dir(cwd)), "api", "events")
+	require.FileExists(t, path.Join(schemasPath, "envelope.v1.schema.json"))
+
+	for eventType, schemaVersion := range schemaVersions {
+		schemaPath := path.Join(schemasPath, fmt.Sprintf("%s.v%d.schema.json", eventType, schemaVersion))
+		require.FileExists(t, schemaPath)
+
+		content, err := os.ReadFile(schemaPath)
+		require.NoError(t, err)
+
+		var schema map[string]any
+		require.NoError(t, json.Unmarshal(content, &schema), schemaPath)
+		require.Equal(t, SchemaURI(eventType, schemaVersion), schema["$id"], schemaPath)
+	}
+}
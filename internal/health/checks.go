@@ -0,0 +1,42 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DKhorkov/libs/db"
+	"github.com/nats-io/nats.go"
+
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
+)
+
+// Database checks, that database can be reached via connections pool.
+func Database(dbConnector db.Connector) Check {
+	return Check{
+		Name: "database",
+		Check: func(ctx context.Context) error {
+			if err := dbConnector.Pool().PingContext(ctx); err != nil {
+				return &customerrors.DependencyUnavailableError{Message: "database is unavailable", BaseErr: err}
+			}
+
+			return nil
+		},
+	}
+}
+
+// NATS checks, that NATS connection is established. Connection reconnects on its own,
+// so check only reports its current state.
+func NATS(connection *nats.Conn) Check {
+	return Check{
+		Name: "NATS",
+		Check: func(_ context.Context) error {
+			if !connection.IsConnected() {
+				return &customerrors.DependencyUnavailableError{
+					Message: fmt.Sprintf("NATS connection status is %s", connection.Status()),
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,21 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
+)
+
+func TestNATS(t *testing.T) {
+	// Connection, which has never been connected, is reported as disconnected:
+	err := NATS(&nats.Conn{}).Check(context.Background())
+
+	var dependencyUnavailableError *customerrors.DependencyUnavailableError
+	require.True(t, errors.As(err, &dependencyUnavailableError))
+	require.Equal(t, "NATS connection status is DISCONNECTED", err.Error())
+}
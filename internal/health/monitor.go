@@ -0,0 +1,108 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+)
+
+// overallService is used by gRPC health protocol for status of the whole server. Kubernetes probes
+// check it, if service name is not provided.
+const overallService = ""
+
+// Check verifies, that one of service dependencies is reachable.
+type Check struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// Monitor periodically runs Checks and reports server as SERVING only if all of them succeeded.
+// Until first Checks are finished, server is reported as NOT_SERVING.
+type Monitor struct {
+	healthServer *health.Server
+	checks       []Check
+	config       config.HealthConfig
+	logger       logging.Logger
+	stopChannel  chan struct{}
+	doneChannel  chan struct{}
+	stopOnce     sync.Once
+}
+
+func New(
+	healthServer *health.Server,
+	checks []Check,
+	config config.HealthConfig,
+	logger logging.Logger,
+) *Monitor {
+	healthServer.SetServingStatus(overallService, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+	return &Monitor{
+		healthServer: healthServer,
+		checks:       checks,
+		config:       config,
+		logger:       logger,
+		stopChannel:  make(chan struct{}),
+		doneChannel:  make(chan struct{}),
+	}
+}
+
+// Run blocks until Stop is called, so should be launched in separate goroutine.
+func (monitor *Monitor) Run() {
+	defer close(monitor.doneChannel)
+
+	monitor.runChecks(context.Background())
+
+	ticker := time.NewTicker(monitor.config.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-monitor.stopChannel:
+			return
+		case <-ticker.C:
+			monitor.runChecks(context.Background())
+		}
+	}
+}
+
+// Stop interrupts Run loop and waits for current checks to be finished.
+func (monitor *Monitor) Stop() {
+	monitor.stopOnce.Do(func() {
+		close(monitor.stopChannel)
+	})
+
+	<-monitor.doneChannel
+}
+
+func (monitor *Monitor) runChecks(ctx context.Context) {
+	status := grpc_health_v1.HealthCheckResponse_SERVING
+	for _, check := range monitor.checks {
+		if err := monitor.runCheck(ctx, check); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				monitor.logger,
+				fmt.Sprintf("Health check of %s failed", check.Name),
+				err,
+			)
+
+			status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}
+	}
+
+	// Status is ignored by health server after its shutdown, so server stays NOT_SERVING during graceful stop:
+	monitor.healthServer.SetServingStatus(overallService, status)
+}
+
+func (monitor *Monitor) runCheck(ctx context.Context, check Check) error {
+	ctx, cancel := context.WithTimeout(ctx, monitor.config.CheckTimeout)
+	defer cancel()
+
+	return check.Check(ctx)
+}
@@ -0,0 +1,123 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+)
+
+func TestMonitor_runChecks(t *testing.T) {
+	healthConfig := config.HealthConfig{
+		CheckInterval: time.Second,
+		CheckTimeout:  10 * time.Millisecond,
+	}
+
+	successfulCheck := Check{
+		Name:  "successful",
+		Check: func(_ context.Context) error { return nil },
+	}
+
+	failedCheck := Check{
+		Name:  "failed",
+		Check: func(_ context.Context) error { return errors.New("unavailable") },
+	}
+
+	testCases := []struct {
+		name           string
+		checks         []Check
+		setupMocks     func(logger *mocklogging.MockLogger)
+		expectedStatus grpc_health_v1.HealthCheckResponse_ServingStatus
+	}{
+		{
+			name:           "all checks succeeded",
+			checks:         []Check{successfulCheck, successfulCheck},
+			expectedStatus: grpc_health_v1.HealthCheckResponse_SERVING,
+		},
+		{
+			name:   "one check failed",
+			checks: []Check{successfulCheck, failedCheck},
+			setupMocks: func(logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedStatus: grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+		},
+		{
+			name: "check exceeded timeout",
+			checks: []Check{
+				{
+					Name: "slow",
+					Check: func(ctx context.Context) error {
+						<-ctx.Done()
+
+						return ctx.Err()
+					},
+				},
+			},
+			setupMocks: func(logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedStatus: grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockController := gomock.NewController(t)
+			logger := mocklogging.NewMockLogger(mockController)
+			if tc.setupMocks != nil {
+				tc.setupMocks(logger)
+			}
+
+			healthServer := health.NewServer()
+			monitor := New(healthServer, tc.checks, healthConfig, logger)
+
+			monitor.runChecks(context.Background())
+
+			response, err := healthServer.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedStatus, response.GetStatus())
+		})
+	}
+}
+
+func TestMonitor_New(t *testing.T) {
+	healthServer := health.NewServer()
+	New(healthServer, nil, config.HealthConfig{}, nil)
+
+	response, err := healthServer.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, response.GetStatus())
+}
+
+func TestMonitor_runChecksAfterShutdown(t *testing.T) {
+	healthServer := health.NewServer()
+	monitor := New(
+		healthServer,
+		nil,
+		config.HealthConfig{CheckInterval: time.Second, CheckTimeout: time.Second},
+		nil,
+	)
+
+	healthServer.Shutdown()
+	monitor.runChecks(context.Background())
+
+	response, err := healthServer.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, response.GetStatus())
+}
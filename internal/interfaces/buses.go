@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+)
+
+// TicketEventsBus delivers Ticket changes to watch streams. Events channel is closed after unsubscribe
+// or if subscriber has not read events fast enough.
+//
+//go:generate mockgen -source=buses.go -destination=../../mocks/buses/ticket_events_bus.go -package=mockbuses -exclude_interfaces=
+type TicketEventsBus interface {
+	Publish(event entities.TicketEvent)
+	Subscribe() (events <-chan entities.TicketEvent, unsubscribe func(), err error)
+	HasSubscribers() bool
+}
@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+)
+
+//go:generate mockgen -source=publishers.go -destination=../../mocks/publishers/outbox_publisher.go -package=mockpublishers -exclude_interfaces=
+type OutboxPublisher interface {
+	Publish(ctx context.Context, message entities.OutboxMessage) error
+}
@@ -2,17 +2,24 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/DKhorkov/hmtm-tickets/internal/entities"
 )
 
-//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/tickets_repository.go -exclude_interfaces=RespondsRepository,ToysRepository -package=mockrepositories
+//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/tickets_repository.go -exclude_interfaces=RespondsRepository,ToysRepository,OutboxRepository,IdempotencyKeysRepository -package=mockrepositories
 type TicketsRepository interface {
 	CreateTicket(
 		ctx context.Context,
 		ticketData entities.CreateTicketDTO,
+		outboxMessageBuilders ...entities.OutboxMessageBuilder,
+	) (ticketID uint64, err error)
+	GetTicketIDByIdempotencyKey(
+		ctx context.Context,
+		idempotencyKey entities.IdempotencyKeyDTO,
 	) (ticketID uint64, err error)
 	GetTicketByID(ctx context.Context, id uint64) (*entities.Ticket, error)
+	GetTicketsByIDs(ctx context.Context, ids []uint64) ([]entities.Ticket, error)
 	GetTickets(
 		ctx context.Context,
 		pagination *entities.Pagination,
@@ -26,26 +33,105 @@ type TicketsRepository interface {
 		filters *entities.TicketsFilters,
 	) ([]entities.Ticket, error)
 	CountUserTickets(ctx context.Context, userID uint64, filters *entities.TicketsFilters) (uint64, error)
-	DeleteTicket(ctx context.Context, id uint64) error
-	UpdateTicket(ctx context.Context, ticketData entities.UpdateTicketDTO) error
+	DeleteTicket(
+		ctx context.Context,
+		id uint64,
+		outboxMessages ...entities.CreateOutboxMessageDTO,
+	) error
+	UpdateTicket(
+		ctx context.Context,
+		ticketData entities.UpdateTicketDTO,
+		outboxMessages ...entities.CreateOutboxMessageDTO,
+	) error
+	UpdateTicketStatus(ctx context.Context, id uint64, currentStatus, status entities.TicketStatus) error
+	UpdateTicketsCategory(
+		ctx context.Context,
+		oldCategoryID, newCategoryID uint32,
+		outboxMessageBuilders ...entities.OutboxMessageBuilder,
+	) ([]uint64, error)
+	DeleteTagFromTickets(
+		ctx context.Context,
+		tagID uint32,
+		outboxMessageBuilders ...entities.OutboxMessageBuilder,
+	) ([]uint64, error)
+	DeleteUserData(
+		ctx context.Context,
+		userData entities.DeleteUserDataDTO,
+		outboxMessageBuilders ...entities.DeletedUserDataOutboxMessageBuilder,
+	) error
 }
 
-//go:generate mockgen -source=repositories.go  -destination=../../mocks/repositories/responds_repository.go -exclude_interfaces=TicketsRepository,ToysRepository -package=mockrepositories
+//go:generate mockgen -source=repositories.go  -destination=../../mocks/repositories/responds_repository.go -exclude_interfaces=TicketsRepository,ToysRepository,OutboxRepository,IdempotencyKeysRepository -package=mockrepositories
 type RespondsRepository interface {
 	RespondToTicket(
 		ctx context.Context,
 		respondData entities.RespondToTicketDTO,
+		outboxMessageBuilders ...entities.OutboxMessageBuilder,
+	) (respondID uint64, err error)
+	GetRespondIDByIdempotencyKey(
+		ctx context.Context,
+		idempotencyKey entities.IdempotencyKeyDTO,
 	) (respondID uint64, err error)
 	GetRespondByID(ctx context.Context, id uint64) (*entities.Respond, error)
-	GetTicketResponds(ctx context.Context, ticketID uint64) ([]entities.Respond, error)
-	GetMasterResponds(ctx context.Context, masterID uint64) ([]entities.Respond, error)
-	UpdateRespond(ctx context.Context, respondData entities.UpdateRespondDTO) error
-	DeleteRespond(ctx context.Context, id uint64) error
+	GetRespondsByIDs(ctx context.Context, ids []uint64) ([]entities.Respond, error)
+	GetTicketResponds(
+		ctx context.Context,
+		ticketID uint64,
+		pagination *entities.Pagination,
+		filters *entities.RespondsFilters,
+	) ([]entities.Respond, error)
+	CountTicketResponds(ctx context.Context, ticketID uint64, filters *entities.RespondsFilters) (uint64, error)
+	GetMasterResponds(
+		ctx context.Context,
+		masterID uint64,
+		pagination *entities.Pagination,
+		filters *entities.RespondsFilters,
+	) ([]entities.Respond, error)
+	CountMasterResponds(ctx context.Context, masterID uint64, filters *entities.RespondsFilters) (uint64, error)
+	UpdateRespond(
+		ctx context.Context,
+		respondData entities.UpdateRespondDTO,
+		outboxMessages ...entities.CreateOutboxMessageDTO,
+	) error
+	DeleteRespond(
+		ctx context.Context,
+		id uint64,
+		outboxMessages ...entities.CreateOutboxMessageDTO,
+	) error
+	AcceptRespond(
+		ctx context.Context,
+		id uint64,
+		outboxMessages ...entities.CreateOutboxMessageDTO,
+	) error
+	UpdateRespondStatus(
+		ctx context.Context,
+		id uint64,
+		status entities.RespondStatus,
+		outboxMessages ...entities.CreateOutboxMessageDTO,
+	) error
+	WithdrawMasterResponds(ctx context.Context, masterID uint64) error
 }
 
-//go:generate mockgen -source=repositories.go  -destination=../../mocks/repositories/toys_repository.go -exclude_interfaces=RespondsRepository,TicketsRepository -package=mockrepositories
+//go:generate mockgen -source=repositories.go  -destination=../../mocks/repositories/toys_repository.go -exclude_interfaces=RespondsRepository,TicketsRepository,OutboxRepository,IdempotencyKeysRepository -package=mockrepositories
 type ToysRepository interface {
 	GetAllTags(ctx context.Context) ([]entities.Tag, error)
 	GetAllCategories(ctx context.Context) ([]entities.Category, error)
 	GetMasterByUserID(ctx context.Context, userID uint64) (*entities.Master, error)
 }
+
+//go:generate mockgen -source=repositories.go  -destination=../../mocks/repositories/outbox_repository.go -exclude_interfaces=RespondsRepository,TicketsRepository,ToysRepository,IdempotencyKeysRepository -package=mockrepositories
+type OutboxRepository interface {
+	ClaimPendingOutboxMessages(
+		ctx context.Context,
+		limit uint64,
+		leaseTimeout time.Duration,
+	) ([]entities.OutboxMessage, error)
+	DeleteSentOutboxMessages(ctx context.Context, sentBefore time.Time) error
+	MarkOutboxMessageAsSent(ctx context.Context, id uint64) error
+	MarkOutboxMessageAsFailed(ctx context.Context, id uint64, lastError string, nextAttemptAt time.Time) error
+}
+
+//go:generate mockgen -source=repositories.go  -destination=../../mocks/repositories/idempotency_keys_repository.go -exclude_interfaces=RespondsRepository,TicketsRepository,ToysRepository,OutboxRepository -package=mockrepositories
+type IdempotencyKeysRepository interface {
+	DeleteExpiredIdempotencyKeys(ctx context.Context, expiredBefore time.Time) error
+}
@@ -1,16 +1,26 @@
 package interfaces
 
-//go:generate mockgen -source=services.go -destination=../../mocks/services/tickets_service.go -package=mockservices -exclude_interfaces=RespondsService,ToysService
+//go:generate mockgen -source=services.go -destination=../../mocks/services/tickets_service.go -package=mockservices -exclude_interfaces=RespondsService,ToysService,OutboxService,IdempotencyKeysService
 type TicketsService interface {
 	TicketsRepository
 }
 
-//go:generate mockgen -source=services.go -destination=../../mocks/services/responds_service.go -package=mockservices -exclude_interfaces=TicketsService,ToysService
+//go:generate mockgen -source=services.go -destination=../../mocks/services/responds_service.go -package=mockservices -exclude_interfaces=TicketsService,ToysService,OutboxService,IdempotencyKeysService
 type RespondsService interface {
 	RespondsRepository
 }
 
-//go:generate mockgen -source=services.go -destination=../../mocks/services/toys_service.go -package=mockservices -exclude_interfaces=RespondsService,TicketsService
+//go:generate mockgen -source=services.go -destination=../../mocks/services/toys_service.go -package=mockservices -exclude_interfaces=RespondsService,TicketsService,OutboxService,IdempotencyKeysService
 type ToysService interface {
 	ToysRepository
 }
+
+//go:generate mockgen -source=services.go -destination=../../mocks/services/outbox_service.go -package=mockservices -exclude_interfaces=RespondsService,TicketsService,ToysService,IdempotencyKeysService
+type OutboxService interface {
+	OutboxRepository
+}
+
+//go:generate mockgen -source=services.go -destination=../../mocks/services/idempotency_keys_service.go -package=mockservices -exclude_interfaces=RespondsService,TicketsService,ToysService,OutboxService
+type IdempotencyKeysService interface {
+	IdempotencyKeysRepository
+}
@@ -14,6 +14,10 @@ type UseCases interface {
 		ticketData entities.CreateTicketDTO,
 	) (ticketID uint64, err error)
 	GetTicketByID(ctx context.Context, id uint64) (*entities.Ticket, error)
+	GetTicketsByIDs(
+		ctx context.Context,
+		ids []uint64,
+	) (tickets []entities.Ticket, missingIDs []uint64, err error)
 	GetTickets(
 		ctx context.Context,
 		pagination *entities.Pagination,
@@ -27,8 +31,15 @@ type UseCases interface {
 		filters *entities.TicketsFilters,
 	) ([]entities.Ticket, error)
 	CountUserTickets(ctx context.Context, userID uint64, filters *entities.TicketsFilters) (uint64, error)
-	DeleteTicket(ctx context.Context, id uint64) error
+	DeleteTicket(ctx context.Context, id, userID uint64) error
 	UpdateTicket(ctx context.Context, rawTicketData entities.RawUpdateTicketDTO) error
+	ChangeTicketStatus(ctx context.Context, id, userID uint64, status entities.TicketStatus) error
+	WatchTickets(
+		ctx context.Context,
+		filters *entities.TicketsFilters,
+		snapshot bool,
+		send func(event entities.TicketEvent) error,
+	) error
 
 	// Responds cases:
 	RespondToTicket(
@@ -36,8 +47,34 @@ type UseCases interface {
 		rawRespondData entities.RawRespondToTicketDTO,
 	) (respondID uint64, err error)
 	GetRespondByID(ctx context.Context, id uint64) (*entities.Respond, error)
-	GetTicketResponds(ctx context.Context, ticketID uint64) ([]entities.Respond, error)
-	GetUserResponds(ctx context.Context, userID uint64) ([]entities.Respond, error)
-	UpdateRespond(ctx context.Context, respondData entities.UpdateRespondDTO) error
-	DeleteRespond(ctx context.Context, id uint64) error
+	GetRespondsByIDs(
+		ctx context.Context,
+		ids []uint64,
+	) (responds []entities.Respond, missingIDs []uint64, err error)
+	GetTicketResponds(
+		ctx context.Context,
+		ticketID uint64,
+		pagination *entities.Pagination,
+		filters *entities.RespondsFilters,
+	) ([]entities.Respond, error)
+	CountTicketResponds(ctx context.Context, ticketID uint64, filters *entities.RespondsFilters) (uint64, error)
+	GetUserResponds(
+		ctx context.Context,
+		userID uint64,
+		pagination *entities.Pagination,
+		filters *entities.RespondsFilters,
+	) ([]entities.Respond, error)
+	CountUserResponds(ctx context.Context, userID uint64, filters *entities.RespondsFilters) (uint64, error)
+	UpdateRespond(ctx context.Context, rawRespondData entities.RawUpdateRespondDTO) error
+	DeleteRespond(ctx context.Context, id, userID uint64) error
+	AcceptRespond(ctx context.Context, id, userID uint64) error
+	RejectRespond(ctx context.Context, id, userID uint64) error
+
+	// Cases for keeping consistency with deleted hmtm-toys entities:
+	UpdateTicketsCategory(ctx context.Context, oldCategoryID, newCategoryID uint32) error
+	DeleteTagFromTickets(ctx context.Context, tagID uint32) error
+	WithdrawMasterResponds(ctx context.Context, masterID uint64) error
+
+	// Cases for keeping consistency with deleted hmtm-sso entities:
+	DeleteUserData(ctx context.Context, userID uint64) error
 }
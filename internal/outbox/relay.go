@@ -0,0 +1,156 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	"github.com/DKhorkov/hmtm-tickets/internal/interfaces"
+)
+
+// Relay periodically claims pending outbox messages and publishes them to NATS.
+// Messages are marked as sent only after successful publishing, so delivery is at-least-once.
+// Sent messages are periodically deleted after retention period together with expired idempotency keys.
+type Relay struct {
+	outboxService          interfaces.OutboxService
+	idempotencyKeysService interfaces.IdempotencyKeysService
+	publisher              interfaces.OutboxPublisher
+	config                 config.OutboxConfig
+	logger                 logging.Logger
+	stopChannel            chan struct{}
+	doneChannel            chan struct{}
+	stopOnce               sync.Once
+}
+
+func New(
+	outboxService interfaces.OutboxService,
+	idempotencyKeysService interfaces.IdempotencyKeysService,
+	publisher interfaces.OutboxPublisher,
+	config config.OutboxConfig,
+	logger logging.Logger,
+) *Relay {
+	return &Relay{
+		outboxService:          outboxService,
+		idempotencyKeysService: idempotencyKeysService,
+		publisher:              publisher,
+		config:                 config,
+		logger:                 logger,
+		stopChannel:            make(chan struct{}),
+		doneChannel:            make(chan struct{}),
+	}
+}
+
+// Run blocks until Stop is called, so should be launched in separate goroutine.
+func (relay *Relay) Run() {
+	defer close(relay.doneChannel)
+
+	ticker := time.NewTicker(relay.config.PollInterval)
+	defer ticker.Stop()
+
+	cleanupTicker := time.NewTicker(relay.config.CleanupInterval)
+	defer cleanupTicker.Stop()
+
+	for {
+		select {
+		case <-relay.stopChannel:
+			return
+		case <-ticker.C:
+			relay.processBatch(context.Background())
+		case <-cleanupTicker.C:
+			relay.cleanup(context.Background())
+		}
+	}
+}
+
+// Stop interrupts Run loop and waits for current batch to be processed.
+func (relay *Relay) Stop() {
+	relay.stopOnce.Do(func() {
+		close(relay.stopChannel)
+	})
+
+	<-relay.doneChannel
+}
+
+func (relay *Relay) processBatch(ctx context.Context) {
+	messages, err := relay.outboxService.ClaimPendingOutboxMessages(
+		ctx,
+		relay.config.BatchSize,
+		relay.config.LeaseTimeout,
+	)
+	if err != nil {
+		logging.LogErrorContext(ctx, relay.logger, "Failed to claim pending outbox messages", err)
+
+		return
+	}
+
+	for _, message := range messages {
+		relay.processMessage(ctx, message)
+	}
+}
+
+func (relay *Relay) processMessage(ctx context.Context, message entities.OutboxMessage) {
+	if err := relay.publisher.Publish(ctx, message); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			relay.logger,
+			fmt.Sprintf("Failed to publish outbox message with ID=%d", message.ID),
+			err,
+		)
+
+		nextAttemptAt := time.Now().UTC().Add(relay.retryTimeout(message.Attempts))
+		if err = relay.outboxService.MarkOutboxMessageAsFailed(
+			ctx,
+			message.ID,
+			err.Error(),
+			nextAttemptAt,
+		); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				relay.logger,
+				fmt.Sprintf("Failed to mark outbox message with ID=%d as failed", message.ID),
+				err,
+			)
+		}
+
+		return
+	}
+
+	if err := relay.outboxService.MarkOutboxMessageAsSent(ctx, message.ID); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			relay.logger,
+			fmt.Sprintf("Failed to mark outbox message with ID=%d as sent", message.ID),
+			err,
+		)
+	}
+}
+
+func (relay *Relay) cleanup(ctx context.Context) {
+	sentBefore := time.Now().UTC().Add(-relay.config.SentMessagesRetention)
+	if err := relay.outboxService.DeleteSentOutboxMessages(ctx, sentBefore); err != nil {
+		logging.LogErrorContext(ctx, relay.logger, "Failed to delete sent outbox messages", err)
+	}
+
+	if err := relay.idempotencyKeysService.DeleteExpiredIdempotencyKeys(ctx, time.Now().UTC()); err != nil {
+		logging.LogErrorContext(ctx, relay.logger, "Failed to delete expired idempotency keys", err)
+	}
+}
+
+// retryTimeout calculates exponential backoff, limited by MaxRetryTimeout.
+func (relay *Relay) retryTimeout(attempts uint32) time.Duration {
+	timeout := relay.config.BaseRetryTimeout
+	for range attempts {
+		if timeout >= relay.config.MaxRetryTimeout {
+			break
+		}
+
+		timeout *= 2
+	}
+
+	return min(timeout, relay.config.MaxRetryTimeout)
+}
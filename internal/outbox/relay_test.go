@@ -0,0 +1,335 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	mockpublishers "github.com/DKhorkov/hmtm-tickets/mocks/publishers"
+	mockservices "github.com/DKhorkov/hmtm-tickets/mocks/services"
+)
+
+func TestRelay_processBatch(t *testing.T) {
+	outboxConfig := config.OutboxConfig{
+		BatchSize:        10,
+		PollInterval:     time.Second,
+		BaseRetryTimeout: time.Second,
+		MaxRetryTimeout:  time.Minute,
+		LeaseTimeout:     time.Minute,
+	}
+
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			outboxService *mockservices.MockOutboxService,
+			publisher *mockpublishers.MockOutboxPublisher,
+			logger *mocklogging.MockLogger,
+		)
+	}{
+		{
+			name: "success",
+			setupMocks: func(
+				outboxService *mockservices.MockOutboxService,
+				publisher *mockpublishers.MockOutboxPublisher,
+				_ *mocklogging.MockLogger,
+			) {
+				outboxService.
+					EXPECT().
+					ClaimPendingOutboxMessages(gomock.Any(), uint64(10), time.Minute).
+					Return(
+						[]entities.OutboxMessage{
+							{ID: 1, Subject: "ticket-deleted", Payload: []byte("{}")},
+							{ID: 2, Subject: "ticket-updated", Payload: []byte("{}")},
+						},
+						nil,
+					).
+					Times(1)
+
+				publisher.
+					EXPECT().
+					Publish(gomock.Any(), messageWithSubject("ticket-deleted")).
+					Return(nil).
+					Times(1)
+
+				outboxService.
+					EXPECT().
+					MarkOutboxMessageAsSent(gomock.Any(), uint64(1)).
+					Return(nil).
+					Times(1)
+
+				publisher.
+					EXPECT().
+					Publish(gomock.Any(), messageWithSubject("ticket-updated")).
+					Return(nil).
+					Times(1)
+
+				outboxService.
+					EXPECT().
+					MarkOutboxMessageAsSent(gomock.Any(), uint64(2)).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "get pending messages error",
+			setupMocks: func(
+				outboxService *mockservices.MockOutboxService,
+				_ *mockpublishers.MockOutboxPublisher,
+				logger *mocklogging.MockLogger,
+			) {
+				outboxService.
+					EXPECT().
+					ClaimPendingOutboxMessages(gomock.Any(), uint64(10), time.Minute).
+					Return(nil, errors.New("db error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+		},
+		{
+			name: "publish error",
+			setupMocks: func(
+				outboxService *mockservices.MockOutboxService,
+				publisher *mockpublishers.MockOutboxPublisher,
+				logger *mocklogging.MockLogger,
+			) {
+				outboxService.
+					EXPECT().
+					ClaimPendingOutboxMessages(gomock.Any(), uint64(10), time.Minute).
+					Return(
+						[]entities.OutboxMessage{{ID: 1, Subject: "ticket-deleted", Payload: []byte("{}"), Attempts: 2}},
+						nil,
+					).
+					Times(1)
+
+				publisher.
+					EXPECT().
+					Publish(gomock.Any(), messageWithSubject("ticket-deleted")).
+					Return(errors.New("publish error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+
+				outboxService.
+					EXPECT().
+					MarkOutboxMessageAsFailed(gomock.Any(), uint64(1), "publish error", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uint64, _ string, nextAttemptAt time.Time) error {
+						// Third attempt must be postponed for 4 seconds:
+						require.WithinDuration(t, time.Now().UTC().Add(4*time.Second), nextAttemptAt, time.Second)
+
+						return nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name: "mark as sent error",
+			setupMocks: func(
+				outboxService *mockservices.MockOutboxService,
+				publisher *mockpublishers.MockOutboxPublisher,
+				logger *mocklogging.MockLogger,
+			) {
+				outboxService.
+					EXPECT().
+					ClaimPendingOutboxMessages(gomock.Any(), uint64(10), time.Minute).
+					Return([]entities.OutboxMessage{{ID: 1, Subject: "ticket-deleted", Payload: []byte("{}")}}, nil).
+					Times(1)
+
+				publisher.
+					EXPECT().
+					Publish(gomock.Any(), messageWithSubject("ticket-deleted")).
+					Return(nil).
+					Times(1)
+
+				outboxService.
+					EXPECT().
+					MarkOutboxMessageAsSent(gomock.Any(), uint64(1)).
+					Return(errors.New("db error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	outboxService := mockservices.NewMockOutboxService(ctrl)
+	publisher := mockpublishers.NewMockOutboxPublisher(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	relay := New(outboxService, mockservices.NewMockIdempotencyKeysService(ctrl), publisher, outboxConfig, logger)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(outboxService, publisher, logger)
+			}
+
+			relay.processBatch(context.Background())
+		})
+	}
+}
+
+func TestRelay_cleanup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	outboxService := mockservices.NewMockOutboxService(ctrl)
+	idempotencyKeysService := mockservices.NewMockIdempotencyKeysService(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	relay := New(
+		outboxService,
+		idempotencyKeysService,
+		mockpublishers.NewMockOutboxPublisher(ctrl),
+		config.OutboxConfig{SentMessagesRetention: time.Hour},
+		logger,
+	)
+
+	// Messages, sent earlier than retention period ago, are deleted:
+	sentBeforeRetention := gomock.Cond(func(sentBefore time.Time) bool {
+		return time.Since(sentBefore) >= time.Hour && time.Since(sentBefore) < time.Hour+time.Minute
+	})
+
+	// Idempotency keys are deleted right after expiration, since they are already ignored by create requests:
+	expiredBeforeNow := gomock.Cond(func(expiredBefore time.Time) bool {
+		return time.Since(expiredBefore) >= 0 && time.Since(expiredBefore) < time.Minute
+	})
+
+	outboxService.
+		EXPECT().
+		DeleteSentOutboxMessages(gomock.Any(), sentBeforeRetention).
+		Return(nil).
+		Times(1)
+
+	idempotencyKeysService.
+		EXPECT().
+		DeleteExpiredIdempotencyKeys(gomock.Any(), expiredBeforeNow).
+		Return(nil).
+		Times(1)
+
+	relay.cleanup(context.Background())
+
+	// Failed cleanup is only logged and is repeated on next tick. Failed deletion of outbox messages
+	// does not prevent deletion of expired idempotency keys:
+	outboxService.
+		EXPECT().
+		DeleteSentOutboxMessages(gomock.Any(), sentBeforeRetention).
+		Return(errors.New("delete failed")).
+		Times(1)
+
+	idempotencyKeysService.
+		EXPECT().
+		DeleteExpiredIdempotencyKeys(gomock.Any(), expiredBeforeNow).
+		Return(errors.New("delete failed")).
+		Times(1)
+
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2)
+
+	relay.cleanup(context.Background())
+}
+
+func TestRelay_retryTimeout(t *testing.T) {
+	relay := New(
+		nil,
+		nil,
+		nil,
+		config.OutboxConfig{
+			BaseRetryTimeout: time.Second,
+			MaxRetryTimeout:  10 * time.Second,
+		},
+		nil,
+	)
+
+	testCases := []struct {
+		name     string
+		attempts uint32
+		expected time.Duration
+	}{
+		{
+			name:     "first attempt",
+			attempts: 0,
+			expected: time.Second,
+		},
+		{
+			name:     "exponential growth",
+			attempts: 3,
+			expected: 8 * time.Second,
+		},
+		{
+			name:     "limited by max retry timeout",
+			attempts: 100,
+			expected: 10 * time.Second,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, relay.retryTimeout(tc.attempts))
+		})
+	}
+}
+
+func TestRelay_RunAndStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	outboxService := mockservices.NewMockOutboxService(ctrl)
+	outboxService.
+		EXPECT().
+		ClaimPendingOutboxMessages(gomock.Any(), uint64(1), time.Minute).
+		Return(nil, nil).
+		AnyTimes()
+
+	outboxService.
+		EXPECT().
+		DeleteSentOutboxMessages(gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+
+	idempotencyKeysService := mockservices.NewMockIdempotencyKeysService(ctrl)
+	idempotencyKeysService.
+		EXPECT().
+		DeleteExpiredIdempotencyKeys(gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+
+	relay := New(
+		outboxService,
+		idempotencyKeysService,
+		mockpublishers.NewMockOutboxPublisher(ctrl),
+		config.OutboxConfig{
+			BatchSize:       1,
+			PollInterval:    time.Millisecond,
+			LeaseTimeout:    time.Minute,
+			CleanupInterval: time.Millisecond,
+		},
+		mocklogging.NewMockLogger(ctrl),
+	)
+
+	go relay.Run()
+
+	time.Sleep(10 * time.Millisecond)
+	relay.Stop()
+	relay.Stop() // Repeated Stop must not panic
+}
+
+func messageWithSubject(subject string) gomock.Matcher {
+	return gomock.Cond(func(message entities.OutboxMessage) bool {
+		return message.Subject == subject
+	})
+}
@@ -0,0 +1,24 @@
+package publishers
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+)
+
+// NewCorePublisher creates publisher, which sends messages via core NATS in fire-and-forget mode.
+// Messages are lost, if there are no subscribers at the moment of publishing. Connection is shared with
+// other NATS clients of service, so its health check reflects the state of publishing.
+func NewCorePublisher(connection *nats.Conn) *CorePublisher {
+	return &CorePublisher{connection: connection}
+}
+
+type CorePublisher struct {
+	connection *nats.Conn
+}
+
+func (publisher *CorePublisher) Publish(_ context.Context, message entities.OutboxMessage) error {
+	return publisher.connection.Publish(message.Subject, message.Payload)
+}
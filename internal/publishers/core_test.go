@@ -0,0 +1,44 @@
+package publishers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	natsserver "github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+)
+
+func TestCorePublisher_Publish(t *testing.T) {
+	server := natsserver.RunRandClientPortServer()
+	defer server.Shutdown()
+
+	connection, err := nats.Connect(server.ClientURL())
+	require.NoError(t, err)
+
+	subscription, err := connection.SubscribeSync("ticket-updated")
+	require.NoError(t, err)
+
+	publisher := NewCorePublisher(connection)
+	err = publisher.Publish(
+		context.Background(),
+		entities.OutboxMessage{ID: 1, Subject: "ticket-updated", Payload: []byte("{}")},
+	)
+	require.NoError(t, err)
+
+	message, err := subscription.NextMsg(time.Second)
+	require.NoError(t, err)
+	require.Equal(t, []byte("{}"), message.Data)
+
+	// Publishing via closed connection fails, so relay retries message later:
+	connection.Close()
+
+	err = publisher.Publish(
+		context.Background(),
+		entities.OutboxMessage{ID: 2, Subject: "ticket-updated", Payload: []byte("{}")},
+	)
+	require.Error(t, err)
+}
@@ -0,0 +1,77 @@
+package publishers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+)
+
+// NewJetStreamPublisher creates publisher, which stores messages in JetStream stream. Stream is created
+// or updated to listen provided subjects.
+func NewJetStreamPublisher(
+	ctx context.Context,
+	js jetstream.JetStream,
+	jetStreamConfig config.NATSJetStream,
+	subjects []string,
+) (*JetStreamPublisher, error) {
+	_, err := js.CreateOrUpdateStream(
+		ctx,
+		jetstream.StreamConfig{
+			Name:       jetStreamConfig.StreamName,
+			Subjects:   subjects,
+			Storage:    jetstream.FileStorage,
+			Duplicates: jetStreamConfig.DuplicatesWindow,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JetStreamPublisher{
+		js:     js,
+		config: jetStreamConfig,
+	}, nil
+}
+
+// JetStreamPublisher waits for stream acknowledgement, so message is considered as published only
+// after it has been persisted. Nats-Msg-Id header allows stream to drop duplicates, which appear
+// when message has been stored, but acknowledgement has been lost.
+type JetStreamPublisher struct {
+	js     jetstream.JetStream
+	config config.NATSJetStream
+}
+
+func (publisher *JetStreamPublisher) Publish(ctx context.Context, message entities.OutboxMessage) error {
+	ctx, cancel := context.WithTimeout(ctx, publisher.config.AckTimeout)
+	defer cancel()
+
+	header := nats.Header{}
+	header.Set(jetstream.MsgIDHeader, DeduplicationID(message))
+
+	_, err := publisher.js.PublishMsg(
+		ctx,
+		&nats.Msg{
+			Subject: message.Subject,
+			Data:    message.Payload,
+			Header:  header,
+		},
+		jetstream.WithExpectStream(publisher.config.StreamName),
+	)
+
+	return err
+}
+
+// DeduplicationID returns ID, provided by message creator. Outbox message ID is used for messages
+// without it, because outbox message is published several times only due to retries.
+func DeduplicationID(message entities.OutboxMessage) string {
+	if message.DeduplicationID != nil {
+		return *message.DeduplicationID
+	}
+
+	return fmt.Sprintf("outbox-%d", message.ID)
+}
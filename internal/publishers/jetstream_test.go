@@ -0,0 +1,159 @@
+package publishers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/libs/pointers"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+)
+
+// stubJetStream stands in for JetStream server. Not overridden methods panic due to nil embedded interface.
+type stubJetStream struct {
+	jetstream.JetStream
+
+	streamConfig *jetstream.StreamConfig
+	streamErr    error
+	published    []*nats.Msg
+	publishErr   error
+	hasDeadline  bool
+}
+
+func (js *stubJetStream) CreateOrUpdateStream(
+	_ context.Context,
+	cfg jetstream.StreamConfig,
+) (jetstream.Stream, error) {
+	js.streamConfig = &cfg
+
+	return nil, js.streamErr
+}
+
+func (js *stubJetStream) PublishMsg(
+	ctx context.Context,
+	msg *nats.Msg,
+	_ ...jetstream.PublishOpt,
+) (*jetstream.PubAck, error) {
+	_, js.hasDeadline = ctx.Deadline()
+	js.published = append(js.published, msg)
+
+	if js.publishErr != nil {
+		return nil, js.publishErr
+	}
+
+	return &jetstream.PubAck{Stream: js.streamConfig.Name, Sequence: uint64(len(js.published))}, nil
+}
+
+var jetStreamConfig = config.NATSJetStream{
+	Enabled:          true,
+	StreamName:       "HMTM_TICKETS",
+	AckTimeout:       time.Second,
+	DuplicatesWindow: time.Minute,
+}
+
+func TestNewJetStreamPublisher(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		js := &stubJetStream{}
+
+		publisher, err := NewJetStreamPublisher(
+			context.Background(),
+			js,
+			jetStreamConfig,
+			[]string{"ticket-updated", "ticket-deleted"},
+		)
+		require.NoError(t, err)
+		require.NotNil(t, publisher)
+		require.Equal(
+			t,
+			&jetstream.StreamConfig{
+				Name:       "HMTM_TICKETS",
+				Subjects:   []string{"ticket-updated", "ticket-deleted"},
+				Storage:    jetstream.FileStorage,
+				Duplicates: time.Minute,
+			},
+			js.streamConfig,
+		)
+	})
+
+	t.Run("stream error", func(t *testing.T) {
+		js := &stubJetStream{streamErr: errors.New("jetstream not enabled")}
+
+		publisher, err := NewJetStreamPublisher(context.Background(), js, jetStreamConfig, nil)
+		require.Error(t, err)
+		require.Nil(t, publisher)
+	})
+}
+
+func TestJetStreamPublisher_Publish(t *testing.T) {
+	testCases := []struct {
+		name                    string
+		message                 entities.OutboxMessage
+		publishErr              error
+		expectedDeduplicationID string
+		errorExpected           bool
+	}{
+		{
+			name: "with deduplication ID",
+			message: entities.OutboxMessage{
+				ID:              1,
+				Subject:         "ticket-updated",
+				Payload:         []byte("{}"),
+				DeduplicationID: pointers.New("ticket-updated:1:100"),
+			},
+			expectedDeduplicationID: "ticket-updated:1:100",
+			errorExpected:           false,
+		},
+		{
+			name: "without deduplication ID",
+			message: entities.OutboxMessage{
+				ID:      2,
+				Subject: "respond-created",
+				Payload: []byte("{}"),
+			},
+			expectedDeduplicationID: "outbox-2",
+			errorExpected:           false,
+		},
+		{
+			name: "ack error",
+			message: entities.OutboxMessage{
+				ID:      3,
+				Subject: "ticket-deleted",
+				Payload: []byte("{}"),
+			},
+			publishErr:              jetstream.ErrNoStreamResponse,
+			expectedDeduplicationID: "outbox-3",
+			errorExpected:           true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			js := &stubJetStream{publishErr: tc.publishErr}
+
+			publisher, err := NewJetStreamPublisher(context.Background(), js, jetStreamConfig, nil)
+			require.NoError(t, err)
+
+			err = publisher.Publish(context.Background(), tc.message)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Len(t, js.published, 1)
+			require.Equal(t, tc.message.Subject, js.published[0].Subject)
+			require.Equal(t, tc.message.Payload, js.published[0].Data)
+			require.Equal(t, tc.expectedDeduplicationID, js.published[0].Header.Get(jetstream.MsgIDHeader))
+
+			// Publisher should not wait for acknowledgement forever:
+			require.True(t, js.hasDeadline)
+		})
+	}
+}
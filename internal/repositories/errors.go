@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	// postgresUniqueViolationSQLState is SQLSTATE of Postgres unique_violation error.
+	postgresUniqueViolationSQLState = "23505"
+
+	// sqliteUniqueViolationMessage is a part of SQLite unique constraint error text. SQLite driver is used
+	// only in tests and is not imported here to keep service binary free of cgo.
+	sqliteUniqueViolationMessage = "UNIQUE constraint failed"
+)
+
+// isUniqueViolation returns true, if err is caused by unique constraint violation in Postgres or SQLite.
+func isUniqueViolation(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var sqlStateErr interface{ SQLState() string }
+	if errors.As(err, &sqlStateErr) {
+		return sqlStateErr.SQLState() == postgresUniqueViolationSQLState
+	}
+
+	return strings.Contains(err.Error(), sqliteUniqueViolationMessage)
+}
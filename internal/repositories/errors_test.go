@@ -0,0 +1,55 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsUniqueViolation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "nil error",
+			err:      nil,
+			expected: false,
+		},
+		{
+			name:     "postgres unique violation",
+			err:      &pq.Error{Code: postgresUniqueViolationSQLState},
+			expected: true,
+		},
+		{
+			name:     "wrapped postgres unique violation",
+			err:      fmt.Errorf("insert failed: %w", &pq.Error{Code: postgresUniqueViolationSQLState}),
+			expected: true,
+		},
+		{
+			name:     "postgres foreign key violation",
+			err:      &pq.Error{Code: "23503"},
+			expected: false,
+		},
+		{
+			name:     "sqlite unique violation",
+			err:      errors.New("UNIQUE constraint failed: responds.ticket_id, responds.master_id"),
+			expected: true,
+		},
+		{
+			name:     "another error",
+			err:      errors.New("connection refused"),
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, isUniqueViolation(tc.err))
+		})
+	}
+}
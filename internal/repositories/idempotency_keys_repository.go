@@ -0,0 +1,177 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/DKhorkov/libs/db"
+	"github.com/DKhorkov/libs/logging"
+	"github.com/DKhorkov/libs/tracing"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+)
+
+const (
+	idempotencyKeysTableName           = "idempotency_keys"
+	idempotencyOperationColumnName     = "operation"
+	idempotencyKeyColumnName           = "idempotency_key"
+	idempotencyResourceIDColumnName    = "resource_id"
+	idempotencyExpiresAtColumnName     = "expires_at"
+	createTicketIdempotentOperation    = "create_ticket"
+	respondToTicketIdempotentOperation = "respond_to_ticket"
+)
+
+type IdempotencyKeysRepository struct {
+	dbConnector   db.Connector
+	logger        logging.Logger
+	traceProvider tracing.Provider
+	spanConfig    tracing.SpanConfig
+}
+
+func NewIdempotencyKeysRepository(
+	dbConnector db.Connector,
+	logger logging.Logger,
+	traceProvider tracing.Provider,
+	spanConfig tracing.SpanConfig,
+) *IdempotencyKeysRepository {
+	return &IdempotencyKeysRepository{
+		dbConnector:   dbConnector,
+		logger:        logger,
+		traceProvider: traceProvider,
+		spanConfig:    spanConfig,
+	}
+}
+
+// DeleteExpiredIdempotencyKeys deletes keys, which have expired before provided time. Expired keys are
+// already ignored by create requests, so they are deleted only for idempotency_keys table not to grow infinitely.
+func (repo *IdempotencyKeysRepository) DeleteExpiredIdempotencyKeys(ctx context.Context, expiredBefore time.Time) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Delete(idempotencyKeysTableName).
+		Where(sq.Lt{idempotencyExpiresAtColumnName: expiredBefore}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = connection.ExecContext(
+		ctx,
+		stmt,
+		params...,
+	)
+
+	return err
+}
+
+// getIdempotentResourceID returns ID of resource, created by request with provided idempotency key.
+// Expired keys are ignored, so sql.ErrNoRows is returned both for expired and unknown keys.
+func getIdempotentResourceID(
+	ctx context.Context,
+	connection *sql.Conn,
+	operation string,
+	idempotencyKey entities.IdempotencyKeyDTO,
+) (uint64, error) {
+	stmt, params, err := sq.
+		Select(idempotencyResourceIDColumnName).
+		From(idempotencyKeysTableName).
+		Where(
+			sq.And{
+				sq.Eq{userIDColumnName: idempotencyKey.UserID},
+				sq.Eq{idempotencyOperationColumnName: operation},
+				sq.Eq{idempotencyKeyColumnName: idempotencyKey.Key},
+				sq.Gt{idempotencyExpiresAtColumnName: time.Now().UTC()},
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var resourceID uint64
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&resourceID); err != nil {
+		return 0, err
+	}
+
+	return resourceID, nil
+}
+
+// insertIdempotencyKey saves ID of created resource within the same transaction, as resource itself.
+// Expired key with the same value is deleted first, so key can be reused after TTL. Concurrent requests
+// with the same key violate unique constraint, so only one of them can create resource.
+func insertIdempotencyKey(
+	ctx context.Context,
+	transaction *sql.Tx,
+	operation string,
+	idempotencyKey *entities.IdempotencyKeyDTO,
+	resourceID uint64,
+	ttl time.Duration,
+) error {
+	if idempotencyKey == nil {
+		return nil
+	}
+
+	now := time.Now().UTC()
+
+	stmt, params, err := sq.
+		Delete(idempotencyKeysTableName).
+		Where(
+			sq.And{
+				sq.Eq{userIDColumnName: idempotencyKey.UserID},
+				sq.Eq{idempotencyOperationColumnName: operation},
+				sq.Eq{idempotencyKeyColumnName: idempotencyKey.Key},
+				sq.LtOrEq{idempotencyExpiresAtColumnName: now},
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+		return err
+	}
+
+	stmt, params, err = sq.
+		Insert(idempotencyKeysTableName).
+		Columns(
+			userIDColumnName,
+			idempotencyOperationColumnName,
+			idempotencyKeyColumnName,
+			idempotencyResourceIDColumnName,
+			idempotencyExpiresAtColumnName,
+		).
+		Values(
+			idempotencyKey.UserID,
+			operation,
+			idempotencyKey.Key,
+			resourceID,
+			now.Add(ttl),
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = transaction.ExecContext(ctx, stmt, params...)
+
+	return err
+}
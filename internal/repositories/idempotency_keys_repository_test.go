@@ -0,0 +1,133 @@
+//go:build integration
+
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/pressly/goose/v3"
+
+	_ "github.com/mattn/go-sqlite3" // Must be imported for correct work
+
+	"github.com/DKhorkov/hmtm-tickets/internal/repositories"
+	"github.com/DKhorkov/libs/db"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/tracing"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestIdempotencyKeysRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyKeysRepositoryTestSuite))
+}
+
+type IdempotencyKeysRepositoryTestSuite struct {
+	suite.Suite
+
+	cwd                       string
+	ctx                       context.Context
+	dbConnector               db.Connector
+	connection                *sql.Conn
+	idempotencyKeysRepository *repositories.IdempotencyKeysRepository
+	logger                    *mocklogging.MockLogger
+	traceProvider             *mocktracing.MockProvider
+	spanConfig                tracing.SpanConfig
+}
+
+func (s *IdempotencyKeysRepositoryTestSuite) SetupSuite() {
+	s.NoError(goose.SetDialect(driver))
+
+	ctrl := gomock.NewController(s.T())
+	s.ctx = context.Background()
+	s.logger = mocklogging.NewMockLogger(ctrl)
+	dbConnector, err := db.New(dsn, driver, s.logger)
+	s.NoError(err)
+
+	cwd, err := os.Getwd()
+	s.NoError(err)
+
+	s.cwd = cwd
+	s.dbConnector = dbConnector
+	s.traceProvider = mocktracing.NewMockProvider(ctrl)
+	s.spanConfig = tracing.SpanConfig{}
+	s.idempotencyKeysRepository = repositories.NewIdempotencyKeysRepository(s.dbConnector, s.logger, s.traceProvider, s.spanConfig)
+}
+
+func (s *IdempotencyKeysRepositoryTestSuite) SetupTest() {
+	s.NoError(
+		goose.Up(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+		),
+	)
+
+	connection, err := s.dbConnector.Connection(s.ctx)
+	s.NoError(err)
+
+	s.connection = connection
+}
+
+func (s *IdempotencyKeysRepositoryTestSuite) TearDownTest() {
+	s.NoError(
+		goose.DownTo(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+			gooseZeroVersion,
+		),
+	)
+
+	s.NoError(s.connection.Close())
+}
+
+func (s *IdempotencyKeysRepositoryTestSuite) TearDownSuite() {
+	s.NoError(s.dbConnector.Close())
+}
+
+func (s *IdempotencyKeysRepositoryTestSuite) TestDeleteExpiredIdempotencyKeys() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	now := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO idempotency_keys (id, user_id, operation, idempotency_key, resource_id, expires_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)",
+		1, 1, "create_ticket", "expired", 1, now.Add(-time.Hour),
+		2, 1, "create_ticket", "actual", 2, now.Add(time.Hour),
+		3, 2, "respond_to_ticket", "expired", 1, now.Add(-time.Minute),
+	)
+	s.NoError(err)
+
+	err = s.idempotencyKeysRepository.DeleteExpiredIdempotencyKeys(s.ctx, now)
+	s.NoError(err)
+
+	rows, err := s.connection.QueryContext(s.ctx, "SELECT id FROM idempotency_keys ORDER BY id")
+	s.NoError(err)
+
+	defer func() {
+		s.NoError(rows.Close())
+	}()
+
+	var ids []uint64
+	for rows.Next() {
+		var id uint64
+		s.NoError(rows.Scan(&id))
+		ids = append(ids, id)
+	}
+
+	s.NoError(rows.Err())
+	s.Equal([]uint64{2}, ids)
+}
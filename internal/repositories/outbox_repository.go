@@ -0,0 +1,312 @@
+package repositories
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/DKhorkov/libs/db"
+	"github.com/DKhorkov/libs/logging"
+	"github.com/DKhorkov/libs/tracing"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+)
+
+const (
+	outboxTableName                  = "outbox"
+	outboxSubjectColumnName          = "subject"
+	outboxPayloadColumnName          = "payload"
+	outboxAttemptsColumnName         = "attempts"
+	outboxLastErrorColumnName        = "last_error"
+	outboxNextAttemptAtColumnName    = "next_attempt_at"
+	outboxSentAtColumnName           = "sent_at"
+	outboxDeduplicationIDColumnName  = "deduplication_id"
+	outboxAttemptsIncrementStatement = outboxAttemptsColumnName + " + 1"
+)
+
+type OutboxRepository struct {
+	dbConnector   db.Connector
+	logger        logging.Logger
+	traceProvider tracing.Provider
+	spanConfig    tracing.SpanConfig
+}
+
+func NewOutboxRepository(
+	dbConnector db.Connector,
+	logger logging.Logger,
+	traceProvider tracing.Provider,
+	spanConfig tracing.SpanConfig,
+) *OutboxRepository {
+	return &OutboxRepository{
+		dbConnector:   dbConnector,
+		logger:        logger,
+		traceProvider: traceProvider,
+		spanConfig:    spanConfig,
+	}
+}
+
+// ClaimPendingOutboxMessages returns not sent messages, which next attempt time has already come, and
+// postpones their next attempt by leaseTimeout. Condition is checked again, while rows are locked for update,
+// so messages, claimed by concurrent relay, are skipped and each message is published by one relay at a time.
+// Messages, which have not been marked as sent or failed until lease expiration, are claimed again.
+func (repo *OutboxRepository) ClaimPendingOutboxMessages(
+	ctx context.Context,
+	limit uint64,
+	leaseTimeout time.Duration,
+) ([]entities.OutboxMessage, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	now := time.Now().UTC()
+	pendingCondition := sq.And{
+		sq.Eq{outboxSentAtColumnName: nil},
+		sq.LtOrEq{outboxNextAttemptAtColumnName: now},
+	}
+
+	pendingIDs := sq.
+		Select(idColumnName).
+		From(outboxTableName).
+		Where(pendingCondition).
+		OrderBy(fmt.Sprintf("%s %s", idColumnName, asc)).
+		Limit(limit)
+
+	stmt, params, err := sq.
+		Update(outboxTableName).
+		Where(
+			sq.And{
+				sq.Expr(idColumnName+" IN (?)", pendingIDs),
+				pendingCondition,
+			},
+		).
+		Set(outboxNextAttemptAtColumnName, now.Add(leaseTimeout)).
+		Set(updatedAtColumnName, now).
+		Suffix("RETURNING " + selectAllColumns).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
+
+	var messages []entities.OutboxMessage
+
+	for rows.Next() {
+		message := entities.OutboxMessage{}
+		columns := db.GetEntityColumns(&message) // Only pointer to use rows.Scan() successfully
+
+		err = rows.Scan(columns...)
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, message)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// RETURNING does not guarantee order, but messages should be published in order of their creation:
+	slices.SortFunc(messages, func(a, b entities.OutboxMessage) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return messages, nil
+}
+
+// DeleteSentOutboxMessages deletes messages, which have been sent before provided time,
+// so outbox table does not grow infinitely.
+func (repo *OutboxRepository) DeleteSentOutboxMessages(ctx context.Context, sentBefore time.Time) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Delete(outboxTableName).
+		Where(
+			sq.And{
+				sq.NotEq{outboxSentAtColumnName: nil},
+				sq.Lt{outboxSentAtColumnName: sentBefore},
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = connection.ExecContext(
+		ctx,
+		stmt,
+		params...,
+	)
+
+	return err
+}
+
+func (repo *OutboxRepository) MarkOutboxMessageAsSent(ctx context.Context, id uint64) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	now := time.Now().UTC()
+	stmt, params, err := sq.
+		Update(outboxTableName).
+		Where(sq.Eq{idColumnName: id}).
+		Set(outboxSentAtColumnName, now).
+		Set(outboxAttemptsColumnName, sq.Expr(outboxAttemptsIncrementStatement)).
+		Set(updatedAtColumnName, now).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = connection.ExecContext(
+		ctx,
+		stmt,
+		params...,
+	)
+
+	return err
+}
+
+// MarkOutboxMessageAsFailed saves publishing error and postpones next attempt to provided time.
+func (repo *OutboxRepository) MarkOutboxMessageAsFailed(
+	ctx context.Context,
+	id uint64,
+	lastError string,
+	nextAttemptAt time.Time,
+) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Update(outboxTableName).
+		Where(sq.Eq{idColumnName: id}).
+		Set(outboxLastErrorColumnName, lastError).
+		Set(outboxNextAttemptAtColumnName, nextAttemptAt).
+		Set(outboxAttemptsColumnName, sq.Expr(outboxAttemptsIncrementStatement)).
+		Set(updatedAtColumnName, time.Now().UTC()).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = connection.ExecContext(
+		ctx,
+		stmt,
+		params...,
+	)
+
+	return err
+}
+
+// buildOutboxMessages creates outbox messages for entity, which ID is known only after its insertion.
+func buildOutboxMessages(
+	id uint64,
+	builders []entities.OutboxMessageBuilder,
+) ([]entities.CreateOutboxMessageDTO, error) {
+	messages := make([]entities.CreateOutboxMessageDTO, 0, len(builders))
+	for _, build := range builders {
+		message, err := build(id)
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+// insertOutboxMessages saves messages within provided transaction to guarantee, that they will be
+// published only if business changes are committed.
+func insertOutboxMessages(
+	ctx context.Context,
+	transaction *sql.Tx,
+	messages []entities.CreateOutboxMessageDTO,
+) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	builder := sq.
+		Insert(outboxTableName).
+		Columns(outboxSubjectColumnName, outboxPayloadColumnName, outboxDeduplicationIDColumnName)
+	for _, message := range messages {
+		builder = builder.Values(message.Subject, message.Payload, message.DeduplicationID)
+	}
+
+	stmt, params, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = transaction.ExecContext(ctx, stmt, params...)
+
+	return err
+}
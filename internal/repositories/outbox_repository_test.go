@@ -0,0 +1,283 @@
+//go:build integration
+
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/pressly/goose/v3"
+
+	_ "github.com/mattn/go-sqlite3" // Must be imported for correct work
+
+	"github.com/DKhorkov/hmtm-tickets/internal/repositories"
+	"github.com/DKhorkov/libs/db"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/pointers"
+	"github.com/DKhorkov/libs/tracing"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOutboxRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxRepositoryTestSuite))
+}
+
+type OutboxRepositoryTestSuite struct {
+	suite.Suite
+
+	cwd              string
+	ctx              context.Context
+	dbConnector      db.Connector
+	connection       *sql.Conn
+	outboxRepository *repositories.OutboxRepository
+	logger           *mocklogging.MockLogger
+	traceProvider    *mocktracing.MockProvider
+	spanConfig       tracing.SpanConfig
+}
+
+func (s *OutboxRepositoryTestSuite) SetupSuite() {
+	s.NoError(goose.SetDialect(driver))
+
+	ctrl := gomock.NewController(s.T())
+	s.ctx = context.Background()
+	s.logger = mocklogging.NewMockLogger(ctrl)
+	dbConnector, err := db.New(dsn, driver, s.logger)
+	s.NoError(err)
+
+	cwd, err := os.Getwd()
+	s.NoError(err)
+
+	s.cwd = cwd
+	s.dbConnector = dbConnector
+	s.traceProvider = mocktracing.NewMockProvider(ctrl)
+	s.spanConfig = tracing.SpanConfig{}
+	s.outboxRepository = repositories.NewOutboxRepository(s.dbConnector, s.logger, s.traceProvider, s.spanConfig)
+}
+
+func (s *OutboxRepositoryTestSuite) SetupTest() {
+	s.NoError(
+		goose.Up(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+		),
+	)
+
+	connection, err := s.dbConnector.Connection(s.ctx)
+	s.NoError(err)
+
+	s.connection = connection
+}
+
+func (s *OutboxRepositoryTestSuite) TearDownTest() {
+	s.NoError(
+		goose.DownTo(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+			gooseZeroVersion,
+		),
+	)
+
+	s.NoError(s.connection.Close())
+}
+
+func (s *OutboxRepositoryTestSuite) TearDownSuite() {
+	s.NoError(s.dbConnector.Close())
+}
+
+func (s *OutboxRepositoryTestSuite) TestClaimPendingOutboxMessages() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	now := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO outbox "+
+			"(id, subject, payload, next_attempt_at, sent_at, created_at, updated_at, deduplication_id) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?)",
+		1, "ticket-deleted", []byte("{}"), now.Add(-time.Minute), nil, now, now, "ticket-deleted:1:1",
+		2, "ticket-updated", []byte("{}"), now.Add(-time.Minute), now, now, now, nil, // already sent
+		3, "ticket-updated", []byte("{}"), now.Add(time.Hour), nil, now, now, nil, // postponed
+	)
+	s.NoError(err)
+
+	messages, err := s.outboxRepository.ClaimPendingOutboxMessages(s.ctx, 10, time.Minute)
+	s.NoError(err)
+	s.Len(messages, 1)
+	s.Equal(uint64(1), messages[0].ID)
+	s.Equal("ticket-deleted", messages[0].Subject)
+	s.Equal([]byte("{}"), messages[0].Payload)
+	s.Equal(pointers.New("ticket-deleted:1:1"), messages[0].DeduplicationID)
+	s.Nil(messages[0].SentAt)
+	s.True(messages[0].NextAttemptAt.After(now))
+}
+
+func (s *OutboxRepositoryTestSuite) TestClaimPendingOutboxMessagesAlreadyClaimed() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(2)
+
+	now := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO outbox (id, subject, payload, next_attempt_at, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		1, "ticket-deleted", []byte("{}"), now.Add(-time.Minute), now, now,
+	)
+	s.NoError(err)
+
+	messages, err := s.outboxRepository.ClaimPendingOutboxMessages(s.ctx, 10, time.Minute)
+	s.NoError(err)
+	s.Len(messages, 1)
+
+	// Message is leased by first claim, so it is not claimed again until lease expiration:
+	messages, err = s.outboxRepository.ClaimPendingOutboxMessages(s.ctx, 10, time.Minute)
+	s.NoError(err)
+	s.Empty(messages)
+}
+
+func (s *OutboxRepositoryTestSuite) TestDeleteSentOutboxMessages() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	now := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO outbox (id, subject, payload, next_attempt_at, sent_at, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?)",
+		1, "ticket-deleted", []byte("{}"), now, now.Add(-2*time.Hour), now, now, // sent before retention period
+		2, "ticket-updated", []byte("{}"), now, now.Add(-time.Minute), now, now, // sent recently
+		3, "ticket-updated", []byte("{}"), now, nil, now, now, // not sent
+	)
+	s.NoError(err)
+
+	err = s.outboxRepository.DeleteSentOutboxMessages(s.ctx, now.Add(-time.Hour))
+	s.NoError(err)
+
+	rows, err := s.connection.QueryContext(s.ctx, "SELECT id FROM outbox ORDER BY id")
+	s.NoError(err)
+
+	defer func() {
+		s.NoError(rows.Close())
+	}()
+
+	var ids []uint64
+	for rows.Next() {
+		var id uint64
+		s.NoError(rows.Scan(&id))
+		ids = append(ids, id)
+	}
+
+	s.NoError(rows.Err())
+	s.Equal([]uint64{2, 3}, ids)
+}
+
+func (s *OutboxRepositoryTestSuite) TestClaimPendingOutboxMessagesWithLimit() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	now := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO outbox (id, subject, payload, next_attempt_at, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)",
+		1, "ticket-deleted", []byte("{}"), now.Add(-time.Minute), now, now,
+		2, "ticket-updated", []byte("{}"), now.Add(-time.Minute), now, now,
+	)
+	s.NoError(err)
+
+	messages, err := s.outboxRepository.ClaimPendingOutboxMessages(s.ctx, 1, time.Minute)
+	s.NoError(err)
+	s.Len(messages, 1)
+	s.Equal(uint64(1), messages[0].ID)
+}
+
+func (s *OutboxRepositoryTestSuite) TestMarkOutboxMessageAsSent() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	now := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO outbox (id, subject, payload, next_attempt_at, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		1, "ticket-deleted", []byte("{}"), now, now, now,
+	)
+	s.NoError(err)
+
+	err = s.outboxRepository.MarkOutboxMessageAsSent(s.ctx, 1)
+	s.NoError(err)
+
+	var (
+		attempts uint32
+		sentAt   sql.NullTime
+	)
+
+	s.NoError(
+		s.connection.QueryRowContext(
+			s.ctx,
+			"SELECT attempts, sent_at FROM outbox WHERE id = ?",
+			1,
+		).Scan(&attempts, &sentAt),
+	)
+	s.Equal(uint32(1), attempts)
+	s.True(sentAt.Valid)
+}
+
+func (s *OutboxRepositoryTestSuite) TestMarkOutboxMessageAsFailed() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	now := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO outbox (id, subject, payload, next_attempt_at, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		1, "ticket-deleted", []byte("{}"), now, now, now,
+	)
+	s.NoError(err)
+
+	nextAttemptAt := now.Add(time.Minute)
+	err = s.outboxRepository.MarkOutboxMessageAsFailed(s.ctx, 1, "nats is unavailable", nextAttemptAt)
+	s.NoError(err)
+
+	var (
+		attempts  uint32
+		lastError sql.NullString
+		sentAt    sql.NullTime
+	)
+
+	s.NoError(
+		s.connection.QueryRowContext(
+			s.ctx,
+			"SELECT attempts, last_error, sent_at FROM outbox WHERE id = ?",
+			1,
+		).Scan(&attempts, &lastError, &sentAt),
+	)
+	s.Equal(uint32(1), attempts)
+	s.Equal("nats is unavailable", lastError.String)
+	s.False(sentAt.Valid)
+}
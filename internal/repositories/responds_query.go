@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+)
+
+// respondsQuery describes set of Responds of one Ticket or one Master to be selected or counted. List and count
+// queries are built from the same conditions, so list results will never disagree with count.
+type respondsQuery struct {
+	scope   sq.Eq
+	filters *entities.RespondsFilters
+}
+
+func newTicketRespondsQuery(ticketID uint64, filters *entities.RespondsFilters) respondsQuery {
+	return respondsQuery{scope: sq.Eq{ticketIDColumnName: ticketID}, filters: filters}
+}
+
+func newMasterRespondsQuery(masterID uint64, filters *entities.RespondsFilters) respondsQuery {
+	return respondsQuery{scope: sq.Eq{masterIDColumnName: masterID}, filters: filters}
+}
+
+// selectBuilder returns builder for selecting Responds page with requested order. Cursor pagination
+// is not supported for Responds, so only limit and offset are applied.
+func (query respondsQuery) selectBuilder(pagination *entities.Pagination) sq.SelectBuilder {
+	builder := query.where(
+		sq.
+			Select(selectAllColumns).
+			From(respondsTableName),
+	)
+
+	if query.filters != nil {
+		for _, sort := range query.filters.Sort {
+			if orderBy, ok := respondsSortOrderBy(sort); ok {
+				builder = builder.OrderBy(orderBy)
+			}
+		}
+	}
+
+	// Newest Responds go first by default. ID is also used as tiebreaker to make order stable between pages:
+	builder = builder.OrderBy(fmt.Sprintf("%s %s", idColumnName, desc))
+
+	if pagination != nil && pagination.Limit != nil {
+		builder = builder.Limit(*pagination.Limit)
+	}
+
+	if pagination != nil && pagination.Offset != nil {
+		builder = builder.Offset(*pagination.Offset)
+	}
+
+	return builder.PlaceholderFormat(sq.Dollar)
+}
+
+func (query respondsQuery) countBuilder() sq.SelectBuilder {
+	return query.where(
+		sq.
+			Select(selectCount).
+			From(respondsTableName),
+	).PlaceholderFormat(sq.Dollar)
+}
+
+func (query respondsQuery) where(builder sq.SelectBuilder) sq.SelectBuilder {
+	for _, condition := range query.conditions() {
+		builder = builder.Where(condition)
+	}
+
+	return builder
+}
+
+func (query respondsQuery) conditions() []sq.Sqlizer {
+	conditions := []sq.Sqlizer{query.scope}
+
+	filters := query.filters
+	if filters == nil {
+		return conditions
+	}
+
+	if filters.PriceFloor != nil {
+		conditions = append(conditions, sq.GtOrEq{respondPriceColumnName: *filters.PriceFloor})
+	}
+
+	if filters.PriceCeil != nil {
+		conditions = append(conditions, sq.LtOrEq{respondPriceColumnName: *filters.PriceCeil})
+	}
+
+	if len(filters.Statuses) > 0 {
+		conditions = append(conditions, sq.Eq{respondStatusColumnName: filters.Statuses})
+	}
+
+	if filters.CreatedAtFrom != nil {
+		conditions = append(conditions, sq.GtOrEq{createdAtColumnName: *filters.CreatedAtFrom})
+	}
+
+	if filters.CreatedAtTo != nil {
+		conditions = append(conditions, sq.Lt{createdAtColumnName: *filters.CreatedAtTo})
+	}
+
+	return conditions
+}
+
+// respondsSortOrderBy returns ORDER BY expression for provided sort key. Unknown sort fields are ignored.
+func respondsSortOrderBy(sort entities.RespondsSort) (string, bool) {
+	direction := asc
+	if sort.Direction == entities.SortDirectionDesc {
+		direction = desc
+	}
+
+	switch sort.Field {
+	case entities.RespondsSortFieldPrice:
+		return fmt.Sprintf("%s %s", respondPriceColumnName, direction), true
+	case entities.RespondsSortFieldCreatedAt:
+		return fmt.Sprintf("%s %s", createdAtColumnName, direction), true
+	default:
+		return "", false
+	}
+}
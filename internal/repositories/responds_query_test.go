@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DKhorkov/libs/pointers"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+)
+
+func TestRespondsQuery_Filters(t *testing.T) {
+	createdAtFrom := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	createdAtTo := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		query          respondsQuery
+		expectedSelect string
+		expectedCount  string
+		expectedParams []any
+	}{
+		{
+			name:           "ticket responds without filters",
+			query:          newTicketRespondsQuery(1, nil),
+			expectedSelect: "SELECT * FROM responds WHERE ticket_id = $1 ORDER BY id DESC",
+			expectedCount:  "SELECT COUNT(*) FROM responds WHERE ticket_id = $1",
+			expectedParams: []any{uint64(1)},
+		},
+		{
+			name: "master responds with all filters",
+			query: newMasterRespondsQuery(2, &entities.RespondsFilters{
+				PriceFloor:    pointers.New[float32](100),
+				PriceCeil:     pointers.New[float32](500),
+				Statuses:      []entities.RespondStatus{entities.RespondStatusPending, entities.RespondStatusAccepted},
+				CreatedAtFrom: &createdAtFrom,
+				CreatedAtTo:   &createdAtTo,
+			}),
+			expectedSelect: "SELECT * FROM responds WHERE master_id = $1 AND price >= $2 AND price <= $3 " +
+				"AND status IN ($4,$5) AND created_at >= $6 AND created_at < $7 ORDER BY id DESC",
+			expectedCount: "SELECT COUNT(*) FROM responds WHERE master_id = $1 AND price >= $2 AND price <= $3 " +
+				"AND status IN ($4,$5) AND created_at >= $6 AND created_at < $7",
+			expectedParams: []any{
+				uint64(2),
+				float32(100),
+				float32(500),
+				entities.RespondStatusPending,
+				entities.RespondStatusAccepted,
+				createdAtFrom,
+				createdAtTo,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stmt, params, err := tc.query.selectBuilder(nil).ToSql()
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSelect, stmt)
+			require.Equal(t, tc.expectedParams, params)
+
+			stmt, params, err = tc.query.countBuilder().ToSql()
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedCount, stmt)
+			require.Equal(t, tc.expectedParams, params)
+		})
+	}
+}
+
+func TestRespondsQuery_SortAndPagination(t *testing.T) {
+	testCases := []struct {
+		name           string
+		filters        *entities.RespondsFilters
+		pagination     *entities.Pagination
+		expectedSelect string
+	}{
+		{
+			name: "price ascending",
+			filters: &entities.RespondsFilters{
+				Sort: []entities.RespondsSort{
+					{Field: entities.RespondsSortFieldPrice, Direction: entities.SortDirectionAsc},
+				},
+			},
+			expectedSelect: "SELECT * FROM responds WHERE ticket_id = $1 ORDER BY price ASC, id DESC",
+		},
+		{
+			name: "price descending then newest",
+			filters: &entities.RespondsFilters{
+				Sort: []entities.RespondsSort{
+					{Field: entities.RespondsSortFieldPrice, Direction: entities.SortDirectionDesc},
+					{Field: entities.RespondsSortFieldCreatedAt, Direction: entities.SortDirectionDesc},
+				},
+			},
+			expectedSelect: "SELECT * FROM responds WHERE ticket_id = $1 ORDER BY price DESC, created_at DESC, id DESC",
+		},
+		{
+			name: "unknown field is ignored",
+			filters: &entities.RespondsFilters{
+				Sort: []entities.RespondsSort{{Field: "unknown", Direction: entities.SortDirectionAsc}},
+			},
+			expectedSelect: "SELECT * FROM responds WHERE ticket_id = $1 ORDER BY id DESC",
+		},
+		{
+			name: "limit and offset",
+			pagination: &entities.Pagination{
+				Limit:  pointers.New[uint64](10),
+				Offset: pointers.New[uint64](20),
+			},
+			expectedSelect: "SELECT * FROM responds WHERE ticket_id = $1 ORDER BY id DESC LIMIT 10 OFFSET 20",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stmt, params, err := newTicketRespondsQuery(1, tc.filters).selectBuilder(tc.pagination).ToSql()
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSelect, stmt)
+			require.Equal(t, []any{uint64(1)}, params)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package repositories
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/DKhorkov/libs/db"
@@ -10,7 +12,9 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
 	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
 )
 
 const (
@@ -18,13 +22,16 @@ const (
 	masterIDColumnName       = "master_id"
 	respondPriceColumnName   = "price"
 	respondCommentColumnName = "comment"
+	respondStatusColumnName  = "status"
+	returningTicketIDSuffix  = "RETURNING ticket_id"
 )
 
 type RespondsRepository struct {
-	dbConnector   db.Connector
-	logger        logging.Logger
-	traceProvider tracing.Provider
-	spanConfig    tracing.SpanConfig
+	dbConnector       db.Connector
+	logger            logging.Logger
+	traceProvider     tracing.Provider
+	spanConfig        tracing.SpanConfig
+	idempotencyConfig config.IdempotencyConfig
 }
 
 func NewRespondsRepository(
@@ -32,18 +39,23 @@ func NewRespondsRepository(
 	logger logging.Logger,
 	traceProvider tracing.Provider,
 	spanConfig tracing.SpanConfig,
+	idempotencyConfig config.IdempotencyConfig,
 ) *RespondsRepository {
 	return &RespondsRepository{
-		dbConnector:   dbConnector,
-		logger:        logger,
-		traceProvider: traceProvider,
-		spanConfig:    spanConfig,
+		dbConnector:       dbConnector,
+		logger:            logger,
+		traceProvider:     traceProvider,
+		spanConfig:        spanConfig,
+		idempotencyConfig: idempotencyConfig,
 	}
 }
 
+// RespondToTicket creates Respond and saves outbox messages, built for created Respond, and idempotency key,
+// if it was provided, within single transaction.
 func (repo *RespondsRepository) RespondToTicket(
 	ctx context.Context,
 	respondData entities.RespondToTicketDTO,
+	outboxMessageBuilders ...entities.OutboxMessageBuilder,
 ) (uint64, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
@@ -51,12 +63,17 @@ func (repo *RespondsRepository) RespondToTicket(
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	transaction, err := repo.dbConnector.Transaction(ctx)
 	if err != nil {
 		return 0, err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
 
 	stmt, params, err := sq.
 		Insert(respondsTableName).
@@ -80,13 +97,64 @@ func (repo *RespondsRepository) RespondToTicket(
 	}
 
 	var respondID uint64
-	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&respondID); err != nil {
+	if err = transaction.QueryRowContext(ctx, stmt, params...).Scan(&respondID); err != nil {
+		// Master can respond to Ticket only once, which is guaranteed by unique index on (ticket_id, master_id):
+		if isUniqueViolation(err) {
+			return 0, &customerrors.RespondAlreadyExistsError{BaseErr: err}
+		}
+
+		return 0, err
+	}
+
+	err = insertIdempotencyKey(
+		ctx,
+		transaction,
+		respondToTicketIdempotentOperation,
+		respondData.IdempotencyKey,
+		respondID,
+		repo.idempotencyConfig.KeysTTL,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	outboxMessages, err := buildOutboxMessages(respondID, outboxMessageBuilders)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = insertOutboxMessages(ctx, transaction, outboxMessages); err != nil {
+		return 0, err
+	}
+
+	if err = transaction.Commit(); err != nil {
 		return 0, err
 	}
 
 	return respondID, nil
 }
 
+// GetRespondIDByIdempotencyKey returns ID of Respond, created by request with provided idempotency key.
+func (repo *RespondsRepository) GetRespondIDByIdempotencyKey(
+	ctx context.Context,
+	idempotencyKey entities.IdempotencyKeyDTO,
+) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	return getIdempotentResourceID(ctx, connection, respondToTicketIdempotentOperation, idempotencyKey)
+}
+
 func (repo *RespondsRepository) GetRespondByID(
 	ctx context.Context,
 	id uint64,
@@ -124,16 +192,19 @@ func (repo *RespondsRepository) GetRespondByID(
 	return respond, nil
 }
 
-func (repo *RespondsRepository) GetTicketResponds(
-	ctx context.Context,
-	ticketID uint64,
-) ([]entities.Respond, error) {
+// GetRespondsByIDs returns Responds for provided IDs with one query. Order of Responds is not guaranteed
+// and not found IDs are skipped.
+func (repo *RespondsRepository) GetRespondsByIDs(ctx context.Context, ids []uint64) ([]entities.Respond, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
 	connection, err := repo.dbConnector.Connection(ctx)
 	if err != nil {
 		return nil, err
@@ -144,8 +215,7 @@ func (repo *RespondsRepository) GetTicketResponds(
 	stmt, params, err := sq.
 		Select(selectAllColumns).
 		From(respondsTableName).
-		Where(sq.Eq{ticketIDColumnName: ticketID}).
-		OrderBy(fmt.Sprintf("%s %s", idColumnName, desc)).
+		Where(sq.Eq{idColumnName: ids}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -193,9 +263,107 @@ func (repo *RespondsRepository) GetTicketResponds(
 	return responds, nil
 }
 
+func (repo *RespondsRepository) GetTicketResponds(
+	ctx context.Context,
+	ticketID uint64,
+	pagination *entities.Pagination,
+	filters *entities.RespondsFilters,
+) ([]entities.Respond, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := newTicketRespondsQuery(ticketID, filters).selectBuilder(pagination).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
+
+	var responds []entities.Respond
+
+	for rows.Next() {
+		respond := entities.Respond{}
+		columns := db.GetEntityColumns(&respond) // Only pointer to use rows.Scan() successfully
+
+		err = rows.Scan(columns...)
+		if err != nil {
+			return nil, err
+		}
+
+		responds = append(responds, respond)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return responds, nil
+}
+
+func (repo *RespondsRepository) CountTicketResponds(
+	ctx context.Context,
+	ticketID uint64,
+	filters *entities.RespondsFilters,
+) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := newTicketRespondsQuery(ticketID, filters).countBuilder().ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
 func (repo *RespondsRepository) GetMasterResponds(
 	ctx context.Context,
 	masterID uint64,
+	pagination *entities.Pagination,
+	filters *entities.RespondsFilters,
 ) ([]entities.Respond, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
@@ -210,13 +378,7 @@ func (repo *RespondsRepository) GetMasterResponds(
 
 	defer db.CloseConnectionContext(ctx, connection, repo.logger)
 
-	stmt, params, err := sq.
-		Select(selectAllColumns).
-		From(respondsTableName).
-		Where(sq.Eq{masterIDColumnName: masterID}).
-		OrderBy(fmt.Sprintf("%s %s", idColumnName, desc)).
-		PlaceholderFormat(sq.Dollar).
-		ToSql()
+	stmt, params, err := newMasterRespondsQuery(masterID, filters).selectBuilder(pagination).ToSql()
 	if err != nil {
 		return nil, err
 	}
@@ -262,9 +424,43 @@ func (repo *RespondsRepository) GetMasterResponds(
 	return responds, nil
 }
 
+func (repo *RespondsRepository) CountMasterResponds(
+	ctx context.Context,
+	masterID uint64,
+	filters *entities.RespondsFilters,
+) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := newMasterRespondsQuery(masterID, filters).countBuilder().ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// UpdateRespond updates Respond and saves provided outbox messages within single transaction.
+// Respond is updated only if it is pending and has expected version.
 func (repo *RespondsRepository) UpdateRespond(
 	ctx context.Context,
 	respondData entities.UpdateRespondDTO,
+	outboxMessages ...entities.CreateOutboxMessageDTO,
 ) error {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
@@ -272,18 +468,31 @@ func (repo *RespondsRepository) UpdateRespond(
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	transaction, err := repo.dbConnector.Transaction(ctx)
 	if err != nil {
 		return err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
 
 	builder := sq.
 		Update(respondsTableName).
-		Where(sq.Eq{idColumnName: respondData.ID}).
+		Where(
+			sq.And{
+				sq.Eq{idColumnName: respondData.ID},
+				sq.Eq{versionColumnName: respondData.ExpectedVersion},
+				sq.Eq{respondStatusColumnName: entities.RespondStatusPending},
+			},
+		).
 		Set(respondCommentColumnName, respondData.Comment).
 		// Update every time, because field is nullable
+		Set(updatedAtColumnName, sq.Expr("CURRENT_TIMESTAMP")).
+		Set(versionColumnName, sq.Expr(versionIncrementStatement)).
 		PlaceholderFormat(sq.Dollar)
 	// pq postgres driver works only with $ placeholders
 
@@ -297,28 +506,89 @@ func (repo *RespondsRepository) UpdateRespond(
 		return err
 	}
 
-	_, err = connection.ExecContext(
-		ctx,
-		stmt,
-		params...,
-	)
+	result, err := transaction.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return err
+	}
 
-	return err
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repo.respondUpdateConflict(ctx, transaction, respondData)
+	}
+
+	if err = insertOutboxMessages(ctx, transaction, outboxMessages); err != nil {
+		return err
+	}
+
+	return transaction.Commit()
 }
 
-func (repo *RespondsRepository) DeleteRespond(ctx context.Context, id uint64) error {
+// respondUpdateConflict explains, why Respond has not been updated: Respond, which is not pending, can not be
+// changed regardless of its version, otherwise it has been modified or deleted since expected version.
+func (repo *RespondsRepository) respondUpdateConflict(
+	ctx context.Context,
+	transaction *sql.Tx,
+	respondData entities.UpdateRespondDTO,
+) error {
+	stmt, params, err := sq.
+		Select(respondStatusColumnName).
+		From(respondsTableName).
+		Where(sq.Eq{idColumnName: respondData.ID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var status entities.RespondStatus
+
+	err = transaction.QueryRowContext(ctx, stmt, params...).Scan(&status)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	if err == nil && status != entities.RespondStatusPending {
+		return &customerrors.RespondIsNotPendingError{
+			Message: fmt.Sprintf("respond with ID=%d is %s and can not be updated", respondData.ID, status),
+		}
+	}
+
+	return &customerrors.VersionConflictError{
+		Message: fmt.Sprintf(
+			"respond with ID=%d has been modified or deleted since version %d",
+			respondData.ID,
+			respondData.ExpectedVersion,
+		),
+	}
+}
+
+// DeleteRespond deletes Respond and saves provided outbox messages within single transaction.
+func (repo *RespondsRepository) DeleteRespond(
+	ctx context.Context,
+	id uint64,
+	outboxMessages ...entities.CreateOutboxMessageDTO,
+) error {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	transaction, err := repo.dbConnector.Transaction(ctx)
 	if err != nil {
 		return err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
 
 	stmt, params, err := sq.
 		Delete(respondsTableName).
@@ -329,6 +599,236 @@ func (repo *RespondsRepository) DeleteRespond(ctx context.Context, id uint64) er
 		return err
 	}
 
+	if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+		return err
+	}
+
+	if err = insertOutboxMessages(ctx, transaction, outboxMessages); err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+// AcceptRespond marks pending Respond as accepted, rejects all other pending Responds
+// for the same Ticket, moves Ticket out of open pool and saves provided outbox messages
+// within single transaction.
+func (repo *RespondsRepository) AcceptRespond(
+	ctx context.Context,
+	id uint64,
+	outboxMessages ...entities.CreateOutboxMessageDTO,
+) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := repo.dbConnector.Transaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	stmt, params, err := sq.
+		Update(respondsTableName).
+		Where(
+			sq.Eq{
+				idColumnName:            id,
+				respondStatusColumnName: entities.RespondStatusPending,
+			},
+		).
+		Set(respondStatusColumnName, entities.RespondStatusAccepted).
+		Set(updatedAtColumnName, sq.Expr("CURRENT_TIMESTAMP")).
+		Set(versionColumnName, sq.Expr(versionIncrementStatement)).
+		Suffix(returningTicketIDSuffix).
+		PlaceholderFormat(sq.Dollar). // pq postgres driver works only with $ placeholders
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	// Returns sql.ErrNoRows if Respond has been already processed by concurrent request:
+	var ticketID uint64
+	if err = transaction.QueryRowContext(ctx, stmt, params...).Scan(&ticketID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &customerrors.RespondIsNotPendingError{
+				Message: fmt.Sprintf("respond with ID=%d is not pending and can not be accepted", id),
+			}
+		}
+
+		return err
+	}
+
+	stmt, params, err = sq.
+		Update(respondsTableName).
+		Where(
+			sq.And{
+				sq.Eq{
+					ticketIDColumnName:      ticketID,
+					respondStatusColumnName: entities.RespondStatusPending,
+				},
+				sq.NotEq{idColumnName: id},
+			},
+		).
+		Set(respondStatusColumnName, entities.RespondStatusRejected).
+		Set(updatedAtColumnName, sq.Expr("CURRENT_TIMESTAMP")).
+		Set(versionColumnName, sq.Expr(versionIncrementStatement)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+		return err
+	}
+
+	// Ticket could have been moved out of open pool by concurrent request since it has been read:
+	stmt, params, err = sq.
+		Update(ticketsTableName).
+		Where(
+			sq.Eq{
+				idColumnName:           ticketID,
+				ticketStatusColumnName: entities.TicketStatusOpen,
+			},
+		).
+		Set(ticketStatusColumnName, entities.TicketStatusInProgress).
+		Set(updatedAtColumnName, sq.Expr("CURRENT_TIMESTAMP")).
+		Set(versionColumnName, sq.Expr(versionIncrementStatement)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := transaction.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &customerrors.TicketIsNotOpenError{
+			Message: fmt.Sprintf("ticket with ID=%d is not open and does not accept responds", ticketID),
+		}
+	}
+
+	if err = insertOutboxMessages(ctx, transaction, outboxMessages); err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+// UpdateRespondStatus updates status of pending Respond and saves provided outbox messages within
+// single transaction. Respond, which has been already processed by concurrent request, is not updated.
+func (repo *RespondsRepository) UpdateRespondStatus(
+	ctx context.Context,
+	id uint64,
+	status entities.RespondStatus,
+	outboxMessages ...entities.CreateOutboxMessageDTO,
+) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := repo.dbConnector.Transaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	stmt, params, err := sq.
+		Update(respondsTableName).
+		Where(
+			sq.Eq{
+				idColumnName:            id,
+				respondStatusColumnName: entities.RespondStatusPending,
+			},
+		).
+		Set(respondStatusColumnName, status).
+		Set(updatedAtColumnName, sq.Expr("CURRENT_TIMESTAMP")).
+		Set(versionColumnName, sq.Expr(versionIncrementStatement)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := transaction.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &customerrors.RespondIsNotPendingError{
+			Message: fmt.Sprintf("respond with ID=%d is not pending and can not be changed to %s", id, status),
+		}
+	}
+
+	if err = insertOutboxMessages(ctx, transaction, outboxMessages); err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+// WithdrawMasterResponds withdraws all pending Responds of Master. Operation is idempotent.
+func (repo *RespondsRepository) WithdrawMasterResponds(ctx context.Context, masterID uint64) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Update(respondsTableName).
+		Where(
+			sq.Eq{
+				masterIDColumnName:      masterID,
+				respondStatusColumnName: entities.RespondStatusPending,
+			},
+		).
+		Set(respondStatusColumnName, entities.RespondStatusWithdrawn).
+		Set(updatedAtColumnName, sq.Expr("CURRENT_TIMESTAMP")).
+		Set(versionColumnName, sq.Expr(versionIncrementStatement)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
 	_, err = connection.ExecContext(
 		ctx,
 		stmt,
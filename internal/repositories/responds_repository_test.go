@@ -12,7 +12,9 @@ import (
 
 	_ "github.com/mattn/go-sqlite3" // Must be imported for correct work
 
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
 	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
 	"github.com/DKhorkov/hmtm-tickets/internal/repositories"
 	"github.com/DKhorkov/libs/db"
 	mocklogging "github.com/DKhorkov/libs/logging/mocks"
@@ -65,7 +67,13 @@ func (s *RespondsRepositoryTestSuite) SetupSuite() {
 	s.dbConnector = dbConnector
 	s.traceProvider = mocktracing.NewMockProvider(ctrl)
 	s.spanConfig = tracing.SpanConfig{}
-	s.respondsRepository = repositories.NewRespondsRepository(s.dbConnector, s.logger, s.traceProvider, s.spanConfig)
+	s.respondsRepository = repositories.NewRespondsRepository(
+		s.dbConnector,
+		s.logger,
+		s.traceProvider,
+		s.spanConfig,
+		config.IdempotencyConfig{KeysTTL: time.Hour},
+	)
 }
 
 func (s *RespondsRepositoryTestSuite) SetupTest() {
@@ -123,6 +131,35 @@ func (s *RespondsRepositoryTestSuite) TestRespondToTicketSuccess() {
 	s.Zero(id)
 }
 
+func (s *RespondsRepositoryTestSuite) TestRespondToTicketAlreadyExists() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, comment, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, 150.75, "Test respond", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	respondData := entities.RespondToTicketDTO{
+		TicketID: 1,
+		MasterID: 2,
+		Price:    100.50,
+	}
+
+	id, err := s.respondsRepository.RespondToTicket(s.ctx, respondData)
+	s.Zero(id)
+
+	var respondAlreadyExistsError *customerrors.RespondAlreadyExistsError
+	s.ErrorAs(err, &respondAlreadyExistsError)
+}
+
 func (s *RespondsRepositoryTestSuite) TestGetRespondByIDExisting() {
 	s.traceProvider.
 		EXPECT().
@@ -164,6 +201,36 @@ func (s *RespondsRepositoryTestSuite) TestGetRespondByIDNonExisting() {
 	s.Nil(respond)
 }
 
+func (s *RespondsRepositoryTestSuite) TestGetRespondsByIDs() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, comment, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 1, 100.00, "Respond 1", createdAt, createdAt,
+		2, 1, 2, 200.00, "Respond 2", createdAt, createdAt,
+		3, 2, 1, 300.00, "Respond 3", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	responds, err := s.respondsRepository.GetRespondsByIDs(s.ctx, []uint64{3, 999, 1})
+	s.NoError(err)
+	s.Len(responds, 2)
+
+	respondsIDs := make([]uint64, len(responds))
+	for i, respond := range responds {
+		respondsIDs[i] = respond.ID
+	}
+
+	s.ElementsMatch([]uint64{1, 3}, respondsIDs)
+}
+
 func (s *RespondsRepositoryTestSuite) TestGetTicketRespondsWithExisting() {
 	s.traceProvider.
 		EXPECT().
@@ -184,7 +251,7 @@ func (s *RespondsRepositoryTestSuite) TestGetTicketRespondsWithExisting() {
 	)
 	s.NoError(err)
 
-	responds, err := s.respondsRepository.GetTicketResponds(s.ctx, ticketID)
+	responds, err := s.respondsRepository.GetTicketResponds(s.ctx, ticketID, nil, nil)
 	s.NoError(err)
 	s.NotEmpty(responds)
 	s.Equal(2, len(responds))
@@ -197,7 +264,7 @@ func (s *RespondsRepositoryTestSuite) TestGetTicketRespondsWithoutExisting() {
 		Return(context.Background(), mocktracing.NewMockSpan()).
 		Times(1)
 
-	responds, err := s.respondsRepository.GetTicketResponds(s.ctx, 999)
+	responds, err := s.respondsRepository.GetTicketResponds(s.ctx, 999, nil, nil)
 	s.NoError(err)
 	s.Empty(responds)
 }
@@ -218,11 +285,11 @@ func (s *RespondsRepositoryTestSuite) TestGetMasterRespondsWithExisting() {
 		"INSERT INTO responds (id, ticket_id, master_id, price, comment, created_at, updated_at) "+
 			"VALUES (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?)",
 		1, 1, masterID, 100.00, *comment1, createdAt, createdAt,
-		2, 1, masterID, 200.00, *comment2, createdAt, createdAt,
+		2, 2, masterID, 200.00, *comment2, createdAt, createdAt,
 	)
 	s.NoError(err)
 
-	responds, err := s.respondsRepository.GetMasterResponds(s.ctx, masterID)
+	responds, err := s.respondsRepository.GetMasterResponds(s.ctx, masterID, nil, nil)
 	s.NoError(err)
 	s.NotEmpty(responds)
 	s.Equal(2, len(responds))
@@ -235,11 +302,103 @@ func (s *RespondsRepositoryTestSuite) TestGetMasterRespondsWithoutExisting() {
 		Return(context.Background(), mocktracing.NewMockSpan()).
 		Times(1)
 
-	responds, err := s.respondsRepository.GetMasterResponds(s.ctx, 999)
+	responds, err := s.respondsRepository.GetMasterResponds(s.ctx, 999, nil, nil)
 	s.NoError(err)
 	s.Empty(responds)
 }
 
+func (s *RespondsRepositoryTestSuite) TestGetTicketRespondsWithFiltersAndPagination() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	ticketID := uint64(1)
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, status, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?)",
+		1, ticketID, 1, 300.00, entities.RespondStatusPending, createdAt, createdAt,
+		2, ticketID, 2, 100.00, entities.RespondStatusPending, createdAt, createdAt,
+		3, ticketID, 3, 200.00, entities.RespondStatusPending, createdAt, createdAt,
+		4, ticketID, 4, 150.00, entities.RespondStatusRejected, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	responds, err := s.respondsRepository.GetTicketResponds(
+		s.ctx,
+		ticketID,
+		&entities.Pagination{
+			Limit:  pointers.New[uint64](2),
+			Offset: pointers.New[uint64](1),
+		},
+		&entities.RespondsFilters{
+			Statuses: []entities.RespondStatus{entities.RespondStatusPending},
+			Sort: []entities.RespondsSort{
+				{Field: entities.RespondsSortFieldPrice, Direction: entities.SortDirectionAsc},
+			},
+		},
+	)
+	s.NoError(err)
+	s.Len(responds, 2)
+	s.Equal(uint64(3), responds[0].ID)
+	s.Equal(uint64(1), responds[1].ID)
+}
+
+func (s *RespondsRepositoryTestSuite) TestCountTicketResponds() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	ticketID := uint64(1)
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)",
+		1, ticketID, 1, 100.00, createdAt, createdAt,
+		2, ticketID, 2, 200.00, createdAt, createdAt,
+		3, 2, 1, 300.00, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	count, err := s.respondsRepository.CountTicketResponds(
+		s.ctx,
+		ticketID,
+		&entities.RespondsFilters{PriceFloor: pointers.New[float32](150)},
+	)
+	s.NoError(err)
+	s.Equal(uint64(1), count)
+}
+
+func (s *RespondsRepositoryTestSuite) TestCountMasterResponds() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	masterID := uint64(1)
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)",
+		1, 1, masterID, 100.00, createdAt, createdAt,
+		2, 2, masterID, 200.00, createdAt, createdAt,
+		3, 1, 2, 300.00, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	count, err := s.respondsRepository.CountMasterResponds(s.ctx, masterID, nil)
+	s.NoError(err)
+	s.Equal(uint64(2), count)
+}
+
 func (s *RespondsRepositoryTestSuite) TestUpdateRespondSuccess() {
 	s.traceProvider.
 		EXPECT().
@@ -247,6 +406,12 @@ func (s *RespondsRepositoryTestSuite) TestUpdateRespondSuccess() {
 		Return(context.Background(), mocktracing.NewMockSpan()).
 		Times(1)
 
+	// Rollback after successful commit:
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
 	createdAt := time.Now().UTC()
 	_, err := s.connection.ExecContext(
 		s.ctx,
@@ -259,9 +424,10 @@ func (s *RespondsRepositoryTestSuite) TestUpdateRespondSuccess() {
 	newPrice := pointers.New[float32](200.50)
 	newComment := pointers.New("Updated comment")
 	respondData := entities.UpdateRespondDTO{
-		ID:      1,
-		Price:   newPrice,
-		Comment: newComment,
+		ID:              1,
+		Price:           newPrice,
+		Comment:         newComment,
+		ExpectedVersion: 1,
 	}
 	err = s.respondsRepository.UpdateRespond(s.ctx, respondData)
 	s.NoError(err)
@@ -287,6 +453,73 @@ func (s *RespondsRepositoryTestSuite) TestUpdateRespondSuccess() {
 	s.Equal(*newComment, comment.String)
 }
 
+func (s *RespondsRepositoryTestSuite) TestUpdateRespondVersionConflict() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, comment, created_at, updated_at, version) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, 100.00, "Old comment", createdAt, createdAt, 3,
+	)
+	s.NoError(err)
+
+	respondData := entities.UpdateRespondDTO{
+		ID:              1,
+		Comment:         pointers.New("Lost update"),
+		ExpectedVersion: 2,
+	}
+	err = s.respondsRepository.UpdateRespond(s.ctx, respondData)
+	s.Error(err)
+	s.IsType(&customerrors.VersionConflictError{}, err)
+
+	var comment string
+	var version uint64
+	row := s.connection.QueryRowContext(s.ctx, "SELECT comment, version FROM responds WHERE id = ?", 1)
+	s.NoError(row.Scan(&comment, &version))
+	s.Equal("Old comment", comment)
+	s.Equal(uint64(3), version)
+}
+
+func (s *RespondsRepositoryTestSuite) TestUpdateRespondNotPending() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, comment, status, created_at, updated_at, version) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, 100.00, "Old comment", entities.RespondStatusAccepted, createdAt, createdAt, 2,
+	)
+	s.NoError(err)
+
+	// Version matches, but Respond, which is not pending, can not be changed:
+	respondData := entities.UpdateRespondDTO{
+		ID:              1,
+		Price:           pointers.New[float32](50),
+		ExpectedVersion: 2,
+	}
+	err = s.respondsRepository.UpdateRespond(s.ctx, respondData)
+	s.Error(err)
+	s.IsType(&customerrors.RespondIsNotPendingError{}, err)
+
+	var price float32
+	var version uint64
+	row := s.connection.QueryRowContext(s.ctx, "SELECT price, version FROM responds WHERE id = ?", 1)
+	s.NoError(row.Scan(&price, &version))
+	s.InEpsilon(float32(100), price, 0.001)
+	s.Equal(uint64(2), version)
+}
+
 func (s *RespondsRepositoryTestSuite) TestUpdateRespondNoPrice() {
 	s.traceProvider.
 		EXPECT().
@@ -294,6 +527,12 @@ func (s *RespondsRepositoryTestSuite) TestUpdateRespondNoPrice() {
 		Return(context.Background(), mocktracing.NewMockSpan()).
 		Times(1)
 
+	// Rollback after successful commit:
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
 	createdAt := time.Now().UTC()
 	_, err := s.connection.ExecContext(
 		s.ctx,
@@ -305,9 +544,10 @@ func (s *RespondsRepositoryTestSuite) TestUpdateRespondNoPrice() {
 
 	newComment := pointers.New("Updated comment")
 	respondData := entities.UpdateRespondDTO{
-		ID:      1,
-		Price:   nil,
-		Comment: newComment,
+		ID:              1,
+		Price:           nil,
+		Comment:         newComment,
+		ExpectedVersion: 1,
 	}
 	err = s.respondsRepository.UpdateRespond(s.ctx, respondData)
 	s.NoError(err)
@@ -340,6 +580,12 @@ func (s *RespondsRepositoryTestSuite) TestUpdateRespondNullComment() {
 		Return(context.Background(), mocktracing.NewMockSpan()).
 		Times(1)
 
+	// Rollback after successful commit:
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
 	createdAt := time.Now().UTC()
 	_, err := s.connection.ExecContext(
 		s.ctx,
@@ -351,9 +597,10 @@ func (s *RespondsRepositoryTestSuite) TestUpdateRespondNullComment() {
 
 	newPrice := pointers.New[float32](200.50)
 	respondData := entities.UpdateRespondDTO{
-		ID:      1,
-		Price:   newPrice,
-		Comment: nil,
+		ID:              1,
+		Price:           newPrice,
+		Comment:         nil,
+		ExpectedVersion: 1,
 	}
 	err = s.respondsRepository.UpdateRespond(s.ctx, respondData)
 	s.NoError(err)
@@ -385,6 +632,12 @@ func (s *RespondsRepositoryTestSuite) TestDeleteRespondSuccess() {
 		Return(context.Background(), mocktracing.NewMockSpan()).
 		Times(1)
 
+	// Rollback after successful commit:
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
 	createdAt := time.Now().UTC()
 	_, err := s.connection.ExecContext(
 		s.ctx,
@@ -394,9 +647,19 @@ func (s *RespondsRepositoryTestSuite) TestDeleteRespondSuccess() {
 	)
 	s.NoError(err)
 
-	err = s.respondsRepository.DeleteRespond(s.ctx, 1)
+	err = s.respondsRepository.DeleteRespond(
+		s.ctx,
+		1,
+		entities.CreateOutboxMessageDTO{Subject: "respond-deleted", Payload: []byte(`{"respondID":1}`)},
+	)
 	s.NoError(err)
 
+	var subject string
+	s.NoError(
+		s.connection.QueryRowContext(s.ctx, "SELECT subject FROM outbox WHERE sent_at IS NULL").Scan(&subject),
+	)
+	s.Equal("respond-deleted", subject)
+
 	// Проверка, что запись удалена
 	rows, err := s.connection.QueryContext(
 		s.ctx,
@@ -411,3 +674,399 @@ func (s *RespondsRepositoryTestSuite) TestDeleteRespondSuccess() {
 
 	s.False(rows.Next())
 }
+
+func (s *RespondsRepositoryTestSuite) TestAcceptRespondSuccess() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	// Rollback after successful commit:
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets (id, user_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Ticket", "Desc", nil, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, comment, created_at, updated_at, status) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, 100.00, nil, createdAt, createdAt, entities.RespondStatusPending,
+		2, 1, 3, 150.00, nil, createdAt, createdAt, entities.RespondStatusPending,
+		3, 1, 4, 200.00, nil, createdAt, createdAt, entities.RespondStatusWithdrawn,
+	)
+	s.NoError(err)
+
+	err = s.respondsRepository.AcceptRespond(s.ctx, 1)
+	s.NoError(err)
+
+	rows, err := s.connection.QueryContext(
+		s.ctx,
+		"SELECT id, status FROM responds ORDER BY id",
+	)
+	s.NoError(err)
+
+	defer func() {
+		s.NoError(rows.Close())
+	}()
+
+	statuses := make(map[uint64]string)
+	for rows.Next() {
+		var id uint64
+		var status string
+		s.NoError(rows.Scan(&id, &status))
+		statuses[id] = status
+	}
+
+	s.NoError(rows.Err())
+	s.Equal(
+		map[uint64]string{
+			1: string(entities.RespondStatusAccepted),
+			2: string(entities.RespondStatusRejected),
+			3: string(entities.RespondStatusWithdrawn),
+		},
+		statuses,
+	)
+
+	var ticketStatus string
+	s.NoError(
+		s.connection.QueryRowContext(s.ctx, "SELECT status FROM tickets WHERE id = ?", 1).Scan(&ticketStatus),
+	)
+	s.Equal(string(entities.TicketStatusInProgress), ticketStatus)
+}
+
+func (s *RespondsRepositoryTestSuite) TestAcceptRespondNotPending() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, comment, created_at, updated_at, status) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, 100.00, nil, createdAt, createdAt, entities.RespondStatusRejected,
+	)
+	s.NoError(err)
+
+	err = s.respondsRepository.AcceptRespond(s.ctx, 1)
+
+	var respondIsNotPendingError *customerrors.RespondIsNotPendingError
+	s.ErrorAs(err, &respondIsNotPendingError)
+}
+
+func (s *RespondsRepositoryTestSuite) TestAcceptRespondTicketIsNotOpen() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets (id, user_id, category_id, name, description, price, quantity, created_at, updated_at, "+
+			"status) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Ticket", "Desc", nil, 1, createdAt, createdAt, entities.TicketStatusCancelled,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, comment, created_at, updated_at, status) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, 100.00, nil, createdAt, createdAt, entities.RespondStatusPending,
+	)
+	s.NoError(err)
+
+	err = s.respondsRepository.AcceptRespond(s.ctx, 1)
+
+	var ticketIsNotOpenError *customerrors.TicketIsNotOpenError
+	s.ErrorAs(err, &ticketIsNotOpenError)
+
+	// Transaction is rolled back, so Respond is still pending and Ticket is still cancelled:
+	var respondStatus, ticketStatus string
+	s.NoError(
+		s.connection.QueryRowContext(s.ctx, "SELECT status FROM responds WHERE id = ?", 1).Scan(&respondStatus),
+	)
+	s.NoError(
+		s.connection.QueryRowContext(s.ctx, "SELECT status FROM tickets WHERE id = ?", 1).Scan(&ticketStatus),
+	)
+	s.Equal(string(entities.RespondStatusPending), respondStatus)
+	s.Equal(string(entities.TicketStatusCancelled), ticketStatus)
+}
+
+func (s *RespondsRepositoryTestSuite) TestUpdateRespondStatusSuccess() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, comment, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, 100.00, nil, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	err = s.respondsRepository.UpdateRespondStatus(
+		s.ctx,
+		1,
+		entities.RespondStatusRejected,
+		entities.CreateOutboxMessageDTO{Subject: "respond-rejected", Payload: []byte(`{"respondID":1}`)},
+	)
+	s.NoError(err)
+
+	var status string
+	s.NoError(
+		s.connection.QueryRowContext(s.ctx, "SELECT status FROM responds WHERE id = ?", 1).Scan(&status),
+	)
+	s.Equal(string(entities.RespondStatusRejected), status)
+
+	var subject string
+	s.NoError(
+		s.connection.QueryRowContext(s.ctx, "SELECT subject FROM outbox WHERE sent_at IS NULL").Scan(&subject),
+	)
+	s.Equal("respond-rejected", subject)
+}
+
+func (s *RespondsRepositoryTestSuite) TestUpdateRespondStatusNotPending() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, comment, created_at, updated_at, status) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, 100.00, nil, createdAt, createdAt, entities.RespondStatusAccepted,
+	)
+	s.NoError(err)
+
+	err = s.respondsRepository.UpdateRespondStatus(
+		s.ctx,
+		1,
+		entities.RespondStatusRejected,
+		entities.CreateOutboxMessageDTO{Subject: "respond-rejected", Payload: []byte(`{"respondID":1}`)},
+	)
+
+	var respondIsNotPendingError *customerrors.RespondIsNotPendingError
+	s.ErrorAs(err, &respondIsNotPendingError)
+
+	// Accepted Respond is not overwritten and no message is saved:
+	var status string
+	s.NoError(
+		s.connection.QueryRowContext(s.ctx, "SELECT status FROM responds WHERE id = ?", 1).Scan(&status),
+	)
+	s.Equal(string(entities.RespondStatusAccepted), status)
+
+	var count int
+	s.NoError(s.connection.QueryRowContext(s.ctx, "SELECT COUNT(*) FROM outbox").Scan(&count))
+	s.Equal(0, count)
+}
+
+func (s *RespondsRepositoryTestSuite) TestWithdrawMasterRespondsSuccess() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, comment, status, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, 100.00, nil, entities.RespondStatusPending, createdAt, createdAt,
+		2, 2, 2, 100.00, nil, entities.RespondStatusAccepted, createdAt, createdAt,
+		3, 1, 3, 100.00, nil, entities.RespondStatusPending, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	err = s.respondsRepository.WithdrawMasterResponds(s.ctx, 2)
+	s.NoError(err)
+
+	// Only pending Responds of deleted Master should be withdrawn:
+	expected := map[uint64]entities.RespondStatus{
+		1: entities.RespondStatusWithdrawn,
+		2: entities.RespondStatusAccepted,
+		3: entities.RespondStatusPending,
+	}
+
+	for id, expectedStatus := range expected {
+		var status string
+		s.NoError(
+			s.connection.QueryRowContext(s.ctx, "SELECT status FROM responds WHERE id = ?", id).Scan(&status),
+		)
+		s.Equal(string(expectedStatus), status)
+	}
+}
+
+func (s *RespondsRepositoryTestSuite) TestGetRespondIDByIdempotencyKeySuccess() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO idempotency_keys (id, user_id, operation, idempotency_key, resource_id, expires_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?)",
+		1, 1, "respond_to_ticket", "key", 5, time.Now().UTC().Add(time.Hour),
+	)
+	s.NoError(err)
+
+	id, err := s.respondsRepository.GetRespondIDByIdempotencyKey(
+		s.ctx,
+		entities.IdempotencyKeyDTO{UserID: 1, Key: "key"},
+	)
+	s.NoError(err)
+	s.Equal(uint64(5), id)
+}
+
+func (s *RespondsRepositoryTestSuite) TestGetRespondIDByIdempotencyKeyExpired() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO idempotency_keys (id, user_id, operation, idempotency_key, resource_id, expires_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?)",
+		1, 1, "respond_to_ticket", "key", 5, time.Now().UTC().Add(-time.Hour),
+	)
+	s.NoError(err)
+
+	id, err := s.respondsRepository.GetRespondIDByIdempotencyKey(
+		s.ctx,
+		entities.IdempotencyKeyDTO{UserID: 1, Key: "key"},
+	)
+	s.ErrorIs(err, sql.ErrNoRows)
+	s.Zero(id)
+}
+
+func (s *RespondsRepositoryTestSuite) TestGetRespondIDByIdempotencyKeyAnotherOperation() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO idempotency_keys (id, user_id, operation, idempotency_key, resource_id, expires_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?)",
+		1, 1, "create_ticket", "key", 5, time.Now().UTC().Add(time.Hour),
+	)
+	s.NoError(err)
+
+	id, err := s.respondsRepository.GetRespondIDByIdempotencyKey(
+		s.ctx,
+		entities.IdempotencyKeyDTO{UserID: 1, Key: "key"},
+	)
+	s.ErrorIs(err, sql.ErrNoRows)
+	s.Zero(id)
+}
+
+func (s *RespondsRepositoryTestSuite) TestUniqueIndexMigrationRemovesDuplicates() {
+	migrationsPath := path.Dir(path.Dir(s.cwd)) + migrationsDir
+	s.NoError(goose.DownTo(s.dbConnector.Pool(), migrationsPath, 20250524120000))
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, comment, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, 100.00, nil, createdAt.Add(-time.Hour), createdAt,
+		2, 1, 2, 200.00, nil, createdAt, createdAt,
+		3, 1, 3, 300.00, nil, createdAt.Add(-time.Hour), createdAt,
+	)
+	s.NoError(err)
+
+	s.NoError(goose.Up(s.dbConnector.Pool(), migrationsPath))
+
+	rows, err := s.connection.QueryContext(s.ctx, "SELECT id FROM responds ORDER BY id")
+	s.NoError(err)
+
+	defer func() {
+		s.NoError(rows.Close())
+	}()
+
+	var ids []uint64
+	for rows.Next() {
+		var id uint64
+		s.NoError(rows.Scan(&id))
+		ids = append(ids, id)
+	}
+
+	s.NoError(rows.Err())
+
+	// Only the newest Respond of master to Ticket is kept:
+	s.Equal([]uint64{2, 3}, ids)
+}
+
+func (s *RespondsRepositoryTestSuite) TestUniqueIndexMigrationKeepsAcceptedRespond() {
+	migrationsPath := path.Dir(path.Dir(s.cwd)) + migrationsDir
+	s.NoError(goose.DownTo(s.dbConnector.Pool(), migrationsPath, 20250524120000))
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, comment, status, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?), "+
+			"(?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, 100.00, nil, entities.RespondStatusAccepted, createdAt.Add(-time.Hour), createdAt,
+		2, 1, 2, 200.00, nil, entities.RespondStatusPending, createdAt, createdAt,
+		3, 1, 3, 300.00, nil, entities.RespondStatusRejected, createdAt.Add(-time.Hour), createdAt,
+		4, 1, 3, 400.00, nil, entities.RespondStatusWithdrawn, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	s.NoError(goose.Up(s.dbConnector.Pool(), migrationsPath))
+
+	rows, err := s.connection.QueryContext(s.ctx, "SELECT id FROM responds ORDER BY id")
+	s.NoError(err)
+
+	defer func() {
+		s.NoError(rows.Close())
+	}()
+
+	var ids []uint64
+	for rows.Next() {
+		var id uint64
+		s.NoError(rows.Scan(&id))
+		ids = append(ids, id)
+	}
+
+	s.NoError(rows.Err())
+
+	// Status is preferred to creation time: accepted Respond survives newer pending one
+	// and rejected Respond survives newer withdrawn one:
+	s.Equal([]uint64{1, 3}, ids)
+}
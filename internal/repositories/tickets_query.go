@@ -0,0 +1,311 @@
+package repositories
+
+import (
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
+)
+
+const (
+	ticketSearchVectorColumnName = "search_vector"
+	relevanceOrderStatement      = "ts_rank(" + ticketSearchVectorColumnName + ", %s) %s"
+	searchMatchStatement         = ticketSearchVectorColumnName + " @@ %s"
+	searchQueryStatement         = "websearch_to_tsquery(?::regconfig, ?)"
+	tsQueriesUnionOperator       = " || "
+)
+
+// ticketsColumns are listed explicitly instead of "*", because Postgres tickets table has additional
+// search_vector column, which is not a part of Ticket entity. Order must correspond to Ticket entity fields.
+var ticketsColumns = []string{
+	idColumnName,
+	userIDColumnName,
+	categoryIDColumnName,
+	ticketNameColumnName,
+	ticketDescriptionColumnName,
+	ticketPriceColumnName,
+	ticketQuantityColumnName,
+	createdAtColumnName,
+	updatedAtColumnName,
+	ticketStatusColumnName,
+	versionColumnName,
+}
+
+// ticketsQuery describes set of Tickets to be selected or counted. List and count queries are built
+// from the same conditions, so new filters should be added only to conditions method and list results
+// will never disagree with count.
+type ticketsQuery struct {
+	userID       *uint64
+	filters      *entities.TicketsFilters
+	searchConfig config.SearchConfig
+}
+
+func newTicketsQuery(filters *entities.TicketsFilters, searchConfig config.SearchConfig) ticketsQuery {
+	return ticketsQuery{filters: filters, searchConfig: searchConfig}
+}
+
+func newUserTicketsQuery(
+	userID uint64,
+	filters *entities.TicketsFilters,
+	searchConfig config.SearchConfig,
+) ticketsQuery {
+	return ticketsQuery{userID: &userID, filters: filters, searchConfig: searchConfig}
+}
+
+// selectBuilder returns builder for selecting Tickets page with requested order.
+// Error is returned for unknown sort field.
+func (query ticketsQuery) selectBuilder(pagination *entities.Pagination) (sq.SelectBuilder, error) {
+	builder := query.where(
+		sq.
+			Select(ticketsColumns...).
+			From(ticketsTableName),
+	)
+
+	// Requested sort keys are applied before creation time, which is used as default order:
+	if query.filters != nil {
+		for i, sort := range query.filters.Sort {
+			orderBy, err := query.sortOrderBy(sort)
+			if err != nil {
+				return builder, &customerrors.InvalidSortError{Index: i, BaseErr: err}
+			}
+
+			if orderBy != nil {
+				builder = builder.OrderByClause(orderBy)
+			}
+		}
+	}
+
+	createdAtOrder := desc
+	if query.filters != nil && query.filters.CreatedAtOrderByAsc != nil && *query.filters.CreatedAtOrderByAsc {
+		createdAtOrder = asc
+	}
+
+	// ID is used as tiebreaker for Tickets with the same creation time to make order stable for keyset pagination:
+	builder = builder.
+		OrderBy(
+			fmt.Sprintf(
+				"%s %s",
+				ticketsColumn(createdAtColumnName),
+				createdAtOrder,
+			),
+			fmt.Sprintf(
+				"%s %s",
+				ticketsColumn(idColumnName),
+				createdAtOrder,
+			),
+		)
+
+	if pagination != nil && pagination.Cursor != nil {
+		builder = builder.Where(keysetCondition(*pagination.Cursor, createdAtOrder))
+	}
+
+	if pagination != nil && pagination.Limit != nil {
+		builder = builder.Limit(*pagination.Limit)
+	}
+
+	// Offset is not used with cursor, because cursor already points to page start:
+	if pagination != nil && pagination.Offset != nil && pagination.Cursor == nil {
+		builder = builder.Offset(*pagination.Offset)
+	}
+
+	return builder.PlaceholderFormat(sq.Dollar), nil
+}
+
+// countBuilder returns builder for counting Tickets. Ordering is not needed for COUNT queries,
+// so CreatedAtOrderByAsc filter is not used.
+func (query ticketsQuery) countBuilder() sq.SelectBuilder {
+	return query.where(
+		sq.
+			Select(selectCount).
+			From(ticketsTableName),
+	).PlaceholderFormat(sq.Dollar)
+}
+
+func (query ticketsQuery) where(builder sq.SelectBuilder) sq.SelectBuilder {
+	for _, condition := range query.conditions() {
+		builder = builder.Where(condition)
+	}
+
+	return builder
+}
+
+func (query ticketsQuery) conditions() []sq.Sqlizer {
+	var conditions []sq.Sqlizer
+
+	if query.userID != nil {
+		conditions = append(conditions, sq.Eq{ticketsColumn(userIDColumnName): *query.userID})
+	}
+
+	filters := query.filters
+	if filters == nil {
+		return conditions
+	}
+
+	if query.hasSearch() {
+		conditions = append(conditions, query.searchCondition())
+	}
+
+	if filters.PriceFloor != nil {
+		conditions = append(conditions, sq.GtOrEq{ticketsColumn(ticketPriceColumnName): *filters.PriceFloor})
+	}
+
+	if filters.PriceCeil != nil {
+		conditions = append(conditions, sq.LtOrEq{ticketsColumn(ticketPriceColumnName): *filters.PriceCeil})
+	}
+
+	if filters.QuantityFloor != nil {
+		conditions = append(
+			conditions,
+			sq.GtOrEq{ticketsColumn(ticketQuantityColumnName): *filters.QuantityFloor},
+		)
+	}
+
+	if filters.CategoryIDs != nil {
+		conditions = append(conditions, sq.Eq{ticketsColumn(categoryIDColumnName): filters.CategoryIDs})
+	}
+
+	if filters.CreatedAtFrom != nil {
+		conditions = append(conditions, sq.GtOrEq{ticketsColumn(createdAtColumnName): *filters.CreatedAtFrom})
+	}
+
+	if filters.CreatedAtTo != nil {
+		conditions = append(conditions, sq.Lt{ticketsColumn(createdAtColumnName): *filters.CreatedAtTo})
+	}
+
+	if filters.UpdatedAtFrom != nil {
+		conditions = append(conditions, sq.GtOrEq{ticketsColumn(updatedAtColumnName): *filters.UpdatedAtFrom})
+	}
+
+	if filters.UpdatedAtTo != nil {
+		conditions = append(conditions, sq.Lt{ticketsColumn(updatedAtColumnName): *filters.UpdatedAtTo})
+	}
+
+	if len(filters.Statuses) > 0 {
+		conditions = append(conditions, sq.Eq{ticketsColumn(ticketStatusColumnName): filters.Statuses})
+	}
+
+	// Ticket should have all provided Tags:
+	for _, tagID := range filters.TagIDs {
+		conditions = append(
+			conditions,
+			sq.Expr(
+				fmt.Sprintf(
+					"EXISTS (SELECT 1 FROM %s WHERE %s.%s = %s AND %s.%s = ?)",
+					ticketsAndTagsAssociationTableName,
+					ticketsAndTagsAssociationTableName,
+					ticketIDColumnName,
+					ticketsColumn(idColumnName),
+					ticketsAndTagsAssociationTableName,
+					tagIDColumnName,
+				),
+				tagID,
+			),
+		)
+	}
+
+	return conditions
+}
+
+func (query ticketsQuery) hasSearch() bool {
+	return query.filters != nil && query.filters.Search != nil && *query.filters.Search != ""
+}
+
+// searchCondition uses full-text search, if it is enabled. Otherwise, case-insensitive substring search is used.
+func (query ticketsQuery) searchCondition() sq.Sqlizer {
+	if query.searchConfig.FullTextEnabled {
+		tsQuery, args := query.tsQuery()
+
+		return sq.Expr(fmt.Sprintf(searchMatchStatement, tsQuery), args...)
+	}
+
+	searchTerm := "%" + strings.ToLower(*query.filters.Search) + "%"
+
+	return sq.Or{
+		sq.Like{fmt.Sprintf("LOWER(%s)", ticketsColumn(ticketNameColumnName)): searchTerm},
+		sq.Like{fmt.Sprintf("LOWER(%s)", ticketsColumn(ticketDescriptionColumnName)): searchTerm},
+	}
+}
+
+// tsQuery unites search queries for all configured languages to match any word form.
+func (query ticketsQuery) tsQuery() (string, []any) {
+	tsQueries := make([]string, 0, len(query.searchConfig.Languages))
+	args := make([]any, 0, len(query.searchConfig.Languages)*2)
+
+	for _, language := range query.searchConfig.Languages {
+		tsQueries = append(tsQueries, searchQueryStatement)
+		args = append(args, language, *query.filters.Search)
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(tsQueries, tsQueriesUnionOperator)), args
+}
+
+// sortOrderBy returns ORDER BY expression for provided sort key. Relevance is known only for full-text search,
+// so nil expression is returned for relevance sort key without it, because fallback search has no ranking.
+func (query ticketsQuery) sortOrderBy(sort entities.TicketsSort) (sq.Sqlizer, error) {
+	direction := asc
+	if sort.Direction == entities.SortDirectionDesc {
+		direction = desc
+	}
+
+	switch sort.Field {
+	case entities.TicketsSortFieldPrice:
+		// Tickets without price go last regardless of direction:
+		return sq.Expr(fmt.Sprintf("%s %s NULLS LAST", ticketsColumn(ticketPriceColumnName), direction)), nil
+	case entities.TicketsSortFieldQuantity:
+		return sq.Expr(fmt.Sprintf("%s %s", ticketsColumn(ticketQuantityColumnName), direction)), nil
+	case entities.TicketsSortFieldUpdatedAt:
+		return sq.Expr(fmt.Sprintf("%s %s", ticketsColumn(updatedAtColumnName), direction)), nil
+	case entities.TicketsSortFieldName:
+		return sq.Expr(fmt.Sprintf("%s %s", ticketsColumn(ticketNameColumnName), direction)), nil
+	case entities.TicketsSortFieldRespondsCount:
+		return sq.Expr(
+			fmt.Sprintf(
+				"(SELECT COUNT(*) FROM %s WHERE %s.%s = %s) %s",
+				respondsTableName,
+				respondsTableName,
+				ticketIDColumnName,
+				ticketsColumn(idColumnName),
+				direction,
+			),
+		), nil
+	case entities.TicketsSortFieldRelevance:
+		if !query.hasSearch() || !query.searchConfig.FullTextEnabled {
+			return nil, nil
+		}
+
+		tsQuery, args := query.tsQuery()
+
+		return sq.Expr(fmt.Sprintf(relevanceOrderStatement, tsQuery, direction), args...), nil
+	default:
+		return nil, fmt.Errorf("unknown sort field %q", sort.Field)
+	}
+}
+
+// keysetCondition selects Tickets, which go after cursor in provided order.
+func keysetCondition(cursor entities.Cursor, order string) sq.Sqlizer {
+	if order == asc {
+		return sq.Or{
+			sq.Gt{ticketsColumn(createdAtColumnName): cursor.CreatedAt},
+			sq.And{
+				sq.Eq{ticketsColumn(createdAtColumnName): cursor.CreatedAt},
+				sq.Gt{ticketsColumn(idColumnName): cursor.ID},
+			},
+		}
+	}
+
+	return sq.Or{
+		sq.Lt{ticketsColumn(createdAtColumnName): cursor.CreatedAt},
+		sq.And{
+			sq.Eq{ticketsColumn(createdAtColumnName): cursor.CreatedAt},
+			sq.Lt{ticketsColumn(idColumnName): cursor.ID},
+		},
+	}
+}
+
+func ticketsColumn(column string) string {
+	return fmt.Sprintf("%s.%s", ticketsTableName, column)
+}
@@ -0,0 +1,236 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DKhorkov/libs/pointers"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
+)
+
+func TestTicketsQuery_Search(t *testing.T) {
+	testCases := []struct {
+		name           string
+		filters        *entities.TicketsFilters
+		searchConfig   config.SearchConfig
+		expectedSelect string
+		expectedCount  string
+		expectedParams []any
+		// Search params are repeated in select query for relevance ordering:
+		orderedByRelevance bool
+	}{
+		{
+			name: "full-text search with relevance",
+			filters: &entities.TicketsFilters{
+				Search: pointers.New("игрушка"),
+				Sort: []entities.TicketsSort{
+					{Field: entities.TicketsSortFieldRelevance, Direction: entities.SortDirectionDesc},
+				},
+			},
+			searchConfig: config.SearchConfig{
+				FullTextEnabled: true,
+				Languages:       []string{"russian", "english"},
+			},
+			expectedSelect: "SELECT id, user_id, category_id, name, description, price, quantity, created_at, " +
+				"updated_at, status, version FROM tickets " +
+				"WHERE search_vector @@ (websearch_to_tsquery($1::regconfig, $2) || " +
+				"websearch_to_tsquery($3::regconfig, $4)) " +
+				"ORDER BY ts_rank(search_vector, (websearch_to_tsquery($5::regconfig, $6) || " +
+				"websearch_to_tsquery($7::regconfig, $8))) DESC, tickets.created_at DESC, tickets.id DESC",
+			expectedCount: "SELECT COUNT(*) FROM tickets " +
+				"WHERE search_vector @@ (websearch_to_tsquery($1::regconfig, $2) || " +
+				"websearch_to_tsquery($3::regconfig, $4))",
+			expectedParams:     []any{"russian", "игрушка", "english", "игрушка"},
+			orderedByRelevance: true,
+		},
+		{
+			name: "full-text search without relevance",
+			filters: &entities.TicketsFilters{
+				Search: pointers.New("toy"),
+			},
+			searchConfig: config.SearchConfig{
+				FullTextEnabled: true,
+				Languages:       []string{"english"},
+			},
+			expectedSelect: "SELECT id, user_id, category_id, name, description, price, quantity, created_at, " +
+				"updated_at, status, version FROM tickets " +
+				"WHERE search_vector @@ (websearch_to_tsquery($1::regconfig, $2)) " +
+				"ORDER BY tickets.created_at DESC, tickets.id DESC",
+			expectedCount: "SELECT COUNT(*) FROM tickets " +
+				"WHERE search_vector @@ (websearch_to_tsquery($1::regconfig, $2))",
+			expectedParams: []any{"english", "toy"},
+		},
+		{
+			name: "fallback search ignores relevance",
+			filters: &entities.TicketsFilters{
+				Search: pointers.New("Toy"),
+				Sort: []entities.TicketsSort{
+					{Field: entities.TicketsSortFieldRelevance, Direction: entities.SortDirectionDesc},
+				},
+			},
+			expectedSelect: "SELECT id, user_id, category_id, name, description, price, quantity, created_at, " +
+				"updated_at, status, version FROM tickets " +
+				"WHERE (LOWER(tickets.name) LIKE $1 OR LOWER(tickets.description) LIKE $2) " +
+				"ORDER BY tickets.created_at DESC, tickets.id DESC",
+			expectedCount: "SELECT COUNT(*) FROM tickets " +
+				"WHERE (LOWER(tickets.name) LIKE $1 OR LOWER(tickets.description) LIKE $2)",
+			expectedParams: []any{"%toy%", "%toy%"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			query := newTicketsQuery(tc.filters, tc.searchConfig)
+
+			builder, err := query.selectBuilder(nil)
+			require.NoError(t, err)
+
+			stmt, params, err := builder.ToSql()
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSelect, stmt)
+
+			if tc.orderedByRelevance {
+				require.Equal(t, append(tc.expectedParams, tc.expectedParams...), params)
+			} else {
+				require.Equal(t, tc.expectedParams, params)
+			}
+
+			stmt, params, err = query.countBuilder().ToSql()
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedCount, stmt)
+			require.Equal(t, tc.expectedParams, params)
+		})
+	}
+}
+
+func TestTicketsQuery_Pagination(t *testing.T) {
+	createdAt := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		filters        *entities.TicketsFilters
+		pagination     *entities.Pagination
+		expectedSelect string
+		expectedParams []any
+	}{
+		{
+			name: "offset",
+			pagination: &entities.Pagination{
+				Limit:  pointers.New[uint64](10),
+				Offset: pointers.New[uint64](20),
+			},
+			expectedSelect: "SELECT id, user_id, category_id, name, description, price, quantity, created_at, " +
+				"updated_at, status, version FROM tickets " +
+				"ORDER BY tickets.created_at DESC, tickets.id DESC LIMIT 10 OFFSET 20",
+		},
+		{
+			name: "cursor with descending order",
+			pagination: &entities.Pagination{
+				Limit:  pointers.New[uint64](10),
+				Cursor: &entities.Cursor{CreatedAt: createdAt, ID: 5},
+			},
+			expectedSelect: "SELECT id, user_id, category_id, name, description, price, quantity, created_at, " +
+				"updated_at, status, version FROM tickets " +
+				"WHERE (tickets.created_at < $1 OR (tickets.created_at = $2 AND tickets.id < $3)) " +
+				"ORDER BY tickets.created_at DESC, tickets.id DESC LIMIT 10",
+			expectedParams: []any{createdAt, createdAt, uint64(5)},
+		},
+		{
+			name:    "cursor with ascending order ignores offset",
+			filters: &entities.TicketsFilters{CreatedAtOrderByAsc: pointers.New(true)},
+			pagination: &entities.Pagination{
+				Limit:  pointers.New[uint64](10),
+				Offset: pointers.New[uint64](20),
+				Cursor: &entities.Cursor{CreatedAt: createdAt, ID: 5},
+			},
+			expectedSelect: "SELECT id, user_id, category_id, name, description, price, quantity, created_at, " +
+				"updated_at, status, version FROM tickets " +
+				"WHERE (tickets.created_at > $1 OR (tickets.created_at = $2 AND tickets.id > $3)) " +
+				"ORDER BY tickets.created_at ASC, tickets.id ASC LIMIT 10",
+			expectedParams: []any{createdAt, createdAt, uint64(5)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder, err := newTicketsQuery(tc.filters, config.SearchConfig{}).selectBuilder(tc.pagination)
+			require.NoError(t, err)
+
+			stmt, params, err := builder.ToSql()
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSelect, stmt)
+			require.Equal(t, tc.expectedParams, params)
+		})
+	}
+}
+
+func TestTicketsQuery_Sort(t *testing.T) {
+	testCases := []struct {
+		name           string
+		filters        *entities.TicketsFilters
+		expectedSelect string
+		errorExpected  bool
+	}{
+		{
+			name: "multiple keys",
+			filters: &entities.TicketsFilters{
+				Sort: []entities.TicketsSort{
+					{Field: entities.TicketsSortFieldPrice, Direction: entities.SortDirectionDesc},
+					{Field: entities.TicketsSortFieldQuantity, Direction: entities.SortDirectionAsc},
+					{Field: entities.TicketsSortFieldUpdatedAt, Direction: entities.SortDirectionDesc},
+					{Field: entities.TicketsSortFieldName, Direction: entities.SortDirectionAsc},
+				},
+			},
+			expectedSelect: "SELECT id, user_id, category_id, name, description, price, quantity, created_at, " +
+				"updated_at, status, version FROM tickets " +
+				"ORDER BY tickets.price DESC NULLS LAST, tickets.quantity ASC, tickets.updated_at DESC, " +
+				"tickets.name ASC, tickets.created_at DESC, tickets.id DESC",
+		},
+		{
+			name: "responds count with ascending creation time",
+			filters: &entities.TicketsFilters{
+				CreatedAtOrderByAsc: pointers.New(true),
+				Sort: []entities.TicketsSort{
+					{Field: entities.TicketsSortFieldRespondsCount, Direction: entities.SortDirectionDesc},
+				},
+			},
+			expectedSelect: "SELECT id, user_id, category_id, name, description, price, quantity, created_at, " +
+				"updated_at, status, version FROM tickets " +
+				"ORDER BY (SELECT COUNT(*) FROM responds WHERE responds.ticket_id = tickets.id) DESC, " +
+				"tickets.created_at ASC, tickets.id ASC",
+		},
+		{
+			name: "unknown field",
+			filters: &entities.TicketsFilters{
+				Sort: []entities.TicketsSort{
+					{Field: entities.TicketsSortFieldPrice, Direction: entities.SortDirectionAsc},
+					{Field: "unknown", Direction: entities.SortDirectionAsc},
+				},
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder, err := newTicketsQuery(tc.filters, config.SearchConfig{}).selectBuilder(nil)
+			if tc.errorExpected {
+				var invalidSortError *customerrors.InvalidSortError
+				require.ErrorAs(t, err, &invalidSortError)
+				require.Equal(t, 1, invalidSortError.Index)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			stmt, _, err := builder.ToSql()
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSelect, stmt)
+		})
+	}
+}
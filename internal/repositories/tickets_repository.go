@@ -4,7 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"strings"
+	"slices"
 
 	"github.com/DKhorkov/libs/db"
 	"github.com/DKhorkov/libs/logging"
@@ -12,7 +12,9 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
 	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
 )
 
 const (
@@ -34,15 +36,20 @@ const (
 	returningIDSuffix                  = "RETURNING id"
 	createdAtColumnName                = "created_at"
 	updatedAtColumnName                = "updated_at"
+	ticketStatusColumnName             = "status"
+	versionColumnName                  = "version"
+	versionIncrementStatement          = versionColumnName + " + 1"
 	desc                               = "DESC"
 	asc                                = "ASC"
 )
 
 type TicketsRepository struct {
-	dbConnector   db.Connector
-	logger        logging.Logger
-	traceProvider tracing.Provider
-	spanConfig    tracing.SpanConfig
+	dbConnector       db.Connector
+	logger            logging.Logger
+	traceProvider     tracing.Provider
+	spanConfig        tracing.SpanConfig
+	searchConfig      config.SearchConfig
+	idempotencyConfig config.IdempotencyConfig
 }
 
 func NewTicketsRepository(
@@ -50,18 +57,25 @@ func NewTicketsRepository(
 	logger logging.Logger,
 	traceProvider tracing.Provider,
 	spanConfig tracing.SpanConfig,
+	searchConfig config.SearchConfig,
+	idempotencyConfig config.IdempotencyConfig,
 ) *TicketsRepository {
 	return &TicketsRepository{
-		dbConnector:   dbConnector,
-		logger:        logger,
-		traceProvider: traceProvider,
-		spanConfig:    spanConfig,
+		dbConnector:       dbConnector,
+		logger:            logger,
+		traceProvider:     traceProvider,
+		spanConfig:        spanConfig,
+		searchConfig:      searchConfig,
+		idempotencyConfig: idempotencyConfig,
 	}
 }
 
+// CreateTicket creates Ticket with its Tags and Attachments and saves outbox messages, built for created Ticket,
+// and idempotency key, if it was provided, within single transaction.
 func (repo *TicketsRepository) CreateTicket(
 	ctx context.Context,
 	ticketData entities.CreateTicketDTO,
+	outboxMessageBuilders ...entities.OutboxMessageBuilder,
 ) (uint64, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
@@ -147,6 +161,27 @@ func (repo *TicketsRepository) CreateTicket(
 		}
 	}
 
+	err = insertIdempotencyKey(
+		ctx,
+		transaction,
+		createTicketIdempotentOperation,
+		ticketData.IdempotencyKey,
+		ticketID,
+		repo.idempotencyConfig.KeysTTL,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	outboxMessages, err := buildOutboxMessages(ticketID, outboxMessageBuilders)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = insertOutboxMessages(ctx, transaction, outboxMessages); err != nil {
+		return 0, err
+	}
+
 	err = transaction.Commit()
 	if err != nil {
 		return 0, err
@@ -155,6 +190,27 @@ func (repo *TicketsRepository) CreateTicket(
 	return ticketID, nil
 }
 
+// GetTicketIDByIdempotencyKey returns ID of Ticket, created by request with provided idempotency key.
+func (repo *TicketsRepository) GetTicketIDByIdempotencyKey(
+	ctx context.Context,
+	idempotencyKey entities.IdempotencyKeyDTO,
+) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	return getIdempotentResourceID(ctx, connection, createTicketIdempotentOperation, idempotencyKey)
+}
+
 func (repo *TicketsRepository) GetTicketByID(
 	ctx context.Context,
 	id uint64,
@@ -173,7 +229,7 @@ func (repo *TicketsRepository) GetTicketByID(
 	defer db.CloseConnectionContext(ctx, connection, repo.logger)
 
 	stmt, params, err := sq.
-		Select(selectAllColumns).
+		Select(ticketsColumns...).
 		From(ticketsTableName).
 		Where(sq.Eq{idColumnName: id}).
 		PlaceholderFormat(sq.Dollar).
@@ -190,34 +246,36 @@ func (repo *TicketsRepository) GetTicketByID(
 		return nil, err
 	}
 
-	tagsIDs, err := repo.getTicketTagsIDs(ctx, ticket.ID, connection)
+	ticketsTagsIDs, err := repo.getTicketsTagsIDs(ctx, []uint64{ticket.ID}, connection)
 	if err != nil {
 		return nil, err
 	}
 
-	ticket.TagIDs = tagsIDs
+	ticket.TagIDs = ticketsTagsIDs[ticket.ID]
 
-	attachments, err := repo.getTicketAttachments(ctx, ticket.ID, connection)
+	ticketsAttachments, err := repo.getTicketsAttachments(ctx, []uint64{ticket.ID}, connection)
 	if err != nil {
 		return nil, err
 	}
 
-	ticket.Attachments = attachments
+	ticket.Attachments = ticketsAttachments[ticket.ID]
 
 	return ticket, nil
 }
 
-func (repo *TicketsRepository) GetTickets(
-	ctx context.Context,
-	pagination *entities.Pagination,
-	filters *entities.TicketsFilters,
-) ([]entities.Ticket, error) {
+// GetTicketsByIDs returns Tickets for provided IDs with one query. Order of Tickets is not guaranteed
+// and not found IDs are skipped.
+func (repo *TicketsRepository) GetTicketsByIDs(ctx context.Context, ids []uint64) ([]entities.Ticket, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
 	connection, err := repo.dbConnector.Connection(ctx)
 	if err != nil {
 		return nil, err
@@ -225,133 +283,85 @@ func (repo *TicketsRepository) GetTickets(
 
 	defer db.CloseConnectionContext(ctx, connection, repo.logger)
 
-	builder := sq.
-		Select(selectAllColumns).
+	stmt, params, err := sq.
+		Select(ticketsColumns...).
 		From(ticketsTableName).
-		PlaceholderFormat(sq.Dollar)
+		Where(sq.Eq{idColumnName: ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
 
-	if filters != nil && filters.Search != nil && *filters.Search != "" {
-		searchTerm := "%" + strings.ToLower(*filters.Search) + "%"
-		builder = builder.
-			Where(
-				sq.Or{
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							ticketsTableName,
-							ticketNameColumnName,
-						): searchTerm,
-					},
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							ticketsTableName,
-							ticketDescriptionColumnName,
-						): searchTerm,
-					},
-				},
-			)
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	if filters != nil && (filters.PriceFloor != nil || filters.PriceCeil != nil) {
-		priceConditions := sq.And{}
-		if filters.PriceFloor != nil {
-			priceConditions = append(
-				priceConditions,
-				sq.GtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						ticketsTableName,
-						ticketPriceColumnName,
-					): *filters.PriceFloor,
-				},
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
 			)
 		}
+	}()
 
-		if filters.PriceCeil != nil {
-			priceConditions = append(
-				priceConditions,
-				sq.LtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						ticketsTableName,
-						ticketPriceColumnName,
-					): *filters.PriceCeil,
-				},
-			)
+	var tickets []entities.Ticket
+
+	for rows.Next() {
+		ticket := entities.Ticket{}
+		columns := db.GetEntityColumns(&ticket) // Only pointer to use rows.Scan() successfully
+		columns = columns[:len(columns)-2]      // Not to paste TagIDs and Attachments fields to Scan function.
+
+		err = rows.Scan(columns...)
+		if err != nil {
+			return nil, err
 		}
 
-		builder = builder.Where(priceConditions)
+		tickets = append(tickets, ticket)
 	}
 
-	if filters != nil && filters.QuantityFloor != nil {
-		builder = builder.
-			Where(
-				sq.GtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						ticketsTableName,
-						ticketQuantityColumnName,
-					): *filters.QuantityFloor,
-				},
-			)
+	if err = rows.Err(); err != nil {
+		return nil, err
 	}
 
-	if filters != nil && filters.CategoryIDs != nil {
-		builder = builder.
-			Where(
-				sq.Eq{
-					fmt.Sprintf(
-						"%s.%s",
-						ticketsTableName,
-						categoryIDColumnName,
-					): filters.CategoryIDs,
-				},
-			)
+	// Reading Tags and Attachments after closing Tickets rows due
+	// to next error: https://github.com/lib/pq/issues/635
+	if err = repo.processTicketsAssociations(ctx, tickets, connection); err != nil {
+		return nil, err
 	}
 
-	if filters != nil && len(filters.TagIDs) > 0 {
-		for _, tagID := range filters.TagIDs {
-			builder = builder.
-				Where(
-					sq.Expr(
-						fmt.Sprintf(
-							"EXISTS (SELECT 1 FROM %s WHERE %s.%s = %s.%s AND %s.%s = ?)",
-							ticketsAndTagsAssociationTableName,
-							ticketsAndTagsAssociationTableName,
-							ticketIDColumnName,
-							ticketsTableName,
-							idColumnName,
-							ticketsAndTagsAssociationTableName,
-							tagIDColumnName,
-						),
-						tagID,
-					),
-				)
-		}
-	}
+	return tickets, nil
+}
 
-	createdAtOrder := desc
-	if filters != nil && filters.CreatedAtOrderByAsc != nil && *filters.CreatedAtOrderByAsc {
-		createdAtOrder = asc
-	}
+func (repo *TicketsRepository) GetTickets(
+	ctx context.Context,
+	pagination *entities.Pagination,
+	filters *entities.TicketsFilters,
+) ([]entities.Ticket, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
 
-	builder = builder.
-		OrderBy(
-			fmt.Sprintf(
-				"%s.%s %s",
-				ticketsTableName,
-				createdAtColumnName,
-				createdAtOrder,
-			),
-		)
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	if pagination != nil && pagination.Limit != nil {
-		builder = builder.Limit(*pagination.Limit)
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	if pagination != nil && pagination.Offset != nil {
-		builder = builder.Offset(*pagination.Offset)
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	builder, err := newTicketsQuery(filters, repo.searchConfig).selectBuilder(pagination)
+	if err != nil {
+		return nil, err
 	}
 
 	stmt, params, err := builder.ToSql()
@@ -398,23 +408,10 @@ func (repo *TicketsRepository) GetTickets(
 		return nil, err
 	}
 
-	// Reading Tags and Attachments for each Ticket in new circle due
+	// Reading Tags and Attachments after closing Tickets rows due
 	// to next error: https://github.com/lib/pq/issues/635
-	// Using ticket index to avoid range iter semantics error, via using copied variable.
-	for i, ticket := range tickets {
-		tagsIDs, err := repo.getTicketTagsIDs(ctx, ticket.ID, connection)
-		if err != nil {
-			return nil, err
-		}
-
-		tickets[i].TagIDs = tagsIDs
-
-		attachments, err := repo.getTicketAttachments(ctx, ticket.ID, connection)
-		if err != nil {
-			return nil, err
-		}
-
-		tickets[i].Attachments = attachments
+	if err = repo.processTicketsAssociations(ctx, tickets, connection); err != nil {
+		return nil, err
 	}
 
 	return tickets, nil
@@ -434,114 +431,7 @@ func (repo *TicketsRepository) CountTickets(ctx context.Context, filters *entiti
 
 	defer db.CloseConnectionContext(ctx, connection, repo.logger)
 
-	builder := sq.
-		Select(selectCount).
-		From(ticketsTableName).
-		PlaceholderFormat(sq.Dollar)
-
-	if filters != nil && filters.Search != nil && *filters.Search != "" {
-		searchTerm := "%" + strings.ToLower(*filters.Search) + "%"
-		builder = builder.
-			Where(
-				sq.Or{
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							ticketsTableName,
-							ticketNameColumnName,
-						): searchTerm,
-					},
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							ticketsTableName,
-							ticketDescriptionColumnName,
-						): searchTerm,
-					},
-				},
-			)
-	}
-
-	if filters != nil && (filters.PriceFloor != nil || filters.PriceCeil != nil) {
-		priceConditions := sq.And{}
-		if filters.PriceFloor != nil {
-			priceConditions = append(
-				priceConditions,
-				sq.GtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						ticketsTableName,
-						ticketPriceColumnName,
-					): *filters.PriceFloor,
-				},
-			)
-		}
-
-		if filters.PriceCeil != nil {
-			priceConditions = append(
-				priceConditions,
-				sq.LtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						ticketsTableName,
-						ticketPriceColumnName,
-					): *filters.PriceCeil,
-				},
-			)
-		}
-
-		builder = builder.Where(priceConditions)
-	}
-
-	if filters != nil && filters.QuantityFloor != nil {
-		builder = builder.
-			Where(
-				sq.GtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						ticketsTableName,
-						ticketQuantityColumnName,
-					): *filters.QuantityFloor,
-				},
-			)
-	}
-
-	if filters != nil && filters.CategoryIDs != nil {
-		builder = builder.
-			Where(
-				sq.Eq{
-					fmt.Sprintf(
-						"%s.%s",
-						ticketsTableName,
-						categoryIDColumnName,
-					): filters.CategoryIDs,
-				},
-			)
-	}
-
-	if filters != nil && len(filters.TagIDs) > 0 {
-		for _, tagID := range filters.TagIDs {
-			builder = builder.
-				Where(
-					sq.Expr(
-						fmt.Sprintf(
-							"EXISTS (SELECT 1 FROM %s WHERE %s.%s = %s.%s AND %s.%s = ?)",
-							ticketsAndTagsAssociationTableName,
-							ticketsAndTagsAssociationTableName,
-							ticketIDColumnName,
-							ticketsTableName,
-							idColumnName,
-							ticketsAndTagsAssociationTableName,
-							tagIDColumnName,
-						),
-						tagID,
-					),
-				)
-		}
-	}
-
-	// Для запросов COUNT сортировка не нужна, поэтому параметр CreatedAtOrderByAsc не используется
-	stmt, params, err := builder.ToSql()
+	stmt, params, err := newTicketsQuery(filters, repo.searchConfig).countBuilder().ToSql()
 	if err != nil {
 		return 0, err
 	}
@@ -573,134 +463,9 @@ func (repo *TicketsRepository) GetUserTickets(
 
 	defer db.CloseConnectionContext(ctx, connection, repo.logger)
 
-	builder := sq.
-		Select(selectAllColumns).
-		From(ticketsTableName).
-		Where(sq.Eq{userIDColumnName: userID}).
-		PlaceholderFormat(sq.Dollar)
-
-	if filters != nil && filters.Search != nil && *filters.Search != "" {
-		searchTerm := "%" + strings.ToLower(*filters.Search) + "%"
-		builder = builder.
-			Where(
-				sq.Or{
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							ticketsTableName,
-							ticketNameColumnName,
-						): searchTerm,
-					},
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							ticketsTableName,
-							ticketDescriptionColumnName,
-						): searchTerm,
-					},
-				},
-			)
-	}
-
-	if filters != nil && (filters.PriceFloor != nil || filters.PriceCeil != nil) {
-		priceConditions := sq.And{}
-		if filters.PriceFloor != nil {
-			priceConditions = append(
-				priceConditions,
-				sq.GtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						ticketsTableName,
-						ticketPriceColumnName,
-					): *filters.PriceFloor,
-				},
-			)
-		}
-
-		if filters.PriceCeil != nil {
-			priceConditions = append(
-				priceConditions,
-				sq.LtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						ticketsTableName,
-						ticketPriceColumnName,
-					): *filters.PriceCeil,
-				},
-			)
-		}
-
-		builder = builder.Where(priceConditions)
-	}
-
-	if filters != nil && filters.QuantityFloor != nil {
-		builder = builder.
-			Where(
-				sq.GtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						ticketsTableName,
-						ticketQuantityColumnName,
-					): *filters.QuantityFloor,
-				},
-			)
-	}
-
-	if filters != nil && filters.CategoryIDs != nil {
-		builder = builder.
-			Where(
-				sq.Eq{
-					fmt.Sprintf(
-						"%s.%s",
-						ticketsTableName,
-						categoryIDColumnName,
-					): filters.CategoryIDs,
-				},
-			)
-	}
-
-	if filters != nil && len(filters.TagIDs) > 0 {
-		for _, tagID := range filters.TagIDs {
-			builder = builder.
-				Where(
-					sq.Expr(
-						fmt.Sprintf(
-							"EXISTS (SELECT 1 FROM %s WHERE %s.%s = %s.%s AND %s.%s = ?)",
-							ticketsAndTagsAssociationTableName,
-							ticketsAndTagsAssociationTableName,
-							ticketIDColumnName,
-							ticketsTableName,
-							idColumnName,
-							ticketsAndTagsAssociationTableName,
-							tagIDColumnName,
-						),
-						tagID,
-					),
-				)
-		}
-	}
-
-	createdAtOrder := desc
-	if filters != nil && filters.CreatedAtOrderByAsc != nil && *filters.CreatedAtOrderByAsc {
-		createdAtOrder = asc
-	}
-
-	builder = builder.
-		OrderBy(
-			fmt.Sprintf(
-				"%s.%s %s",
-				ticketsTableName,
-				createdAtColumnName,
-				createdAtOrder,
-			),
-		)
-
-	if pagination != nil && pagination.Limit != nil {
-		builder = builder.Limit(*pagination.Limit)
-	}
-
-	if pagination != nil && pagination.Offset != nil {
-		builder = builder.Offset(*pagination.Offset)
+	builder, err := newUserTicketsQuery(userID, filters, repo.searchConfig).selectBuilder(pagination)
+	if err != nil {
+		return nil, err
 	}
 
 	stmt, params, err := builder.ToSql()
@@ -747,23 +512,10 @@ func (repo *TicketsRepository) GetUserTickets(
 		return nil, err
 	}
 
-	// Reading Tags and Attachments for each Ticket in new circle due
+	// Reading Tags and Attachments after closing Tickets rows due
 	// to next error: https://github.com/lib/pq/issues/635
-	// Using ticket index to avoid range iter semantics error, via using copied variable.
-	for i, ticket := range tickets {
-		tagsIDs, err := repo.getTicketTagsIDs(ctx, ticket.ID, connection)
-		if err != nil {
-			return nil, err
-		}
-
-		tickets[i].TagIDs = tagsIDs
-
-		attachments, err := repo.getTicketAttachments(ctx, ticket.ID, connection)
-		if err != nil {
-			return nil, err
-		}
-
-		tickets[i].Attachments = attachments
+	if err = repo.processTicketsAssociations(ctx, tickets, connection); err != nil {
+		return nil, err
 	}
 
 	return tickets, nil
@@ -787,115 +539,7 @@ func (repo *TicketsRepository) CountUserTickets(
 
 	defer db.CloseConnectionContext(ctx, connection, repo.logger)
 
-	builder := sq.
-		Select(selectCount).
-		From(ticketsTableName).
-		Where(sq.Eq{userIDColumnName: userID}).
-		PlaceholderFormat(sq.Dollar)
-
-	if filters != nil && filters.Search != nil && *filters.Search != "" {
-		searchTerm := "%" + strings.ToLower(*filters.Search) + "%"
-		builder = builder.
-			Where(
-				sq.Or{
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							ticketsTableName,
-							ticketNameColumnName,
-						): searchTerm,
-					},
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							ticketsTableName,
-							ticketDescriptionColumnName,
-						): searchTerm,
-					},
-				},
-			)
-	}
-
-	if filters != nil && (filters.PriceFloor != nil || filters.PriceCeil != nil) {
-		priceConditions := sq.And{}
-		if filters.PriceFloor != nil {
-			priceConditions = append(
-				priceConditions,
-				sq.GtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						ticketsTableName,
-						ticketPriceColumnName,
-					): *filters.PriceFloor,
-				},
-			)
-		}
-
-		if filters.PriceCeil != nil {
-			priceConditions = append(
-				priceConditions,
-				sq.LtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						ticketsTableName,
-						ticketPriceColumnName,
-					): *filters.PriceCeil,
-				},
-			)
-		}
-
-		builder = builder.Where(priceConditions)
-	}
-
-	if filters != nil && filters.QuantityFloor != nil {
-		builder = builder.
-			Where(
-				sq.GtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						ticketsTableName,
-						ticketQuantityColumnName,
-					): *filters.QuantityFloor,
-				},
-			)
-	}
-
-	if filters != nil && filters.CategoryIDs != nil {
-		builder = builder.
-			Where(
-				sq.Eq{
-					fmt.Sprintf(
-						"%s.%s",
-						ticketsTableName,
-						categoryIDColumnName,
-					): filters.CategoryIDs,
-				},
-			)
-	}
-
-	if filters != nil && len(filters.TagIDs) > 0 {
-		for _, tagID := range filters.TagIDs {
-			builder = builder.
-				Where(
-					sq.Expr(
-						fmt.Sprintf(
-							"EXISTS (SELECT 1 FROM %s WHERE %s.%s = %s.%s AND %s.%s = ?)",
-							ticketsAndTagsAssociationTableName,
-							ticketsAndTagsAssociationTableName,
-							ticketIDColumnName,
-							ticketsTableName,
-							idColumnName,
-							ticketsAndTagsAssociationTableName,
-							tagIDColumnName,
-						),
-						tagID,
-					),
-				)
-		}
-	}
-
-	// Для запросов COUNT сортировка не нужна, поэтому параметр CreatedAtOrderByAsc не используется
-	stmt, params, err := builder.ToSql()
+	stmt, params, err := newUserTicketsQuery(userID, filters, repo.searchConfig).countBuilder().ToSql()
 	if err != nil {
 		return 0, err
 	}
@@ -908,19 +552,29 @@ func (repo *TicketsRepository) CountUserTickets(
 	return count, nil
 }
 
-func (repo *TicketsRepository) DeleteTicket(ctx context.Context, id uint64) error {
+// DeleteTicket deletes Ticket and saves provided outbox messages within single transaction.
+func (repo *TicketsRepository) DeleteTicket(
+	ctx context.Context,
+	id uint64,
+	outboxMessages ...entities.CreateOutboxMessageDTO,
+) error {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	transaction, err := repo.dbConnector.Transaction(ctx)
 	if err != nil {
 		return err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
 
 	stmt, params, err := sq.
 		Delete(ticketsTableName).
@@ -931,18 +585,25 @@ func (repo *TicketsRepository) DeleteTicket(ctx context.Context, id uint64) erro
 		return err
 	}
 
-	_, err = connection.ExecContext(
-		ctx,
-		stmt,
-		params...,
-	)
+	if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+		return err
+	}
+
+	if err = insertOutboxMessages(ctx, transaction, outboxMessages); err != nil {
+		return err
+	}
 
-	return err
+	return transaction.Commit()
 }
 
+// UpdateTicket updates Ticket with its Tags and Attachments and saves provided outbox messages
+// within single transaction. Current Tags and Attachments are compared with new ones inside transaction,
+// so concurrent updates can not be lost. Ticket is updated only if it has expected version.
+// Only fields, listed in update mask, are updated.
 func (repo *TicketsRepository) UpdateTicket(
 	ctx context.Context,
 	ticketData entities.UpdateTicketDTO,
+	outboxMessages ...entities.CreateOutboxMessageDTO,
 ) error {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
@@ -962,27 +623,40 @@ func (repo *TicketsRepository) UpdateTicket(
 		}
 	}()
 
+	// Conditional update locks Ticket row, so Tags and Attachments can not be changed concurrently
+	// until transaction is finished:
 	builder := sq.
 		Update(ticketsTableName).
-		Where(sq.Eq{idColumnName: ticketData.ID}).
-		Set(ticketPriceColumnName, ticketData.Price).
-		// Update every time, because field is nullable
+		Where(
+			sq.And{
+				sq.Eq{idColumnName: ticketData.ID},
+				sq.Eq{versionColumnName: ticketData.ExpectedVersion},
+			},
+		).
+		Set(updatedAtColumnName, sq.Expr("CURRENT_TIMESTAMP")).
+		Set(versionColumnName, sq.Expr(versionIncrementStatement)).
 		PlaceholderFormat(sq.Dollar)
 	// pq postgres driver works only with $ placeholders
 
-	if ticketData.CategoryID != nil {
+	if ticketData.CategoryID != nil && slices.Contains(ticketData.UpdateMask, entities.TicketUpdateFieldCategoryID) {
 		builder = builder.Set(categoryIDColumnName, ticketData.CategoryID)
 	}
 
-	if ticketData.Name != nil {
+	if ticketData.Name != nil && slices.Contains(ticketData.UpdateMask, entities.TicketUpdateFieldName) {
 		builder = builder.Set(ticketNameColumnName, ticketData.Name)
 	}
 
-	if ticketData.Description != nil {
+	if ticketData.Description != nil &&
+		slices.Contains(ticketData.UpdateMask, entities.TicketUpdateFieldDescription) {
 		builder = builder.Set(ticketDescriptionColumnName, ticketData.Description)
 	}
 
-	if ticketData.Quantity != nil {
+	// Price is nullable, so nil value clears it:
+	if slices.Contains(ticketData.UpdateMask, entities.TicketUpdateFieldPrice) {
+		builder = builder.Set(ticketPriceColumnName, ticketData.Price)
+	}
+
+	if ticketData.Quantity != nil && slices.Contains(ticketData.UpdateMask, entities.TicketUpdateFieldQuantity) {
 		builder = builder.Set(ticketQuantityColumnName, ticketData.Quantity)
 	}
 
@@ -991,85 +665,439 @@ func (repo *TicketsRepository) UpdateTicket(
 		return err
 	}
 
-	if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+	result, err := transaction.ExecContext(ctx, stmt, params...)
+	if err != nil {
 		return err
 	}
 
-	if len(ticketData.TagIDsToAdd) > 0 {
-		builder := sq.Insert(ticketsAndTagsAssociationTableName).
-			Columns(ticketIDColumnName, tagIDColumnName)
-		for _, tagID := range ticketData.TagIDsToAdd {
-			builder = builder.Values(ticketData.ID, tagID)
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &customerrors.VersionConflictError{
+			Message: fmt.Sprintf(
+				"ticket with ID=%d has been modified or deleted since version %d",
+				ticketData.ID,
+				ticketData.ExpectedVersion,
+			),
 		}
+	}
 
-		if stmt, params, err = builder.PlaceholderFormat(sq.Dollar).ToSql(); err != nil {
+	if slices.Contains(ticketData.UpdateMask, entities.TicketUpdateFieldTagIDs) {
+		if err = repo.updateTicketTags(ctx, transaction, ticketData.ID, ticketData.TagIDs); err != nil {
 			return err
 		}
+	}
 
-		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+	if slices.Contains(ticketData.UpdateMask, entities.TicketUpdateFieldAttachments) {
+		if err = repo.updateTicketAttachments(ctx, transaction, ticketData.ID, ticketData.Attachments); err != nil {
 			return err
 		}
 	}
 
-	if len(ticketData.TagIDsToDelete) > 0 {
-		stmt, params, err = sq.
-			Delete(ticketsAndTagsAssociationTableName).
-			Where(
-				sq.And{
-					sq.Eq{ticketIDColumnName: ticketData.ID},
-					sq.Eq{tagIDColumnName: ticketData.TagIDsToDelete},
-				},
-			).
-			PlaceholderFormat(sq.Dollar).
-			ToSql()
-		if err != nil {
-			return err
+	if err = insertOutboxMessages(ctx, transaction, outboxMessages); err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+// UpdateTicketStatus changes Ticket status only if it still equals to provided current status, so transition,
+// which has been checked by caller, can not be applied to the Ticket, changed by concurrent request.
+func (repo *TicketsRepository) UpdateTicketStatus(
+	ctx context.Context,
+	id uint64,
+	currentStatus, status entities.TicketStatus,
+) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Update(ticketsTableName).
+		Where(
+			sq.Eq{
+				idColumnName:           id,
+				ticketStatusColumnName: currentStatus,
+			},
+		).
+		Set(ticketStatusColumnName, status).
+		Set(updatedAtColumnName, sq.Expr("CURRENT_TIMESTAMP")).
+		Set(versionColumnName, sq.Expr(versionIncrementStatement)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := connection.ExecContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &customerrors.TicketStatusTransitionNotAllowedError{
+			Message: fmt.Sprintf(
+				"ticket with ID=%d is not in %s status anymore, so transition to %s is not allowed",
+				id,
+				currentStatus,
+				status,
+			),
 		}
+	}
 
-		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
-			return err
+	return nil
+}
+
+// UpdateTicketsCategory moves all Tickets of one Category to another and saves outbox messages for each moved
+// Ticket within single transaction. Returns IDs of moved Tickets. Operation is idempotent.
+func (repo *TicketsRepository) UpdateTicketsCategory(
+	ctx context.Context,
+	oldCategoryID, newCategoryID uint32,
+	outboxMessageBuilders ...entities.OutboxMessageBuilder,
+) ([]uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := repo.dbConnector.Transaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
 		}
+	}()
+
+	stmt, params, err := sq.
+		Update(ticketsTableName).
+		Where(sq.Eq{categoryIDColumnName: oldCategoryID}).
+		Set(categoryIDColumnName, newCategoryID).
+		Set(updatedAtColumnName, sq.Expr("CURRENT_TIMESTAMP")).
+		Set(versionColumnName, sq.Expr(versionIncrementStatement)).
+		Suffix(returningIDSuffix).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
 	}
 
-	if len(ticketData.AttachmentsToAdd) > 0 {
-		builder := sq.Insert(ticketsAttachmentsTableName).
-			Columns(ticketIDColumnName, attachmentLinkColumnName)
-		for _, attachment := range ticketData.AttachmentsToAdd {
-			builder = builder.Values(ticketData.ID, attachment)
+	ticketIDs, err := updateTicketsWithOutboxMessages(ctx, transaction, stmt, params, outboxMessageBuilders)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return nil, err
+	}
+
+	return ticketIDs, nil
+}
+
+// DeleteTagFromTickets removes Tag from all Tickets, which have it. Affected Tickets are marked as updated
+// and outbox messages for them are saved within the same transaction. Returns IDs of affected Tickets.
+// Operation is idempotent.
+func (repo *TicketsRepository) DeleteTagFromTickets(
+	ctx context.Context,
+	tagID uint32,
+	outboxMessageBuilders ...entities.OutboxMessageBuilder,
+) ([]uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := repo.dbConnector.Transaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
 		}
+	}()
 
-		if stmt, params, err = builder.PlaceholderFormat(sq.Dollar).ToSql(); err != nil {
-			return err
+	stmt, params, err := sq.
+		Update(ticketsTableName).
+		Where(
+			sq.Expr(
+				fmt.Sprintf(
+					"%s IN (SELECT %s FROM %s WHERE %s = ?)",
+					idColumnName,
+					ticketIDColumnName,
+					ticketsAndTagsAssociationTableName,
+					tagIDColumnName,
+				),
+				tagID,
+			),
+		).
+		Set(updatedAtColumnName, sq.Expr("CURRENT_TIMESTAMP")).
+		Set(versionColumnName, sq.Expr(versionIncrementStatement)).
+		Suffix(returningIDSuffix).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	ticketIDs, err := updateTicketsWithOutboxMessages(ctx, transaction, stmt, params, outboxMessageBuilders)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt, params, err = sq.
+		Delete(ticketsAndTagsAssociationTableName).
+		Where(sq.Eq{tagIDColumnName: tagID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+		return nil, err
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return nil, err
+	}
+
+	return ticketIDs, nil
+}
+
+// updateTicketsWithOutboxMessages executes update statement, which returns IDs of updated Tickets, and saves
+// outbox messages for each updated Ticket within provided transaction. IDs are returned in ascending order.
+func updateTicketsWithOutboxMessages(
+	ctx context.Context,
+	transaction *sql.Tx,
+	stmt string,
+	params []any,
+	outboxMessageBuilders []entities.OutboxMessageBuilder,
+) ([]uint64, error) {
+	rows, err := transaction.QueryContext(ctx, stmt, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	var ticketIDs []uint64
+	for rows.Next() {
+		var ticketID uint64
+		if err = rows.Scan(&ticketID); err != nil {
+			_ = rows.Close()
+
+			return nil, err
 		}
 
-		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
-			return err
+		ticketIDs = append(ticketIDs, ticketID)
+	}
+
+	// Rows must be closed before next statement within the same transaction:
+	if err = rows.Close(); err != nil {
+		return nil, err
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	slices.Sort(ticketIDs)
+
+	for _, ticketID := range ticketIDs {
+		outboxMessages, err := buildOutboxMessages(ticketID, outboxMessageBuilders)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = insertOutboxMessages(ctx, transaction, outboxMessages); err != nil {
+			return nil, err
 		}
 	}
 
-	if len(ticketData.AttachmentIDsToDelete) > 0 {
-		stmt, params, err = sq.
-			Delete(ticketsAttachmentsTableName).
-			Where(sq.Eq{idColumnName: ticketData.AttachmentIDsToDelete}).
+	return ticketIDs, nil
+}
+
+// DeleteUserData deletes all Tickets of User with their Tags, Attachments and Responds. Responds of User as
+// a Master are deleted as well. All data is deleted and summary outbox messages are saved within single
+// transaction. Operation is idempotent.
+func (repo *TicketsRepository) DeleteUserData(
+	ctx context.Context,
+	userData entities.DeleteUserDataDTO,
+	outboxMessageBuilders ...entities.DeletedUserDataOutboxMessageBuilder,
+) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := repo.dbConnector.Transaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	// Associations are deleted explicitly to not depend on foreign keys support of database driver:
+	userTicketsCondition := sq.Expr(
+		fmt.Sprintf(
+			"%s IN (SELECT %s FROM %s WHERE %s = ?)",
+			ticketIDColumnName,
+			idColumnName,
+			ticketsTableName,
+			userIDColumnName,
+		),
+		userData.UserID,
+	)
+
+	var respondsCondition sq.Sqlizer = userTicketsCondition
+	if userData.MasterID != nil {
+		respondsCondition = sq.Or{userTicketsCondition, sq.Eq{masterIDColumnName: *userData.MasterID}}
+	}
+
+	var deletedData entities.DeletedUserDataDTO
+	deletions := []struct {
+		tableName string
+		condition sq.Sqlizer
+		count     *uint64
+	}{
+		{tableName: respondsTableName, condition: respondsCondition, count: &deletedData.RespondsCount},
+		{
+			tableName: ticketsAttachmentsTableName,
+			condition: userTicketsCondition,
+			count:     &deletedData.AttachmentsCount,
+		},
+		{
+			tableName: ticketsAndTagsAssociationTableName,
+			condition: userTicketsCondition,
+			count:     &deletedData.TagsAssociationsCount,
+		},
+		{
+			tableName: ticketsTableName,
+			condition: sq.Eq{userIDColumnName: userData.UserID},
+			count:     &deletedData.TicketsCount,
+		},
+		// Idempotency keys are provided by User, so they are personal data too:
+		{
+			tableName: idempotencyKeysTableName,
+			condition: sq.Eq{userIDColumnName: userData.UserID},
+			count:     &deletedData.IdempotencyKeysCount,
+		},
+	}
+
+	for _, deletion := range deletions {
+		stmt, params, err := sq.
+			Delete(deletion.tableName).
+			Where(deletion.condition).
 			PlaceholderFormat(sq.Dollar).
 			ToSql()
 		if err != nil {
 			return err
 		}
 
-		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+		result, err := transaction.ExecContext(ctx, stmt, params...)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		*deletion.count = uint64(rowsAffected)
+	}
+
+	outboxMessages := make([]entities.CreateOutboxMessageDTO, 0, len(outboxMessageBuilders))
+	for _, build := range outboxMessageBuilders {
+		message, err := build(deletedData)
+		if err != nil {
 			return err
 		}
+
+		outboxMessages = append(outboxMessages, message)
+	}
+
+	if err = insertOutboxMessages(ctx, transaction, outboxMessages); err != nil {
+		return err
 	}
 
 	return transaction.Commit()
 }
 
-func (repo *TicketsRepository) getTicketTagsIDs(
+// processTicketsAssociations loads Tags and Attachments for all provided Tickets with one query per table
+// and sets them to Tickets in memory.
+func (repo *TicketsRepository) processTicketsAssociations(
 	ctx context.Context,
-	ticketID uint64,
+	tickets []entities.Ticket,
 	connection *sql.Conn,
-) ([]uint32, error) {
+) error {
+	if len(tickets) == 0 {
+		return nil
+	}
+
+	ticketsIDs := make([]uint64, len(tickets))
+	for i, ticket := range tickets {
+		ticketsIDs[i] = ticket.ID
+	}
+
+	ticketsTagsIDs, err := repo.getTicketsTagsIDs(ctx, ticketsIDs, connection)
+	if err != nil {
+		return err
+	}
+
+	ticketsAttachments, err := repo.getTicketsAttachments(ctx, ticketsIDs, connection)
+	if err != nil {
+		return err
+	}
+
+	// Using ticket index to avoid range iter semantics error, via using copied variable.
+	for i := range tickets {
+		tickets[i].TagIDs = ticketsTagsIDs[tickets[i].ID]
+		tickets[i].Attachments = ticketsAttachments[tickets[i].ID]
+	}
+
+	return nil
+}
+
+// getTicketsTagsIDs returns Tags IDs grouped by Ticket ID.
+func (repo *TicketsRepository) getTicketsTagsIDs(
+	ctx context.Context,
+	ticketsIDs []uint64,
+	connection queryer,
+) (map[uint64][]uint32, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
@@ -1077,9 +1105,10 @@ func (repo *TicketsRepository) getTicketTagsIDs(
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
 	stmt, params, err := sq.
-		Select(tagIDColumnName).
+		Select(ticketIDColumnName, tagIDColumnName).
 		From(ticketsAndTagsAssociationTableName).
-		Where(sq.Eq{ticketIDColumnName: ticketID}).
+		Where(sq.Eq{ticketIDColumnName: ticketsIDs}). // IN clause for compatibility with all drivers
+		OrderBy(fmt.Sprintf("%s %s", idColumnName, asc)).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -1106,31 +1135,35 @@ func (repo *TicketsRepository) getTicketTagsIDs(
 		}
 	}()
 
-	var tagIDs []uint32
+	ticketsTagsIDs := make(map[uint64][]uint32, len(ticketsIDs))
 
 	for rows.Next() {
-		var tagID uint32
+		var (
+			ticketID uint64
+			tagID    uint32
+		)
 
-		err = rows.Scan(&tagID)
+		err = rows.Scan(&ticketID, &tagID)
 		if err != nil {
 			return nil, err
 		}
 
-		tagIDs = append(tagIDs, tagID)
+		ticketsTagsIDs[ticketID] = append(ticketsTagsIDs[ticketID], tagID)
 	}
 
 	if err = rows.Err(); err != nil {
 		return nil, err
 	}
 
-	return tagIDs, err
+	return ticketsTagsIDs, nil
 }
 
-func (repo *TicketsRepository) getTicketAttachments(
+// getTicketsAttachments returns Attachments grouped by Ticket ID.
+func (repo *TicketsRepository) getTicketsAttachments(
 	ctx context.Context,
-	ticketID uint64,
-	connection *sql.Conn,
-) ([]entities.Attachment, error) {
+	ticketsIDs []uint64,
+	connection queryer,
+) (map[uint64][]entities.Attachment, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
@@ -1140,7 +1173,8 @@ func (repo *TicketsRepository) getTicketAttachments(
 	stmt, params, err := sq.
 		Select(selectAllColumns).
 		From(ticketsAttachmentsTableName).
-		Where(sq.Eq{ticketIDColumnName: ticketID}).
+		Where(sq.Eq{ticketIDColumnName: ticketsIDs}). // IN clause for compatibility with all drivers
+		OrderBy(fmt.Sprintf("%s %s", idColumnName, asc)).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -1167,7 +1201,7 @@ func (repo *TicketsRepository) getTicketAttachments(
 		}
 	}()
 
-	var attachments []entities.Attachment
+	ticketsAttachments := make(map[uint64][]entities.Attachment, len(ticketsIDs))
 
 	for rows.Next() {
 		var attachment entities.Attachment
@@ -1178,12 +1212,176 @@ func (repo *TicketsRepository) getTicketAttachments(
 			return nil, err
 		}
 
-		attachments = append(attachments, attachment)
+		ticketsAttachments[attachment.TicketID] = append(ticketsAttachments[attachment.TicketID], attachment)
 	}
 
 	if err = rows.Err(); err != nil {
 		return nil, err
 	}
 
-	return attachments, nil
+	return ticketsAttachments, nil
+}
+
+// updateTicketTags compares current Ticket Tags with new ones within transaction and applies difference.
+func (repo *TicketsRepository) updateTicketTags(
+	ctx context.Context,
+	transaction *sql.Tx,
+	ticketID uint64,
+	tagIDs []uint32,
+) error {
+	ticketsTagsIDs, err := repo.getTicketsTagsIDs(ctx, []uint64{ticketID}, transaction)
+	if err != nil {
+		return err
+	}
+
+	tagIDsToAdd, tagIDsToDelete := diffTagIDs(ticketsTagsIDs[ticketID], tagIDs)
+	if len(tagIDsToAdd) > 0 {
+		builder := sq.Insert(ticketsAndTagsAssociationTableName).
+			Columns(ticketIDColumnName, tagIDColumnName)
+		for _, tagID := range tagIDsToAdd {
+			builder = builder.Values(ticketID, tagID)
+		}
+
+		stmt, params, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+			return err
+		}
+	}
+
+	if len(tagIDsToDelete) > 0 {
+		stmt, params, err := sq.
+			Delete(ticketsAndTagsAssociationTableName).
+			Where(
+				sq.And{
+					sq.Eq{ticketIDColumnName: ticketID},
+					sq.Eq{tagIDColumnName: tagIDsToDelete},
+				},
+			).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateTicketAttachments compares current Ticket Attachments with new ones within transaction and applies difference.
+func (repo *TicketsRepository) updateTicketAttachments(
+	ctx context.Context,
+	transaction *sql.Tx,
+	ticketID uint64,
+	attachments []string,
+) error {
+	ticketsAttachments, err := repo.getTicketsAttachments(ctx, []uint64{ticketID}, transaction)
+	if err != nil {
+		return err
+	}
+
+	attachmentsToAdd, attachmentIDsToDelete := diffAttachments(ticketsAttachments[ticketID], attachments)
+	if len(attachmentsToAdd) > 0 {
+		builder := sq.Insert(ticketsAttachmentsTableName).
+			Columns(ticketIDColumnName, attachmentLinkColumnName)
+		for _, attachment := range attachmentsToAdd {
+			builder = builder.Values(ticketID, attachment)
+		}
+
+		stmt, params, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+			return err
+		}
+	}
+
+	if len(attachmentIDsToDelete) > 0 {
+		stmt, params, err := sq.
+			Delete(ticketsAttachmentsTableName).
+			Where(sq.Eq{idColumnName: attachmentIDsToDelete}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// queryer is implemented by both *sql.Conn and *sql.Tx, so associations can be read within transaction.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// diffTagIDs returns Tags to be added to Ticket and Tags, which are not used by Ticket anymore.
+func diffTagIDs(oldTagIDs, newTagIDs []uint32) (tagIDsToAdd, tagIDsToDelete []uint32) {
+	oldTagIDsSet := make(map[uint32]struct{}, len(oldTagIDs))
+	for _, tagID := range oldTagIDs {
+		oldTagIDsSet[tagID] = struct{}{}
+	}
+
+	newTagIDsSet := make(map[uint32]struct{}, len(newTagIDs))
+	for _, tagID := range newTagIDs {
+		newTagIDsSet[tagID] = struct{}{}
+	}
+
+	for _, tagID := range newTagIDs {
+		if _, ok := oldTagIDsSet[tagID]; !ok {
+			tagIDsToAdd = append(tagIDsToAdd, tagID)
+		}
+	}
+
+	for _, tagID := range oldTagIDs {
+		if _, ok := newTagIDsSet[tagID]; !ok {
+			tagIDsToDelete = append(tagIDsToDelete, tagID)
+		}
+	}
+
+	return tagIDsToAdd, tagIDsToDelete
+}
+
+// diffAttachments returns links of Attachments to be added to Ticket and IDs of Attachments,
+// which are not used by Ticket anymore.
+func diffAttachments(
+	oldAttachments []entities.Attachment,
+	newAttachments []string,
+) (attachmentsToAdd []string, attachmentIDsToDelete []uint64) {
+	oldAttachmentsSet := make(map[string]struct{}, len(oldAttachments))
+	for _, attachment := range oldAttachments {
+		oldAttachmentsSet[attachment.Link] = struct{}{}
+	}
+
+	newAttachmentsSet := make(map[string]struct{}, len(newAttachments))
+	for _, attachment := range newAttachments {
+		newAttachmentsSet[attachment] = struct{}{}
+	}
+
+	for _, attachment := range newAttachments {
+		if _, ok := oldAttachmentsSet[attachment]; !ok {
+			attachmentsToAdd = append(attachmentsToAdd, attachment)
+		}
+	}
+
+	for _, attachment := range oldAttachments {
+		if _, ok := newAttachmentsSet[attachment.Link]; !ok {
+			attachmentIDsToDelete = append(attachmentIDsToDelete, attachment.ID)
+		}
+	}
+
+	return attachmentsToAdd, attachmentIDsToDelete
 }
@@ -0,0 +1,187 @@
+//go:build integration
+
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	sqldriver "database/sql/driver"
+	"fmt"
+	"os"
+	"path"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	"github.com/DKhorkov/hmtm-tickets/internal/repositories"
+	"github.com/DKhorkov/libs/db"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/pointers"
+	"github.com/DKhorkov/libs/tracing"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+)
+
+const countingDriver = "sqlite3_counting"
+
+// queriesCounter counts all queries, executed via countingDriver connections.
+var queriesCounter atomic.Int64
+
+func init() {
+	sql.Register(countingDriver, &queriesCountingDriver{Driver: &sqlite3.SQLiteDriver{}})
+}
+
+type queriesCountingDriver struct {
+	sqldriver.Driver
+}
+
+func (d *queriesCountingDriver) Open(name string) (sqldriver.Conn, error) {
+	connection, err := d.Driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queriesCountingConn{Conn: connection}, nil
+}
+
+type queriesCountingConn struct {
+	sqldriver.Conn
+}
+
+func (c *queriesCountingConn) QueryContext(
+	ctx context.Context,
+	query string,
+	args []sqldriver.NamedValue,
+) (sqldriver.Rows, error) {
+	queriesCounter.Add(1)
+
+	return c.Conn.(sqldriver.QueryerContext).QueryContext(ctx, query, args)
+}
+
+func (c *queriesCountingConn) ExecContext(
+	ctx context.Context,
+	query string,
+	args []sqldriver.NamedValue,
+) (sqldriver.Result, error) {
+	queriesCounter.Add(1)
+
+	return c.Conn.(sqldriver.ExecerContext).ExecContext(ctx, query, args)
+}
+
+func (c *queriesCountingConn) BeginTx(ctx context.Context, opts sqldriver.TxOptions) (sqldriver.Tx, error) {
+	return c.Conn.(sqldriver.ConnBeginTx).BeginTx(ctx, opts)
+}
+
+// BenchmarkTicketsRepository_GetTickets reports "queries/op" metric, which must not depend on page size,
+// because Tags and Attachments are loaded for all Tickets at once.
+func BenchmarkTicketsRepository_GetTickets(b *testing.B) {
+	for _, ticketsCount := range []int{10, 100} {
+		b.Run(fmt.Sprintf("tickets=%d", ticketsCount), func(b *testing.B) {
+			ticketsRepository := setupTicketsBenchmark(b, ticketsCount)
+			pagination := &entities.Pagination{Limit: pointers.New(uint64(ticketsCount))}
+
+			queriesCounter.Store(0)
+			b.ResetTimer()
+
+			for range b.N {
+				tickets, err := ticketsRepository.GetTickets(context.Background(), pagination, nil)
+				require.NoError(b, err)
+				require.Len(b, tickets, ticketsCount)
+			}
+
+			b.StopTimer()
+			b.ReportMetric(float64(queriesCounter.Load())/float64(b.N), "queries/op")
+		})
+	}
+}
+
+// BenchmarkTicketsRepository_GetUserTickets reports "queries/op" metric, which must not depend on page size,
+// because Tags and Attachments are loaded for all Tickets at once.
+func BenchmarkTicketsRepository_GetUserTickets(b *testing.B) {
+	for _, ticketsCount := range []int{10, 100} {
+		b.Run(fmt.Sprintf("tickets=%d", ticketsCount), func(b *testing.B) {
+			ticketsRepository := setupTicketsBenchmark(b, ticketsCount)
+			pagination := &entities.Pagination{Limit: pointers.New(uint64(ticketsCount))}
+
+			queriesCounter.Store(0)
+			b.ResetTimer()
+
+			for range b.N {
+				tickets, err := ticketsRepository.GetUserTickets(context.Background(), 1, pagination, nil)
+				require.NoError(b, err)
+				require.Len(b, tickets, ticketsCount)
+			}
+
+			b.StopTimer()
+			b.ReportMetric(float64(queriesCounter.Load())/float64(b.N), "queries/op")
+		})
+	}
+}
+
+func setupTicketsBenchmark(b *testing.B, ticketsCount int) *repositories.TicketsRepository {
+	b.Helper()
+
+	ctrl := gomock.NewController(b)
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	traceProvider := mocktracing.NewMockProvider(ctrl)
+	traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		AnyTimes()
+
+	connector, err := db.New(dsn, countingDriver, logger)
+	require.NoError(b, err)
+
+	cwd, err := os.Getwd()
+	require.NoError(b, err)
+
+	require.NoError(b, goose.SetDialect(driver))
+	migrationsPath := path.Dir(path.Dir(cwd)) + migrationsDir
+	require.NoError(b, goose.Up(connector.Pool(), migrationsPath))
+
+	b.Cleanup(func() {
+		require.NoError(b, goose.DownTo(connector.Pool(), migrationsPath, gooseZeroVersion))
+		require.NoError(b, connector.Close())
+	})
+
+	createdAt := time.Now().UTC()
+	for i := 1; i <= ticketsCount; i++ {
+		_, err = connector.Pool().Exec(
+			"INSERT INTO tickets (id, user_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+				"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+			i, 1, 1, fmt.Sprintf("Ticket %d", i), "Description", 100, 1, createdAt, createdAt,
+		)
+		require.NoError(b, err)
+
+		_, err = connector.Pool().Exec(
+			"INSERT INTO tickets_tags_associations (id, ticket_id, tag_id) VALUES (?, ?, ?), (?, ?, ?)",
+			2*i-1, i, 1,
+			2*i, i, 2,
+		)
+		require.NoError(b, err)
+
+		_, err = connector.Pool().Exec(
+			"INSERT INTO tickets_attachments (id, ticket_id, link, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+			i, i, fmt.Sprintf("file%d.jpg", i), createdAt, createdAt,
+		)
+		require.NoError(b, err)
+	}
+
+	return repositories.NewTicketsRepository(
+		connector,
+		logger,
+		traceProvider,
+		tracing.SpanConfig{},
+		config.SearchConfig{},
+		config.IdempotencyConfig{},
+	)
+}
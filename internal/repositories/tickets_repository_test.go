@@ -5,6 +5,7 @@ package repositories_test
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"github.com/pressly/goose/v3"
 	"os"
 	"path"
@@ -13,7 +14,9 @@ import (
 
 	_ "github.com/mattn/go-sqlite3" // Must be imported for correct work
 
+	"github.com/DKhorkov/hmtm-tickets/internal/config"
 	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
 	"github.com/DKhorkov/hmtm-tickets/internal/repositories"
 	"github.com/DKhorkov/libs/db"
 	mocklogging "github.com/DKhorkov/libs/logging/mocks"
@@ -57,7 +60,14 @@ func (s *TicketsRepositoryTestSuite) SetupSuite() {
 	s.dbConnector = dbConnector
 	s.traceProvider = mocktracing.NewMockProvider(ctrl)
 	s.spanConfig = tracing.SpanConfig{}
-	s.ticketsRepository = repositories.NewTicketsRepository(s.dbConnector, s.logger, s.traceProvider, s.spanConfig)
+	s.ticketsRepository = repositories.NewTicketsRepository(
+		s.dbConnector,
+		s.logger,
+		s.traceProvider,
+		s.spanConfig,
+		config.SearchConfig{},
+		config.IdempotencyConfig{KeysTTL: time.Hour},
+	)
 }
 
 func (s *TicketsRepositoryTestSuite) SetupTest() {
@@ -146,7 +156,7 @@ func (s *TicketsRepositoryTestSuite) TestGetTicketByIDExisting() {
 		EXPECT().
 		Span(gomock.Any(), gomock.Any()).
 		Return(context.Background(), mocktracing.NewMockSpan()).
-		Times(3) // Основной + getTicketTagsIDs + getTicketAttachments
+		Times(3) // Основной + getTicketsTagsIDs + getTicketsAttachments
 
 	createdAt := time.Now().UTC()
 	price := pointers.New[float32](99.99)
@@ -209,7 +219,7 @@ func (s *TicketsRepositoryTestSuite) TestGetTicketsWithExistingTickets() {
 		EXPECT().
 		Span(gomock.Any(), gomock.Any()).
 		Return(context.Background(), mocktracing.NewMockSpan()).
-		Times(5) // Основной + 2x(getTicketTagsIDs + getTicketAttachments)
+		Times(3) // Основной + getTicketsTagsIDs + getTicketsAttachments для всех Tickets сразу
 
 	createdAt := time.Now().UTC()
 	price1 := pointers.New[float32](99.99)
@@ -223,10 +233,105 @@ func (s *TicketsRepositoryTestSuite) TestGetTicketsWithExistingTickets() {
 	)
 	s.NoError(err)
 
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets_tags_associations (id, ticket_id, tag_id) VALUES "+
+			"(?, ?, ?), (?, ?, ?), (?, ?, ?)",
+		1, 1, 10,
+		2, 2, 20,
+		3, 2, 30,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets_attachments (id, ticket_id, link, created_at, updated_at) VALUES "+
+			"(?, ?, ?, ?, ?)",
+		1, 2, "file2.jpg", createdAt, createdAt,
+	)
+	s.NoError(err)
+
 	tickets, err := s.ticketsRepository.GetTickets(s.ctx, nil, nil)
 	s.NoError(err)
 	s.NotEmpty(tickets)
 	s.Equal(2, len(tickets))
+
+	// Associations must be stitched to corresponding Tickets:
+	ticketsByID := make(map[uint64]entities.Ticket, len(tickets))
+	for _, ticket := range tickets {
+		ticketsByID[ticket.ID] = ticket
+	}
+
+	s.Equal([]uint32{10}, ticketsByID[1].TagIDs)
+	s.Empty(ticketsByID[1].Attachments)
+	s.Equal([]uint32{20, 30}, ticketsByID[2].TagIDs)
+	s.Len(ticketsByID[2].Attachments, 1)
+	s.Equal("file2.jpg", ticketsByID[2].Attachments[0].Link)
+}
+
+func (s *TicketsRepositoryTestSuite) TestGetTicketsByIDs() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(3) // Основной + getTicketsTagsIDs + getTicketsAttachments для всех Tickets сразу
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets (id, user_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Ticket 1", "Desc 1", 99.99, 5, createdAt, createdAt,
+		2, 2, 3, "Ticket 2", "Desc 2", 49.99, 3, createdAt, createdAt,
+		3, 2, 3, "Ticket 3", "Desc 3", 19.99, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets_tags_associations (id, ticket_id, tag_id) VALUES "+
+			"(?, ?, ?), (?, ?, ?), (?, ?, ?)",
+		1, 1, 10,
+		2, 2, 20,
+		3, 3, 30,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets_attachments (id, ticket_id, link, created_at, updated_at) VALUES "+
+			"(?, ?, ?, ?, ?)",
+		1, 2, "file2.jpg", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	tickets, err := s.ticketsRepository.GetTicketsByIDs(s.ctx, []uint64{2, 999, 1})
+	s.NoError(err)
+	s.Len(tickets, 2)
+
+	// Associations must be stitched to corresponding Tickets:
+	ticketsByID := make(map[uint64]entities.Ticket, len(tickets))
+	for _, ticket := range tickets {
+		ticketsByID[ticket.ID] = ticket
+	}
+
+	s.Equal([]uint32{10}, ticketsByID[1].TagIDs)
+	s.Empty(ticketsByID[1].Attachments)
+	s.Equal([]uint32{20}, ticketsByID[2].TagIDs)
+	s.Len(ticketsByID[2].Attachments, 1)
+	s.Equal("file2.jpg", ticketsByID[2].Attachments[0].Link)
+}
+
+func (s *TicketsRepositoryTestSuite) TestGetTicketsByIDsWithoutIDs() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	tickets, err := s.ticketsRepository.GetTicketsByIDs(s.ctx, nil)
+	s.NoError(err)
+	s.Empty(tickets)
 }
 
 func (s *TicketsRepositoryTestSuite) TestGetTicketsWithExistingTicketsAndPagination() {
@@ -256,6 +361,126 @@ func (s *TicketsRepositoryTestSuite) TestGetTicketsWithExistingTicketsAndPaginat
 	s.Empty(tickets)
 }
 
+func (s *TicketsRepositoryTestSuite) TestGetTicketsWithCursorPagination() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(9) // 3x(Основной + getTicketsTagsIDs + getTicketsAttachments)
+
+	// Tickets 2 and 3 have the same creation time, so ID is used as tiebreaker:
+	createdAt := time.Now().UTC().Truncate(time.Second)
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets (id, user_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?), "+
+			"(?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Ticket 1", "Desc 1", nil, 1, createdAt.Add(-3*time.Second), createdAt,
+		2, 1, 2, "Ticket 2", "Desc 2", nil, 1, createdAt.Add(-2*time.Second), createdAt,
+		3, 1, 2, "Ticket 3", "Desc 3", nil, 1, createdAt.Add(-2*time.Second), createdAt,
+		4, 1, 2, "Ticket 4", "Desc 4", nil, 1, createdAt.Add(-time.Second), createdAt,
+		5, 1, 2, "Ticket 5", "Desc 5", nil, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	var ticketsIDs []uint64
+	pagination := &entities.Pagination{Limit: pointers.New[uint64](2)}
+	for range 3 {
+		tickets, err := s.ticketsRepository.GetTickets(s.ctx, pagination, nil)
+		s.NoError(err)
+
+		for _, ticket := range tickets {
+			ticketsIDs = append(ticketsIDs, ticket.ID)
+		}
+
+		lastTicket := tickets[len(tickets)-1]
+		pagination.Cursor = &entities.Cursor{CreatedAt: lastTicket.CreatedAt, ID: lastTicket.ID}
+	}
+
+	s.Equal([]uint64{5, 4, 3, 2, 1}, ticketsIDs)
+}
+
+func (s *TicketsRepositoryTestSuite) TestGetTicketsWithSort() {
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets (id, user_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?), "+
+			"(?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "B", "Desc 1", 50, 1, createdAt, createdAt,
+		2, 1, 2, "A", "Desc 2", nil, 1, createdAt, createdAt,
+		3, 1, 2, "C", "Desc 3", 150, 2, createdAt, createdAt,
+		4, 1, 2, "D", "Desc 4", 50, 2, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, comment, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?)",
+		1, 4, 1, 100, "Comment", createdAt, createdAt,
+		2, 4, 2, 100, "Comment", createdAt, createdAt,
+		3, 1, 1, 100, "Comment", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	testCases := []struct {
+		name     string
+		sort     []entities.TicketsSort
+		expected []uint64
+	}{
+		{
+			name: "price ascending with nulls last",
+			sort: []entities.TicketsSort{
+				{Field: entities.TicketsSortFieldPrice, Direction: entities.SortDirectionAsc},
+			},
+			expected: []uint64{4, 1, 3, 2}, // same price Tickets are ordered by ID
+		},
+		{
+			name: "price descending with nulls last",
+			sort: []entities.TicketsSort{
+				{Field: entities.TicketsSortFieldPrice, Direction: entities.SortDirectionDesc},
+			},
+			expected: []uint64{3, 4, 1, 2},
+		},
+		{
+			name: "quantity and name",
+			sort: []entities.TicketsSort{
+				{Field: entities.TicketsSortFieldQuantity, Direction: entities.SortDirectionDesc},
+				{Field: entities.TicketsSortFieldName, Direction: entities.SortDirectionAsc},
+			},
+			expected: []uint64{3, 4, 2, 1},
+		},
+		{
+			name: "responds count",
+			sort: []entities.TicketsSort{
+				{Field: entities.TicketsSortFieldRespondsCount, Direction: entities.SortDirectionDesc},
+			},
+			expected: []uint64{4, 1, 3, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.traceProvider.
+				EXPECT().
+				Span(gomock.Any(), gomock.Any()).
+				Return(context.Background(), mocktracing.NewMockSpan()).
+				Times(3) // Основной + getTicketsTagsIDs + getTicketsAttachments
+
+			tickets, err := s.ticketsRepository.GetTickets(s.ctx, nil, &entities.TicketsFilters{Sort: tc.sort})
+			s.NoError(err)
+
+			ticketsIDs := make([]uint64, len(tickets))
+			for i, ticket := range tickets {
+				ticketsIDs[i] = ticket.ID
+			}
+
+			s.Equal(tc.expected, ticketsIDs)
+		})
+	}
+}
+
 func (s *TicketsRepositoryTestSuite) TestGetTicketsWithExistingTicketsAndFilters() {
 	s.traceProvider.
 		EXPECT().
@@ -291,7 +516,7 @@ func (s *TicketsRepositoryTestSuite) TestGetTicketsWithExistingTicketsAndFilters
 	s.NoError(err)
 
 	filters := &entities.TicketsFilters{
-		//Search:              pointers.New("ticket"), // no ILike in sqlite
+		Search:              pointers.New("TICKET"), // case-insensitive fallback search in sqlite
 		PriceCeil:           pointers.New[float32](1000),
 		PriceFloor:          pointers.New[float32](10),
 		QuantityFloor:       pointers.New[uint32](1),
@@ -331,7 +556,7 @@ func (s *TicketsRepositoryTestSuite) TestGetUserTicketsWithExistingTickets() {
 		EXPECT().
 		Span(gomock.Any(), gomock.Any()).
 		Return(context.Background(), mocktracing.NewMockSpan()).
-		Times(5) // Основной + 2x(getTicketTagsIDs + getTicketAttachments)
+		Times(3) // Основной + getTicketsTagsIDs + getTicketsAttachments для всех Tickets сразу
 
 	userID := uint64(1)
 	createdAt := time.Now().UTC()
@@ -427,7 +652,7 @@ func (s *TicketsRepositoryTestSuite) TestGetUserTicketsWithExistingTicketsAndFil
 	s.NoError(err)
 
 	filters := &entities.TicketsFilters{
-		//Search:              pointers.New("ticket"), // no ILike in sqlite
+		Search:              pointers.New("TICKET"), // case-insensitive fallback search in sqlite
 		PriceCeil:           pointers.New[float32](1000),
 		PriceFloor:          pointers.New[float32](10),
 		QuantityFloor:       pointers.New[uint32](1),
@@ -457,6 +682,11 @@ func (s *TicketsRepositoryTestSuite) TestDeleteTicketSuccess() {
 		Return(context.Background(), mocktracing.NewMockSpan()).
 		Times(1)
 
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
 	createdAt := time.Now().UTC()
 	price := pointers.New[float32](99.99)
 	_, err := s.connection.ExecContext(
@@ -466,7 +696,11 @@ func (s *TicketsRepositoryTestSuite) TestDeleteTicketSuccess() {
 	)
 	s.NoError(err)
 
-	err = s.ticketsRepository.DeleteTicket(s.ctx, 1)
+	err = s.ticketsRepository.DeleteTicket(
+		s.ctx,
+		1,
+		entities.CreateOutboxMessageDTO{Subject: "ticket-deleted", Payload: []byte(`{"ticketOwnerID":1}`)},
+	)
 	s.NoError(err)
 
 	rows, err := s.connection.QueryContext(
@@ -480,15 +714,27 @@ func (s *TicketsRepositoryTestSuite) TestDeleteTicketSuccess() {
 	}()
 
 	s.False(rows.Next())
+
+	// Outbox message must be saved within the same transaction:
+	var subject string
+	s.NoError(
+		s.connection.QueryRowContext(
+			s.ctx,
+			"SELECT subject FROM outbox WHERE sent_at IS NULL",
+		).Scan(&subject),
+	)
+	s.Equal("ticket-deleted", subject)
 }
 
 func (s *TicketsRepositoryTestSuite) TestUpdateTicketFullUpdateSuccess() {
+	// UpdateTicket, getTicketsTagsIDs and getTicketsAttachments spans:
 	s.traceProvider.
 		EXPECT().
 		Span(gomock.Any(), gomock.Any()).
 		Return(context.Background(), mocktracing.NewMockSpan()).
-		Times(1)
+		Times(3)
 
+	// Rollback after successful commit:
 	s.logger.
 		EXPECT().
 		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -506,16 +752,18 @@ func (s *TicketsRepositoryTestSuite) TestUpdateTicketFullUpdateSuccess() {
 	_, err = s.connection.ExecContext(
 		s.ctx,
 		"INSERT INTO tickets_tags_associations (id, ticket_id, tag_id) "+
-			"VALUES (?, ?, ?)",
+			"VALUES (?, ?, ?), (?, ?, ?)",
 		1, 1, 10,
+		2, 1, 30,
 	)
 	s.NoError(err)
 
 	_, err = s.connection.ExecContext(
 		s.ctx,
 		"INSERT INTO tickets_attachments (id, ticket_id, link, created_at, updated_at) "+
-			"VALUES (?, ?, ?, ?, ?)",
+			"VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)",
 		1, 1, "oldfile.jpg", createdAt, createdAt,
+		2, 1, "keptfile.jpg", createdAt, createdAt,
 	)
 	s.NoError(err)
 
@@ -525,16 +773,24 @@ func (s *TicketsRepositoryTestSuite) TestUpdateTicketFullUpdateSuccess() {
 	newPrice := pointers.New[float32](150.00)
 	newQuantity := uint32(10)
 	ticketData := entities.UpdateTicketDTO{
-		ID:                    1,
-		CategoryID:            &newCategoryID,
-		Name:                  &newName,
-		Description:           &newDesc,
-		Price:                 newPrice,
-		Quantity:              &newQuantity,
-		TagIDsToAdd:           []uint32{30, 40},
-		TagIDsToDelete:        []uint32{10},
-		AttachmentsToAdd:      []string{"newfile.jpg"},
-		AttachmentIDsToDelete: []uint64{1},
+		ID:              1,
+		CategoryID:      &newCategoryID,
+		Name:            &newName,
+		Description:     &newDesc,
+		Price:           newPrice,
+		Quantity:        &newQuantity,
+		TagIDs:          []uint32{30, 40},
+		Attachments:     []string{"keptfile.jpg", "newfile.jpg"},
+		ExpectedVersion: 1,
+		UpdateMask: []entities.TicketUpdateField{
+			entities.TicketUpdateFieldCategoryID,
+			entities.TicketUpdateFieldName,
+			entities.TicketUpdateFieldDescription,
+			entities.TicketUpdateFieldPrice,
+			entities.TicketUpdateFieldQuantity,
+			entities.TicketUpdateFieldTagIDs,
+			entities.TicketUpdateFieldAttachments,
+		},
 	}
 
 	err = s.ticketsRepository.UpdateTicket(s.ctx, ticketData)
@@ -543,7 +799,7 @@ func (s *TicketsRepositoryTestSuite) TestUpdateTicketFullUpdateSuccess() {
 	// Проверка tickets
 	rows, err := s.connection.QueryContext(
 		s.ctx,
-		"SELECT category_id, name, description, price, quantity FROM tickets WHERE id = ?",
+		"SELECT category_id, name, description, price, quantity, version FROM tickets WHERE id = ?",
 		1,
 	)
 	s.NoError(err)
@@ -557,13 +813,505 @@ func (s *TicketsRepositoryTestSuite) TestUpdateTicketFullUpdateSuccess() {
 	var name, description string
 	var priceVal sql.NullFloat64
 	var quantity uint32
-	s.NoError(rows.Scan(&categoryID, &name, &description, &priceVal, &quantity))
+	var version uint64
+	s.NoError(rows.Scan(&categoryID, &name, &description, &priceVal, &quantity, &version))
 	s.Equal(newCategoryID, categoryID)
 	s.Equal(newName, name)
 	s.Equal(newDesc, description)
 	s.True(priceVal.Valid)
 	s.InDelta(*newPrice, priceVal.Float64, 0.01)
 	s.Equal(newQuantity, quantity)
+	s.Equal(uint64(2), version)
+	s.NoError(rows.Close())
+
+	// Проверка тегов
+	tagsRows, err := s.connection.QueryContext(
+		s.ctx,
+		"SELECT tag_id FROM tickets_tags_associations WHERE ticket_id = ? ORDER BY tag_id",
+		1,
+	)
+	s.NoError(err)
+
+	defer func() {
+		s.NoError(tagsRows.Close())
+	}()
+
+	var tagIDs []uint32
+	for tagsRows.Next() {
+		var tagID uint32
+		s.NoError(tagsRows.Scan(&tagID))
+		tagIDs = append(tagIDs, tagID)
+	}
+
+	s.Equal([]uint32{30, 40}, tagIDs)
+	s.NoError(tagsRows.Close())
+
+	// Проверка вложений
+	attachmentsRows, err := s.connection.QueryContext(
+		s.ctx,
+		"SELECT link FROM tickets_attachments WHERE ticket_id = ? ORDER BY link",
+		1,
+	)
+	s.NoError(err)
+
+	defer func() {
+		s.NoError(attachmentsRows.Close())
+	}()
+
+	var links []string
+	for attachmentsRows.Next() {
+		var link string
+		s.NoError(attachmentsRows.Scan(&link))
+		links = append(links, link)
+	}
+
+	// Kept attachment must not be recreated:
+	var keptLink string
+	row := s.connection.QueryRowContext(s.ctx, "SELECT link FROM tickets_attachments WHERE id = ?", 2)
+	s.NoError(row.Scan(&keptLink))
+	s.Equal("keptfile.jpg", keptLink)
+
+	s.Equal([]string{"keptfile.jpg", "newfile.jpg"}, links)
+}
+
+func (s *TicketsRepositoryTestSuite) TestUpdateTicketPartialUpdateSuccess() {
+	// Tags and Attachments are not listed in mask, so they are not read:
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	// Rollback after successful commit:
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets (id, user_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Ticket", "Desc", 100.00, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets_tags_associations (id, ticket_id, tag_id) VALUES (?, ?, ?)",
+		1, 1, 10,
+	)
+	s.NoError(err)
+
+	// Price is cleared, while name, Tags and Attachments are not listed in mask and stay untouched:
+	ticketData := entities.UpdateTicketDTO{
+		ID:              1,
+		Name:            pointers.New("Ignored"),
+		ExpectedVersion: 1,
+		UpdateMask:      []entities.TicketUpdateField{entities.TicketUpdateFieldPrice},
+	}
+
+	err = s.ticketsRepository.UpdateTicket(s.ctx, ticketData)
+	s.NoError(err)
+
+	var name string
+	var price sql.NullFloat64
+	row := s.connection.QueryRowContext(s.ctx, "SELECT name, price FROM tickets WHERE id = ?", 1)
+	s.NoError(row.Scan(&name, &price))
+	s.Equal("Ticket", name)
+	s.False(price.Valid)
+
+	var tagsCount int
+	row = s.connection.QueryRowContext(
+		s.ctx,
+		"SELECT COUNT(*) FROM tickets_tags_associations WHERE ticket_id = ?",
+		1,
+	)
+	s.NoError(row.Scan(&tagsCount))
+	s.Equal(1, tagsCount)
+}
+
+func (s *TicketsRepositoryTestSuite) TestUpdateTicketVersionConflict() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets (id, user_id, category_id, name, description, price, quantity, created_at, updated_at, version) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Ticket", "Desc", nil, 1, createdAt, createdAt, 2,
+	)
+	s.NoError(err)
+
+	ticketData := entities.UpdateTicketDTO{
+		ID:              1,
+		Name:            pointers.New("Lost Update"),
+		ExpectedVersion: 1,
+	}
+
+	err = s.ticketsRepository.UpdateTicket(s.ctx, ticketData)
+	s.Error(err)
+	s.IsType(&customerrors.VersionConflictError{}, err)
+
+	var name string
+	var version uint64
+	row := s.connection.QueryRowContext(s.ctx, "SELECT name, version FROM tickets WHERE id = ?", 1)
+	s.NoError(row.Scan(&name, &version))
+	s.Equal("Ticket", name)
+	s.Equal(uint64(2), version)
+}
+
+func (s *TicketsRepositoryTestSuite) TestUpdateTicketStatusSuccess() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets (id, user_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Ticket", "Desc", nil, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	err = s.ticketsRepository.UpdateTicketStatus(s.ctx, 1, entities.TicketStatusOpen, entities.TicketStatusInProgress)
+	s.NoError(err)
+
+	rows, err := s.connection.QueryContext(
+		s.ctx,
+		"SELECT status FROM tickets WHERE id = ?",
+		1,
+	)
+	s.NoError(err)
+
+	defer func() {
+		s.NoError(rows.Close())
+	}()
+
+	s.True(rows.Next())
+	var status string
+	s.NoError(rows.Scan(&status))
+	s.Equal(string(entities.TicketStatusInProgress), status)
+}
+
+func (s *TicketsRepositoryTestSuite) TestUpdateTicketStatusChangedConcurrently() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets (id, user_id, category_id, name, description, price, quantity, created_at, updated_at, "+
+			"status) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Ticket", "Desc", nil, 1, createdAt, createdAt, entities.TicketStatusCancelled,
+	)
+	s.NoError(err)
+
+	// Ticket has been cancelled after caller checked transition from open status:
+	err = s.ticketsRepository.UpdateTicketStatus(s.ctx, 1, entities.TicketStatusOpen, entities.TicketStatusInProgress)
+
+	var transitionNotAllowedError *customerrors.TicketStatusTransitionNotAllowedError
+	s.ErrorAs(err, &transitionNotAllowedError)
+
+	var status string
+	s.NoError(s.connection.QueryRowContext(s.ctx, "SELECT status FROM tickets WHERE id = ?", 1).Scan(&status))
+	s.Equal(string(entities.TicketStatusCancelled), status)
+}
+
+func (s *TicketsRepositoryTestSuite) TestUpdateTicketsCategorySuccess() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	// Rollback after successful commit:
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets (id, user_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Ticket 1", "Desc", nil, 1, createdAt, createdAt,
+		2, 1, 3, "Ticket 2", "Desc", nil, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	ticketIDs, err := s.ticketsRepository.UpdateTicketsCategory(
+		s.ctx,
+		2,
+		1,
+		func(ticketID uint64) (entities.CreateOutboxMessageDTO, error) {
+			return entities.CreateOutboxMessageDTO{
+				Subject: "ticket-updated",
+				Payload: []byte(fmt.Sprintf(`{"ticketId":%d}`, ticketID)),
+			}, nil
+		},
+	)
+	s.NoError(err)
+	s.Equal([]uint64{1}, ticketIDs)
+
+	var categoryID uint32
+	s.NoError(
+		s.connection.QueryRowContext(s.ctx, "SELECT category_id FROM tickets WHERE id = ?", 1).Scan(&categoryID),
+	)
+	s.Equal(uint32(1), categoryID)
+
+	// Tickets of other Categories should not be affected:
+	s.NoError(
+		s.connection.QueryRowContext(s.ctx, "SELECT category_id FROM tickets WHERE id = ?", 2).Scan(&categoryID),
+	)
+	s.Equal(uint32(3), categoryID)
+
+	// Outbox messages must be saved for moved Tickets only within the same transaction:
+	var payload string
+	s.NoError(
+		s.connection.QueryRowContext(
+			s.ctx,
+			"SELECT payload FROM outbox WHERE subject = ?",
+			"ticket-updated",
+		).Scan(&payload),
+	)
+	s.JSONEq(`{"ticketId":1}`, payload)
+}
+
+func (s *TicketsRepositoryTestSuite) TestDeleteTagFromTicketsSuccess() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	// Rollback after successful commit:
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	createdAt := time.Now().UTC().Add(-time.Hour)
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets (id, user_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 1, "Ticket 1", "Desc", nil, 1, createdAt, createdAt,
+		2, 1, 1, "Ticket 2", "Desc", nil, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets_tags_associations (id, ticket_id, tag_id) VALUES (?, ?, ?), (?, ?, ?), (?, ?, ?)",
+		1, 1, 1,
+		2, 1, 2,
+		3, 2, 2,
+	)
+	s.NoError(err)
+
+	ticketIDs, err := s.ticketsRepository.DeleteTagFromTickets(
+		s.ctx,
+		1,
+		func(ticketID uint64) (entities.CreateOutboxMessageDTO, error) {
+			return entities.CreateOutboxMessageDTO{
+				Subject: "ticket-updated",
+				Payload: []byte(fmt.Sprintf(`{"ticketId":%d}`, ticketID)),
+			}, nil
+		},
+	)
+	s.NoError(err)
+	s.Equal([]uint64{1}, ticketIDs)
+
+	var tagsCount int
+	s.NoError(
+		s.connection.QueryRowContext(
+			s.ctx,
+			"SELECT COUNT(*) FROM tickets_tags_associations WHERE tag_id = ?",
+			1,
+		).Scan(&tagsCount),
+	)
+	s.Zero(tagsCount)
+
+	s.NoError(
+		s.connection.QueryRowContext(s.ctx, "SELECT COUNT(*) FROM tickets_tags_associations").Scan(&tagsCount),
+	)
+	s.Equal(2, tagsCount)
+
+	// Only Tickets with deleted Tag should be marked as updated:
+	var updatedCount int
+	s.NoError(
+		s.connection.QueryRowContext(
+			s.ctx,
+			"SELECT COUNT(*) FROM tickets WHERE updated_at > created_at",
+		).Scan(&updatedCount),
+	)
+	s.Equal(1, updatedCount)
+
+	var payload string
+	s.NoError(
+		s.connection.QueryRowContext(
+			s.ctx,
+			"SELECT payload FROM outbox WHERE subject = ?",
+			"ticket-updated",
+		).Scan(&payload),
+	)
+	s.JSONEq(`{"ticketId":1}`, payload)
+}
+
+func (s *TicketsRepositoryTestSuite) TestDeleteUserDataSuccess() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	// Rollback after successful commit:
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets (id, user_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 1, "Ticket 1", "Desc", nil, 1, createdAt, createdAt,
+		2, 2, 1, "Ticket 2", "Desc", nil, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets_tags_associations (id, ticket_id, tag_id) VALUES (?, ?, ?), (?, ?, ?), (?, ?, ?)",
+		1, 1, 1,
+		2, 1, 2,
+		3, 2, 1,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets_attachments (id, ticket_id, link, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)",
+		1, 1, "file1.jpg", createdAt, createdAt,
+		2, 2, "file2.jpg", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	// Respond to deleted Ticket, Respond of deleted Master and Respond of another Master to another Ticket:
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO responds (id, ticket_id, master_id, price, comment, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 5, 100.00, nil, createdAt, createdAt,
+		2, 2, 3, 100.00, nil, createdAt, createdAt,
+		3, 2, 5, 100.00, nil, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	expiresAt := createdAt.Add(time.Hour)
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO idempotency_keys (id, user_id, operation, idempotency_key, resource_id, expires_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)",
+		1, 1, "create_ticket", "key", 1, expiresAt,
+		2, 2, "create_ticket", "key", 2, expiresAt,
+	)
+	s.NoError(err)
+
+	var deletedData entities.DeletedUserDataDTO
+	err = s.ticketsRepository.DeleteUserData(
+		s.ctx,
+		entities.DeleteUserDataDTO{UserID: 1, MasterID: pointers.New[uint64](3)},
+		func(data entities.DeletedUserDataDTO) (entities.CreateOutboxMessageDTO, error) {
+			deletedData = data
+
+			return entities.CreateOutboxMessageDTO{
+				Subject: "user-data-deleted",
+				Payload: []byte(`{"userId":1}`),
+			}, nil
+		},
+	)
+	s.NoError(err)
+	s.Equal(
+		entities.DeletedUserDataDTO{
+			TicketsCount:          1,
+			RespondsCount:         2,
+			AttachmentsCount:      1,
+			TagsAssociationsCount: 2,
+			IdempotencyKeysCount:  1,
+		},
+		deletedData,
+	)
+
+	counts := map[string]int{
+		"SELECT COUNT(*) FROM tickets":                   1,
+		"SELECT COUNT(*) FROM tickets_tags_associations": 1,
+		"SELECT COUNT(*) FROM tickets_attachments":       1,
+		"SELECT COUNT(*) FROM responds WHERE id = 3":     1,
+		"SELECT COUNT(*) FROM responds":                  1,
+		"SELECT COUNT(*) FROM idempotency_keys":          1,
+	}
+
+	for query, expectedCount := range counts {
+		var count int
+		s.NoError(s.connection.QueryRowContext(s.ctx, query).Scan(&count))
+		s.Equal(expectedCount, count, query)
+	}
+
+	var subject string
+	s.NoError(
+		s.connection.QueryRowContext(s.ctx, "SELECT subject FROM outbox WHERE sent_at IS NULL").Scan(&subject),
+	)
+	s.Equal("user-data-deleted", subject)
+}
+
+func (s *TicketsRepositoryTestSuite) TestCountTicketsWithStatusesFilter() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(2)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets (id, user_id, category_id, name, description, price, quantity, created_at, updated_at, status) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Open Ticket", "Desc", nil, 1, createdAt, createdAt, entities.TicketStatusOpen,
+		2, 1, 2, "Completed Ticket", "Desc", nil, 1, createdAt, createdAt, entities.TicketStatusCompleted,
+	)
+	s.NoError(err)
+
+	count, err := s.ticketsRepository.CountTickets(
+		s.ctx,
+		&entities.TicketsFilters{Statuses: []entities.TicketStatus{entities.TicketStatusOpen}},
+	)
+	s.NoError(err)
+	s.Equal(uint64(1), count)
+
+	count, err = s.ticketsRepository.CountTickets(
+		s.ctx,
+		&entities.TicketsFilters{
+			Statuses: []entities.TicketStatus{entities.TicketStatusOpen, entities.TicketStatusCompleted},
+		},
+	)
+	s.NoError(err)
+	s.Equal(uint64(2), count)
 }
 
 func (s *TicketsRepositoryTestSuite) TestCountTicketsWithExistingTickets() {
@@ -621,7 +1369,7 @@ func (s *TicketsRepositoryTestSuite) TestCountTicketsWithExistingTicketsAndFilte
 	s.NoError(err)
 
 	filters := &entities.TicketsFilters{
-		//Search:              pointers.New("ticket"), // no ILike in sqlite
+		Search:              pointers.New("TICKET"), // case-insensitive fallback search in sqlite
 		PriceCeil:           pointers.New[float32](1000),
 		PriceFloor:          pointers.New[float32](10),
 		QuantityFloor:       pointers.New[uint32](1),
@@ -703,7 +1451,7 @@ func (s *TicketsRepositoryTestSuite) TestCountUserTicketsWithExistingTicketsAndF
 	s.NoError(err)
 
 	filters := &entities.TicketsFilters{
-		//Search:              pointers.New("ticket"), // no ILike in sqlite
+		Search:              pointers.New("TICKET"), // case-insensitive fallback search in sqlite
 		PriceCeil:           pointers.New[float32](1000),
 		PriceFloor:          pointers.New[float32](10),
 		QuantityFloor:       pointers.New[uint32](1),
@@ -729,3 +1477,198 @@ func (s *TicketsRepositoryTestSuite) TestCountUserTicketsWithoutExistingTickets(
 	s.NoError(err)
 	s.Zero(count)
 }
+
+func (s *TicketsRepositoryTestSuite) TestListAndCountTicketsAreConsistent() {
+	createdAt := time.Now().UTC()
+	updatedAt := createdAt.Add(time.Hour)
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets (id, user_id, category_id, name, description, price, quantity, status, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Ticket 1", "Desc 1", 50, 1, entities.TicketStatusOpen, createdAt.Add(-24*time.Hour), createdAt,
+		2, 1, 3, "Ticket 2", "Desc 2", 150, 5, entities.TicketStatusCompleted, createdAt, updatedAt,
+		3, 2, 2, "Ticket 3", "Desc 3", nil, 10, entities.TicketStatusOpen, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tickets_tags_associations (id, ticket_id, tag_id) VALUES (?, ?, ?), (?, ?, ?), (?, ?, ?)",
+		1, 1, 10,
+		2, 1, 20,
+		3, 3, 10,
+	)
+	s.NoError(err)
+
+	testCases := []struct {
+		name         string
+		filters      *entities.TicketsFilters
+		expected     int
+		expectedUser int
+	}{
+		{
+			name:         "without filters",
+			expected:     3,
+			expectedUser: 2,
+		},
+		{
+			name:         "price range",
+			filters:      &entities.TicketsFilters{PriceFloor: pointers.New[float32](10), PriceCeil: pointers.New[float32](100)},
+			expected:     1,
+			expectedUser: 1,
+		},
+		{
+			name:         "quantity floor",
+			filters:      &entities.TicketsFilters{QuantityFloor: pointers.New[uint32](5)},
+			expected:     2,
+			expectedUser: 1,
+		},
+		{
+			name:         "categories",
+			filters:      &entities.TicketsFilters{CategoryIDs: []uint32{2}},
+			expected:     2,
+			expectedUser: 1,
+		},
+		{
+			name:         "statuses",
+			filters:      &entities.TicketsFilters{Statuses: []entities.TicketStatus{entities.TicketStatusCompleted}},
+			expected:     1,
+			expectedUser: 1,
+		},
+		{
+			name:         "all tags",
+			filters:      &entities.TicketsFilters{TagIDs: []uint32{10, 20}},
+			expected:     1,
+			expectedUser: 1,
+		},
+		{
+			name:         "created at from",
+			filters:      &entities.TicketsFilters{CreatedAtFrom: &createdAt},
+			expected:     2,
+			expectedUser: 1,
+		},
+		{
+			name:         "created at to",
+			filters:      &entities.TicketsFilters{CreatedAtTo: &createdAt},
+			expected:     1,
+			expectedUser: 1,
+		},
+		{
+			name:         "updated at from",
+			filters:      &entities.TicketsFilters{UpdatedAtFrom: &updatedAt},
+			expected:     1,
+			expectedUser: 1,
+		},
+		{
+			name:         "updated at to",
+			filters:      &entities.TicketsFilters{UpdatedAtTo: &updatedAt},
+			expected:     2,
+			expectedUser: 1,
+		},
+		{
+			name: "combined",
+			filters: &entities.TicketsFilters{
+				CategoryIDs: []uint32{2},
+				TagIDs:      []uint32{10},
+				Statuses:    []entities.TicketStatus{entities.TicketStatusOpen},
+			},
+			expected:     2,
+			expectedUser: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.traceProvider.
+				EXPECT().
+				Span(gomock.Any(), gomock.Any()).
+				Return(context.Background(), mocktracing.NewMockSpan()).
+				Times(8) // 2x(Основной + getTicketsTagsIDs + getTicketsAttachments) + 2x Count
+
+			tickets, err := s.ticketsRepository.GetTickets(s.ctx, nil, tc.filters)
+			s.NoError(err)
+			s.Len(tickets, tc.expected)
+
+			count, err := s.ticketsRepository.CountTickets(s.ctx, tc.filters)
+			s.NoError(err)
+			s.Equal(uint64(len(tickets)), count)
+
+			userTickets, err := s.ticketsRepository.GetUserTickets(s.ctx, 1, nil, tc.filters)
+			s.NoError(err)
+			s.Len(userTickets, tc.expectedUser)
+
+			userCount, err := s.ticketsRepository.CountUserTickets(s.ctx, 1, tc.filters)
+			s.NoError(err)
+			s.Equal(uint64(len(userTickets)), userCount)
+		})
+	}
+}
+
+func (s *TicketsRepositoryTestSuite) TestGetTicketIDByIdempotencyKeySuccess() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO idempotency_keys (id, user_id, operation, idempotency_key, resource_id, expires_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?)",
+		1, 1, "create_ticket", "key", 5, time.Now().UTC().Add(time.Hour),
+	)
+	s.NoError(err)
+
+	id, err := s.ticketsRepository.GetTicketIDByIdempotencyKey(
+		s.ctx,
+		entities.IdempotencyKeyDTO{UserID: 1, Key: "key"},
+	)
+	s.NoError(err)
+	s.Equal(uint64(5), id)
+}
+
+func (s *TicketsRepositoryTestSuite) TestGetTicketIDByIdempotencyKeyExpired() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO idempotency_keys (id, user_id, operation, idempotency_key, resource_id, expires_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?)",
+		1, 1, "create_ticket", "key", 5, time.Now().UTC().Add(-time.Hour),
+	)
+	s.NoError(err)
+
+	id, err := s.ticketsRepository.GetTicketIDByIdempotencyKey(
+		s.ctx,
+		entities.IdempotencyKeyDTO{UserID: 1, Key: "key"},
+	)
+	s.ErrorIs(err, sql.ErrNoRows)
+	s.Zero(id)
+}
+
+func (s *TicketsRepositoryTestSuite) TestGetTicketIDByIdempotencyKeyAnotherOperation() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO idempotency_keys (id, user_id, operation, idempotency_key, resource_id, expires_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?)",
+		1, 1, "respond_to_ticket", "key", 5, time.Now().UTC().Add(time.Hour),
+	)
+	s.NoError(err)
+
+	id, err := s.ticketsRepository.GetTicketIDByIdempotencyKey(
+		s.ctx,
+		entities.IdempotencyKeyDTO{UserID: 1, Key: "key"},
+	)
+	s.ErrorIs(err, sql.ErrNoRows)
+	s.Zero(id)
+}
@@ -2,11 +2,15 @@ package repositories
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
 	"github.com/DKhorkov/hmtm-tickets/internal/interfaces"
 )
 
@@ -28,6 +32,13 @@ func (repo *ToysRepository) GetMasterByUserID(
 			UserID: userID,
 		},
 	)
+	if status.Code(err) == codes.NotFound {
+		return nil, &customerrors.MasterNotFoundError{
+			Message: strconv.FormatUint(userID, 10),
+			BaseErr: err,
+		}
+	}
+
 	if err != nil {
 		return nil, err
 	}
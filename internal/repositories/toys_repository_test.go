@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/DKhorkov/libs/pointers"
 
 	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
 	mockclients "github.com/DKhorkov/hmtm-tickets/mocks/clients"
 )
 
@@ -164,6 +167,7 @@ func TestToysRepository_GetMasterByUserID(t *testing.T) {
 		userID         uint64
 		setupMocks     func(toysClient *mockclients.MockToysClient)
 		expectedMaster *entities.Master
+		expectedErr    error
 		errorExpected  bool
 	}{
 		{
@@ -210,6 +214,23 @@ func TestToysRepository_GetMasterByUserID(t *testing.T) {
 			expectedMaster: nil,
 			errorExpected:  true,
 		},
+		{
+			name:   "master not found",
+			userID: 1,
+			setupMocks: func(toysClient *mockclients.MockToysClient) {
+				toysClient.
+					EXPECT().
+					GetMasterByUser(
+						gomock.Any(),
+						&toys.GetMasterByUserIn{UserID: 1},
+					).
+					Return(nil, status.Error(codes.NotFound, "master not found")).
+					Times(1)
+			},
+			expectedMaster: nil,
+			expectedErr:    &customerrors.MasterNotFoundError{},
+			errorExpected:  true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -222,6 +243,10 @@ func TestToysRepository_GetMasterByUserID(t *testing.T) {
 			if tc.errorExpected {
 				require.Error(t, err)
 				require.Nil(t, master)
+
+				if tc.expectedErr != nil {
+					require.IsType(t, tc.expectedErr, err)
+				}
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, tc.expectedMaster, master)
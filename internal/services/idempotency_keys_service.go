@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/interfaces"
+)
+
+type IdempotencyKeysService struct {
+	idempotencyKeysRepository interfaces.IdempotencyKeysRepository
+	logger                    logging.Logger
+}
+
+func NewIdempotencyKeysService(
+	idempotencyKeysRepository interfaces.IdempotencyKeysRepository,
+	logger logging.Logger,
+) *IdempotencyKeysService {
+	return &IdempotencyKeysService{
+		idempotencyKeysRepository: idempotencyKeysRepository,
+		logger:                    logger,
+	}
+}
+
+func (service *IdempotencyKeysService) DeleteExpiredIdempotencyKeys(
+	ctx context.Context,
+	expiredBefore time.Time,
+) error {
+	return service.idempotencyKeysRepository.DeleteExpiredIdempotencyKeys(ctx, expiredBefore)
+}
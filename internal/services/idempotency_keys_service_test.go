@@ -0,0 +1,78 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogger "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/services"
+	mockrepositories "github.com/DKhorkov/hmtm-tickets/mocks/repositories"
+)
+
+func TestIdempotencyKeysService_DeleteExpiredIdempotencyKeys(t *testing.T) {
+	expiredBefore := time.Now().UTC()
+
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			idempotencyKeysRepository *mockrepositories.MockIdempotencyKeysRepository,
+			logger *mocklogger.MockLogger,
+		)
+		errorExpected bool
+	}{
+		{
+			name: "successfully deleted expired idempotency keys",
+			setupMocks: func(
+				idempotencyKeysRepository *mockrepositories.MockIdempotencyKeysRepository,
+				_ *mocklogger.MockLogger,
+			) {
+				idempotencyKeysRepository.
+					EXPECT().
+					DeleteExpiredIdempotencyKeys(gomock.Any(), expiredBefore).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "failed to delete expired idempotency keys",
+			setupMocks: func(
+				idempotencyKeysRepository *mockrepositories.MockIdempotencyKeysRepository,
+				_ *mocklogger.MockLogger,
+			) {
+				idempotencyKeysRepository.
+					EXPECT().
+					DeleteExpiredIdempotencyKeys(gomock.Any(), expiredBefore).
+					Return(errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(mockController)
+	idempotencyKeysRepository := mockrepositories.NewMockIdempotencyKeysRepository(mockController)
+	idempotencyKeysService := services.NewIdempotencyKeysService(idempotencyKeysRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(idempotencyKeysRepository, logger)
+			}
+
+			err := idempotencyKeysService.DeleteExpiredIdempotencyKeys(ctx, expiredBefore)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
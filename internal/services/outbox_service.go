@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	"github.com/DKhorkov/hmtm-tickets/internal/interfaces"
+)
+
+type OutboxService struct {
+	outboxRepository interfaces.OutboxRepository
+	logger           logging.Logger
+}
+
+func NewOutboxService(
+	outboxRepository interfaces.OutboxRepository,
+	logger logging.Logger,
+) *OutboxService {
+	return &OutboxService{
+		outboxRepository: outboxRepository,
+		logger:           logger,
+	}
+}
+
+func (service *OutboxService) ClaimPendingOutboxMessages(
+	ctx context.Context,
+	limit uint64,
+	leaseTimeout time.Duration,
+) ([]entities.OutboxMessage, error) {
+	return service.outboxRepository.ClaimPendingOutboxMessages(ctx, limit, leaseTimeout)
+}
+
+func (service *OutboxService) DeleteSentOutboxMessages(ctx context.Context, sentBefore time.Time) error {
+	return service.outboxRepository.DeleteSentOutboxMessages(ctx, sentBefore)
+}
+
+func (service *OutboxService) MarkOutboxMessageAsSent(ctx context.Context, id uint64) error {
+	return service.outboxRepository.MarkOutboxMessageAsSent(ctx, id)
+}
+
+func (service *OutboxService) MarkOutboxMessageAsFailed(
+	ctx context.Context,
+	id uint64,
+	lastError string,
+	nextAttemptAt time.Time,
+) error {
+	return service.outboxRepository.MarkOutboxMessageAsFailed(ctx, id, lastError, nextAttemptAt)
+}
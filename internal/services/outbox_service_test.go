@@ -0,0 +1,279 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogger "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	"github.com/DKhorkov/hmtm-tickets/internal/services"
+	mockrepositories "github.com/DKhorkov/hmtm-tickets/mocks/repositories"
+)
+
+const outboxMessageID uint64 = 1
+
+func TestOutboxService_ClaimPendingOutboxMessages(t *testing.T) {
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			outboxRepository *mockrepositories.MockOutboxRepository,
+			logger *mocklogger.MockLogger,
+		)
+		limit         uint64
+		expected      []entities.OutboxMessage
+		errorExpected bool
+	}{
+		{
+			name: "successfully claimed pending outbox messages",
+			setupMocks: func(
+				outboxRepository *mockrepositories.MockOutboxRepository,
+				_ *mocklogger.MockLogger,
+			) {
+				outboxRepository.
+					EXPECT().
+					ClaimPendingOutboxMessages(gomock.Any(), uint64(10), time.Minute).
+					Return([]entities.OutboxMessage{{ID: outboxMessageID}}, nil).
+					Times(1)
+			},
+			limit:    10,
+			expected: []entities.OutboxMessage{{ID: outboxMessageID}},
+		},
+		{
+			name: "failed to claim pending outbox messages",
+			setupMocks: func(
+				outboxRepository *mockrepositories.MockOutboxRepository,
+				_ *mocklogger.MockLogger,
+			) {
+				outboxRepository.
+					EXPECT().
+					ClaimPendingOutboxMessages(gomock.Any(), uint64(10), time.Minute).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			limit:         10,
+			errorExpected: true,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(mockController)
+	outboxRepository := mockrepositories.NewMockOutboxRepository(mockController)
+	outboxService := services.NewOutboxService(outboxRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(outboxRepository, logger)
+			}
+
+			actual, err := outboxService.ClaimPendingOutboxMessages(ctx, tc.limit, time.Minute)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestOutboxService_DeleteSentOutboxMessages(t *testing.T) {
+	sentBefore := time.Now().UTC()
+
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			outboxRepository *mockrepositories.MockOutboxRepository,
+			logger *mocklogger.MockLogger,
+		)
+		errorExpected bool
+	}{
+		{
+			name: "successfully deleted sent outbox messages",
+			setupMocks: func(
+				outboxRepository *mockrepositories.MockOutboxRepository,
+				_ *mocklogger.MockLogger,
+			) {
+				outboxRepository.
+					EXPECT().
+					DeleteSentOutboxMessages(gomock.Any(), sentBefore).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "failed to delete sent outbox messages",
+			setupMocks: func(
+				outboxRepository *mockrepositories.MockOutboxRepository,
+				_ *mocklogger.MockLogger,
+			) {
+				outboxRepository.
+					EXPECT().
+					DeleteSentOutboxMessages(gomock.Any(), sentBefore).
+					Return(errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(mockController)
+	outboxRepository := mockrepositories.NewMockOutboxRepository(mockController)
+	outboxService := services.NewOutboxService(outboxRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(outboxRepository, logger)
+			}
+
+			err := outboxService.DeleteSentOutboxMessages(ctx, sentBefore)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOutboxService_MarkOutboxMessageAsSent(t *testing.T) {
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			outboxRepository *mockrepositories.MockOutboxRepository,
+			logger *mocklogger.MockLogger,
+		)
+		id            uint64
+		errorExpected bool
+	}{
+		{
+			name: "successfully marked outbox message as sent",
+			setupMocks: func(
+				outboxRepository *mockrepositories.MockOutboxRepository,
+				_ *mocklogger.MockLogger,
+			) {
+				outboxRepository.
+					EXPECT().
+					MarkOutboxMessageAsSent(gomock.Any(), outboxMessageID).
+					Return(nil).
+					Times(1)
+			},
+			id: outboxMessageID,
+		},
+		{
+			name: "failed to mark outbox message as sent",
+			setupMocks: func(
+				outboxRepository *mockrepositories.MockOutboxRepository,
+				_ *mocklogger.MockLogger,
+			) {
+				outboxRepository.
+					EXPECT().
+					MarkOutboxMessageAsSent(gomock.Any(), outboxMessageID).
+					Return(errors.New("test")).
+					Times(1)
+			},
+			id:            outboxMessageID,
+			errorExpected: true,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(mockController)
+	outboxRepository := mockrepositories.NewMockOutboxRepository(mockController)
+	outboxService := services.NewOutboxService(outboxRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(outboxRepository, logger)
+			}
+
+			err := outboxService.MarkOutboxMessageAsSent(ctx, tc.id)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOutboxService_MarkOutboxMessageAsFailed(t *testing.T) {
+	nextAttemptAt := time.Now().UTC()
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			outboxRepository *mockrepositories.MockOutboxRepository,
+			logger *mocklogger.MockLogger,
+		)
+		id            uint64
+		lastError     string
+		errorExpected bool
+	}{
+		{
+			name: "successfully marked outbox message as failed",
+			setupMocks: func(
+				outboxRepository *mockrepositories.MockOutboxRepository,
+				_ *mocklogger.MockLogger,
+			) {
+				outboxRepository.
+					EXPECT().
+					MarkOutboxMessageAsFailed(gomock.Any(), outboxMessageID, "publish error", nextAttemptAt).
+					Return(nil).
+					Times(1)
+			},
+			id:        outboxMessageID,
+			lastError: "publish error",
+		},
+		{
+			name: "failed to mark outbox message as failed",
+			setupMocks: func(
+				outboxRepository *mockrepositories.MockOutboxRepository,
+				_ *mocklogger.MockLogger,
+			) {
+				outboxRepository.
+					EXPECT().
+					MarkOutboxMessageAsFailed(gomock.Any(), outboxMessageID, "publish error", nextAttemptAt).
+					Return(errors.New("test")).
+					Times(1)
+			},
+			id:            outboxMessageID,
+			lastError:     "publish error",
+			errorExpected: true,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(mockController)
+	outboxRepository := mockrepositories.NewMockOutboxRepository(mockController)
+	outboxService := services.NewOutboxService(outboxRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(outboxRepository, logger)
+			}
+
+			err := outboxService.MarkOutboxMessageAsFailed(ctx, tc.id, tc.lastError, nextAttemptAt)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/DKhorkov/libs/logging"
@@ -29,8 +31,32 @@ func NewRespondsService(
 func (service *RespondsService) RespondToTicket(
 	ctx context.Context,
 	respondData entities.RespondToTicketDTO,
+	outboxMessageBuilders ...entities.OutboxMessageBuilder,
 ) (uint64, error) {
-	return service.respondsRepository.RespondToTicket(ctx, respondData)
+	return service.respondsRepository.RespondToTicket(ctx, respondData, outboxMessageBuilders...)
+}
+
+// GetRespondIDByIdempotencyKey returns IdempotencyKeyNotFoundError, if Respond has not been created
+// with provided key yet or key has expired.
+func (service *RespondsService) GetRespondIDByIdempotencyKey(
+	ctx context.Context,
+	idempotencyKey entities.IdempotencyKeyDTO,
+) (uint64, error) {
+	respondID, err := service.respondsRepository.GetRespondIDByIdempotencyKey(ctx, idempotencyKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, &customerrors.IdempotencyKeyNotFoundError{}
+	}
+
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			service.logger,
+			"Error occurred while trying to get Respond ID by idempotency key",
+			err,
+		)
+	}
+
+	return respondID, err
 }
 
 func (service *RespondsService) GetRespondByID(
@@ -52,27 +78,77 @@ func (service *RespondsService) GetRespondByID(
 	return respond, nil
 }
 
+func (service *RespondsService) GetRespondsByIDs(ctx context.Context, ids []uint64) ([]entities.Respond, error) {
+	return service.respondsRepository.GetRespondsByIDs(ctx, ids)
+}
+
 func (service *RespondsService) GetTicketResponds(
 	ctx context.Context,
 	ticketID uint64,
+	pagination *entities.Pagination,
+	filters *entities.RespondsFilters,
 ) ([]entities.Respond, error) {
-	return service.respondsRepository.GetTicketResponds(ctx, ticketID)
+	return service.respondsRepository.GetTicketResponds(ctx, ticketID, pagination, filters)
+}
+
+func (service *RespondsService) CountTicketResponds(
+	ctx context.Context,
+	ticketID uint64,
+	filters *entities.RespondsFilters,
+) (uint64, error) {
+	return service.respondsRepository.CountTicketResponds(ctx, ticketID, filters)
 }
 
 func (service *RespondsService) GetMasterResponds(
 	ctx context.Context,
 	masterID uint64,
+	pagination *entities.Pagination,
+	filters *entities.RespondsFilters,
 ) ([]entities.Respond, error) {
-	return service.respondsRepository.GetMasterResponds(ctx, masterID)
+	return service.respondsRepository.GetMasterResponds(ctx, masterID, pagination, filters)
+}
+
+func (service *RespondsService) CountMasterResponds(
+	ctx context.Context,
+	masterID uint64,
+	filters *entities.RespondsFilters,
+) (uint64, error) {
+	return service.respondsRepository.CountMasterResponds(ctx, masterID, filters)
 }
 
 func (service *RespondsService) UpdateRespond(
 	ctx context.Context,
 	respondData entities.UpdateRespondDTO,
+	outboxMessages ...entities.CreateOutboxMessageDTO,
+) error {
+	return service.respondsRepository.UpdateRespond(ctx, respondData, outboxMessages...)
+}
+
+func (service *RespondsService) DeleteRespond(
+	ctx context.Context,
+	id uint64,
+	outboxMessages ...entities.CreateOutboxMessageDTO,
+) error {
+	return service.respondsRepository.DeleteRespond(ctx, id, outboxMessages...)
+}
+
+func (service *RespondsService) AcceptRespond(
+	ctx context.Context,
+	id uint64,
+	outboxMessages ...entities.CreateOutboxMessageDTO,
+) error {
+	return service.respondsRepository.AcceptRespond(ctx, id, outboxMessages...)
+}
+
+func (service *RespondsService) UpdateRespondStatus(
+	ctx context.Context,
+	id uint64,
+	status entities.RespondStatus,
+	outboxMessages ...entities.CreateOutboxMessageDTO,
 ) error {
-	return service.respondsRepository.UpdateRespond(ctx, respondData)
+	return service.respondsRepository.UpdateRespondStatus(ctx, id, status, outboxMessages...)
 }
 
-func (service *RespondsService) DeleteRespond(ctx context.Context, id uint64) error {
-	return service.respondsRepository.DeleteRespond(ctx, id)
+func (service *RespondsService) WithdrawMasterResponds(ctx context.Context, masterID uint64) error {
+	return service.respondsRepository.WithdrawMasterResponds(ctx, masterID)
 }
@@ -2,6 +2,7 @@ package services_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"github.com/DKhorkov/libs/pointers"
 	"testing"
@@ -105,6 +106,95 @@ func TestRespondsService_RespondToTicket(t *testing.T) {
 	}
 }
 
+func TestRespondsService_GetRespondIDByIdempotencyKey(t *testing.T) {
+	idempotencyKey := entities.IdempotencyKeyDTO{UserID: 1, Key: "key"}
+
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			respondsRepository *mockrepositories.MockRespondsRepository,
+			logger *mocklogger.MockLogger,
+		)
+		expected      uint64
+		errorExpected bool
+		err           error
+	}{
+		{
+			name: "success",
+			setupMocks: func(
+				respondsRepository *mockrepositories.MockRespondsRepository,
+				_ *mocklogger.MockLogger,
+			) {
+				respondsRepository.
+					EXPECT().
+					GetRespondIDByIdempotencyKey(gomock.Any(), idempotencyKey).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+			expected:      1,
+			errorExpected: false,
+		},
+		{
+			name: "idempotency key not found",
+			setupMocks: func(
+				respondsRepository *mockrepositories.MockRespondsRepository,
+				_ *mocklogger.MockLogger,
+			) {
+				respondsRepository.
+					EXPECT().
+					GetRespondIDByIdempotencyKey(gomock.Any(), idempotencyKey).
+					Return(uint64(0), sql.ErrNoRows).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           &customerrors.IdempotencyKeyNotFoundError{},
+		},
+		{
+			name: "repository error",
+			setupMocks: func(
+				respondsRepository *mockrepositories.MockRespondsRepository,
+				logger *mocklogger.MockLogger,
+			) {
+				respondsRepository.
+					EXPECT().
+					GetRespondIDByIdempotencyKey(gomock.Any(), idempotencyKey).
+					Return(uint64(0), errors.New("test error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           errors.New("test error"),
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(ctrl)
+	respondsRepository := mockrepositories.NewMockRespondsRepository(ctrl)
+	respondsService := services.NewRespondsService(respondsRepository, logger)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(respondsRepository, logger)
+			}
+
+			actual, err := respondsService.GetRespondIDByIdempotencyKey(context.Background(), idempotencyKey)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.IsType(t, tc.err, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
 func TestRespondsService_GetRespondByID(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -197,7 +287,7 @@ func TestRespondsService_GetMasterResponds(t *testing.T) {
 			) {
 				respondsRepository.
 					EXPECT().
-					GetMasterResponds(gomock.Any(), masterID).
+					GetMasterResponds(gomock.Any(), masterID, nil, nil).
 					Return([]entities.Respond{*respond}, nil).
 					Times(1)
 			},
@@ -213,7 +303,7 @@ func TestRespondsService_GetMasterResponds(t *testing.T) {
 			) {
 				respondsRepository.
 					EXPECT().
-					GetMasterResponds(gomock.Any(), masterID).
+					GetMasterResponds(gomock.Any(), masterID, nil, nil).
 					Return(nil, errors.New("test")).
 					Times(1)
 			},
@@ -234,7 +324,7 @@ func TestRespondsService_GetMasterResponds(t *testing.T) {
 				tc.setupMocks(respondsRepository, logger)
 			}
 
-			actualResponds, err := respondsService.GetMasterResponds(ctx, tc.masterID)
+			actualResponds, err := respondsService.GetMasterResponds(ctx, tc.masterID, nil, nil)
 			if tc.errorExpected {
 				require.Error(t, err)
 			} else {
@@ -366,6 +456,66 @@ func TestRespondsService_DeleteRespond(t *testing.T) {
 	}
 }
 
+func TestRespondsService_GetRespondsByIDs(t *testing.T) {
+	testCases := []struct {
+		name          string
+		ids           []uint64
+		setupMocks    func(respondsRepository *mockrepositories.MockRespondsRepository)
+		expected      []entities.Respond
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			ids:  []uint64{1, 2},
+			setupMocks: func(respondsRepository *mockrepositories.MockRespondsRepository) {
+				respondsRepository.
+					EXPECT().
+					GetRespondsByIDs(gomock.Any(), []uint64{1, 2}).
+					Return([]entities.Respond{{ID: 2}, {ID: 1}}, nil).
+					Times(1)
+			},
+			expected:      []entities.Respond{{ID: 2}, {ID: 1}},
+			errorExpected: false,
+		},
+		{
+			name: "repository error",
+			ids:  []uint64{1},
+			setupMocks: func(respondsRepository *mockrepositories.MockRespondsRepository) {
+				respondsRepository.
+					EXPECT().
+					GetRespondsByIDs(gomock.Any(), []uint64{1}).
+					Return(nil, errors.New("fetch failed")).
+					Times(1)
+			},
+			expected:      nil,
+			errorExpected: true,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(mockController)
+	respondsRepository := mockrepositories.NewMockRespondsRepository(mockController)
+	respondsService := services.NewRespondsService(respondsRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(respondsRepository)
+			}
+
+			actual, err := respondsService.GetRespondsByIDs(ctx, tc.ids)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
 func TestRespondsService_GetTicketResponds(t *testing.T) {
 	testCases := []struct {
 		name             string
@@ -380,7 +530,7 @@ func TestRespondsService_GetTicketResponds(t *testing.T) {
 			setupMocks: func(respondsRepository *mockrepositories.MockRespondsRepository) {
 				respondsRepository.
 					EXPECT().
-					GetTicketResponds(gomock.Any(), uint64(1)).
+					GetTicketResponds(gomock.Any(), uint64(1), nil, nil).
 					Return([]entities.Respond{{ID: 1, TicketID: 1}}, nil).
 					Times(1)
 			},
@@ -393,7 +543,7 @@ func TestRespondsService_GetTicketResponds(t *testing.T) {
 			setupMocks: func(respondsRepository *mockrepositories.MockRespondsRepository) {
 				respondsRepository.
 					EXPECT().
-					GetTicketResponds(gomock.Any(), uint64(1)).
+					GetTicketResponds(gomock.Any(), uint64(1), nil, nil).
 					Return(nil, errors.New("fetch failed")).
 					Times(1)
 			},
@@ -414,7 +564,7 @@ func TestRespondsService_GetTicketResponds(t *testing.T) {
 				tc.setupMocks(respondsRepository)
 			}
 
-			responds, err := respondsService.GetTicketResponds(ctx, tc.ticketID)
+			responds, err := respondsService.GetTicketResponds(ctx, tc.ticketID, nil, nil)
 			if tc.errorExpected {
 				require.Error(t, err)
 				require.Equal(t, tc.expectedResponds, responds)
@@ -425,3 +575,297 @@ func TestRespondsService_GetTicketResponds(t *testing.T) {
 		})
 	}
 }
+
+func TestRespondsService_CountTicketResponds(t *testing.T) {
+	testCases := []struct {
+		name          string
+		ticketID      uint64
+		filters       *entities.RespondsFilters
+		setupMocks    func(respondsRepository *mockrepositories.MockRespondsRepository)
+		expectedCount uint64
+		errorExpected bool
+	}{
+		{
+			name:     "success",
+			ticketID: 1,
+			filters:  &entities.RespondsFilters{Statuses: []entities.RespondStatus{entities.RespondStatusPending}},
+			setupMocks: func(respondsRepository *mockrepositories.MockRespondsRepository) {
+				respondsRepository.
+					EXPECT().
+					CountTicketResponds(
+						gomock.Any(),
+						uint64(1),
+						&entities.RespondsFilters{Statuses: []entities.RespondStatus{entities.RespondStatusPending}},
+					).
+					Return(uint64(3), nil).
+					Times(1)
+			},
+			expectedCount: 3,
+			errorExpected: false,
+		},
+		{
+			name:     "repository error",
+			ticketID: 1,
+			setupMocks: func(respondsRepository *mockrepositories.MockRespondsRepository) {
+				respondsRepository.
+					EXPECT().
+					CountTicketResponds(gomock.Any(), uint64(1), nil).
+					Return(uint64(0), errors.New("count failed")).
+					Times(1)
+			},
+			expectedCount: 0,
+			errorExpected: true,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(mockController)
+	respondsRepository := mockrepositories.NewMockRespondsRepository(mockController)
+	respondsService := services.NewRespondsService(respondsRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(respondsRepository)
+			}
+
+			count, err := respondsService.CountTicketResponds(ctx, tc.ticketID, tc.filters)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expectedCount, count)
+		})
+	}
+}
+
+func TestRespondsService_CountMasterResponds(t *testing.T) {
+	testCases := []struct {
+		name          string
+		masterID      uint64
+		setupMocks    func(respondsRepository *mockrepositories.MockRespondsRepository)
+		expectedCount uint64
+		errorExpected bool
+	}{
+		{
+			name:     "success",
+			masterID: 1,
+			setupMocks: func(respondsRepository *mockrepositories.MockRespondsRepository) {
+				respondsRepository.
+					EXPECT().
+					CountMasterResponds(gomock.Any(), uint64(1), nil).
+					Return(uint64(5), nil).
+					Times(1)
+			},
+			expectedCount: 5,
+			errorExpected: false,
+		},
+		{
+			name:     "repository error",
+			masterID: 1,
+			setupMocks: func(respondsRepository *mockrepositories.MockRespondsRepository) {
+				respondsRepository.
+					EXPECT().
+					CountMasterResponds(gomock.Any(), uint64(1), nil).
+					Return(uint64(0), errors.New("count failed")).
+					Times(1)
+			},
+			expectedCount: 0,
+			errorExpected: true,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(mockController)
+	respondsRepository := mockrepositories.NewMockRespondsRepository(mockController)
+	respondsService := services.NewRespondsService(respondsRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(respondsRepository)
+			}
+
+			count, err := respondsService.CountMasterResponds(ctx, tc.masterID, nil)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expectedCount, count)
+		})
+	}
+}
+
+func TestRespondsService_AcceptRespond(t *testing.T) {
+	testCases := []struct {
+		name          string
+		id            uint64
+		setupMocks    func(respondsRepository *mockrepositories.MockRespondsRepository)
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			id:   1,
+			setupMocks: func(respondsRepository *mockrepositories.MockRespondsRepository) {
+				respondsRepository.
+					EXPECT().
+					AcceptRespond(gomock.Any(), uint64(1)).
+					Return(nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name: "repository error",
+			id:   1,
+			setupMocks: func(respondsRepository *mockrepositories.MockRespondsRepository) {
+				respondsRepository.
+					EXPECT().
+					AcceptRespond(gomock.Any(), uint64(1)).
+					Return(errors.New("accept failed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(mockController)
+	respondsRepository := mockrepositories.NewMockRespondsRepository(mockController)
+	respondsService := services.NewRespondsService(respondsRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(respondsRepository)
+			}
+
+			err := respondsService.AcceptRespond(ctx, tc.id)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRespondsService_UpdateRespondStatus(t *testing.T) {
+	testCases := []struct {
+		name          string
+		id            uint64
+		status        entities.RespondStatus
+		setupMocks    func(respondsRepository *mockrepositories.MockRespondsRepository)
+		errorExpected bool
+	}{
+		{
+			name:   "success",
+			id:     1,
+			status: entities.RespondStatusRejected,
+			setupMocks: func(respondsRepository *mockrepositories.MockRespondsRepository) {
+				respondsRepository.
+					EXPECT().
+					UpdateRespondStatus(gomock.Any(), uint64(1), entities.RespondStatusRejected).
+					Return(nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name:   "repository error",
+			id:     1,
+			status: entities.RespondStatusRejected,
+			setupMocks: func(respondsRepository *mockrepositories.MockRespondsRepository) {
+				respondsRepository.
+					EXPECT().
+					UpdateRespondStatus(gomock.Any(), uint64(1), entities.RespondStatusRejected).
+					Return(errors.New("update failed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(mockController)
+	respondsRepository := mockrepositories.NewMockRespondsRepository(mockController)
+	respondsService := services.NewRespondsService(respondsRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(respondsRepository)
+			}
+
+			err := respondsService.UpdateRespondStatus(ctx, tc.id, tc.status)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRespondsService_WithdrawMasterResponds(t *testing.T) {
+	testCases := []struct {
+		name          string
+		masterID      uint64
+		setupMocks    func(respondsRepository *mockrepositories.MockRespondsRepository)
+		errorExpected bool
+	}{
+		{
+			name:     "success",
+			masterID: 1,
+			setupMocks: func(respondsRepository *mockrepositories.MockRespondsRepository) {
+				respondsRepository.
+					EXPECT().
+					WithdrawMasterResponds(gomock.Any(), uint64(1)).
+					Return(nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name:     "repository error",
+			masterID: 1,
+			setupMocks: func(respondsRepository *mockrepositories.MockRespondsRepository) {
+				respondsRepository.
+					EXPECT().
+					WithdrawMasterResponds(gomock.Any(), uint64(1)).
+					Return(errors.New("update failed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(mockController)
+	respondsRepository := mockrepositories.NewMockRespondsRepository(mockController)
+	respondsService := services.NewRespondsService(respondsRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(respondsRepository)
+			}
+
+			err := respondsService.WithdrawMasterResponds(ctx, tc.masterID)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
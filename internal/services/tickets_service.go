@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/DKhorkov/libs/logging"
@@ -29,8 +31,32 @@ func NewTicketsService(
 func (service *TicketsService) CreateTicket(
 	ctx context.Context,
 	ticketData entities.CreateTicketDTO,
+	outboxMessageBuilders ...entities.OutboxMessageBuilder,
 ) (uint64, error) {
-	return service.ticketsRepository.CreateTicket(ctx, ticketData)
+	return service.ticketsRepository.CreateTicket(ctx, ticketData, outboxMessageBuilders...)
+}
+
+// GetTicketIDByIdempotencyKey returns IdempotencyKeyNotFoundError, if Ticket has not been created
+// with provided key yet or key has expired.
+func (service *TicketsService) GetTicketIDByIdempotencyKey(
+	ctx context.Context,
+	idempotencyKey entities.IdempotencyKeyDTO,
+) (uint64, error) {
+	ticketID, err := service.ticketsRepository.GetTicketIDByIdempotencyKey(ctx, idempotencyKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, &customerrors.IdempotencyKeyNotFoundError{}
+	}
+
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			service.logger,
+			"Error occurred while trying to get Ticket ID by idempotency key",
+			err,
+		)
+	}
+
+	return ticketID, err
 }
 
 func (service *TicketsService) GetTicketByID(
@@ -52,6 +78,10 @@ func (service *TicketsService) GetTicketByID(
 	return ticket, nil
 }
 
+func (service *TicketsService) GetTicketsByIDs(ctx context.Context, ids []uint64) ([]entities.Ticket, error) {
+	return service.ticketsRepository.GetTicketsByIDs(ctx, ids)
+}
+
 func (service *TicketsService) GetTickets(
 	ctx context.Context,
 	pagination *entities.Pagination,
@@ -81,13 +111,55 @@ func (service *TicketsService) CountUserTickets(
 	return service.ticketsRepository.CountUserTickets(ctx, userID, filters)
 }
 
-func (service *TicketsService) DeleteTicket(ctx context.Context, id uint64) error {
-	return service.ticketsRepository.DeleteTicket(ctx, id)
+func (service *TicketsService) DeleteTicket(
+	ctx context.Context,
+	id uint64,
+	outboxMessages ...entities.CreateOutboxMessageDTO,
+) error {
+	return service.ticketsRepository.DeleteTicket(ctx, id, outboxMessages...)
 }
 
 func (service *TicketsService) UpdateTicket(
 	ctx context.Context,
 	ticketData entities.UpdateTicketDTO,
+	outboxMessages ...entities.CreateOutboxMessageDTO,
+) error {
+	return service.ticketsRepository.UpdateTicket(ctx, ticketData, outboxMessages...)
+}
+
+func (service *TicketsService) UpdateTicketStatus(
+	ctx context.Context,
+	id uint64,
+	currentStatus, status entities.TicketStatus,
+) error {
+	return service.ticketsRepository.UpdateTicketStatus(ctx, id, currentStatus, status)
+}
+
+func (service *TicketsService) UpdateTicketsCategory(
+	ctx context.Context,
+	oldCategoryID, newCategoryID uint32,
+	outboxMessageBuilders ...entities.OutboxMessageBuilder,
+) ([]uint64, error) {
+	return service.ticketsRepository.UpdateTicketsCategory(
+		ctx,
+		oldCategoryID,
+		newCategoryID,
+		outboxMessageBuilders...,
+	)
+}
+
+func (service *TicketsService) DeleteTagFromTickets(
+	ctx context.Context,
+	tagID uint32,
+	outboxMessageBuilders ...entities.OutboxMessageBuilder,
+) ([]uint64, error) {
+	return service.ticketsRepository.DeleteTagFromTickets(ctx, tagID, outboxMessageBuilders...)
+}
+
+func (service *TicketsService) DeleteUserData(
+	ctx context.Context,
+	userData entities.DeleteUserDataDTO,
+	outboxMessageBuilders ...entities.DeletedUserDataOutboxMessageBuilder,
 ) error {
-	return service.ticketsRepository.UpdateTicket(ctx, ticketData)
+	return service.ticketsRepository.DeleteUserData(ctx, userData, outboxMessageBuilders...)
 }
@@ -2,6 +2,7 @@ package services_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 
@@ -113,6 +114,95 @@ func TestTicketsService_CreateTicket(t *testing.T) {
 	}
 }
 
+func TestTicketsService_GetTicketIDByIdempotencyKey(t *testing.T) {
+	idempotencyKey := entities.IdempotencyKeyDTO{UserID: 1, Key: "key"}
+
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			ticketsRepository *mockrepositories.MockTicketsRepository,
+			logger *mocklogger.MockLogger,
+		)
+		expected      uint64
+		errorExpected bool
+		err           error
+	}{
+		{
+			name: "success",
+			setupMocks: func(
+				ticketsRepository *mockrepositories.MockTicketsRepository,
+				_ *mocklogger.MockLogger,
+			) {
+				ticketsRepository.
+					EXPECT().
+					GetTicketIDByIdempotencyKey(gomock.Any(), idempotencyKey).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+			expected:      1,
+			errorExpected: false,
+		},
+		{
+			name: "idempotency key not found",
+			setupMocks: func(
+				ticketsRepository *mockrepositories.MockTicketsRepository,
+				_ *mocklogger.MockLogger,
+			) {
+				ticketsRepository.
+					EXPECT().
+					GetTicketIDByIdempotencyKey(gomock.Any(), idempotencyKey).
+					Return(uint64(0), sql.ErrNoRows).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           &customerrors.IdempotencyKeyNotFoundError{},
+		},
+		{
+			name: "repository error",
+			setupMocks: func(
+				ticketsRepository *mockrepositories.MockTicketsRepository,
+				logger *mocklogger.MockLogger,
+			) {
+				ticketsRepository.
+					EXPECT().
+					GetTicketIDByIdempotencyKey(gomock.Any(), idempotencyKey).
+					Return(uint64(0), errors.New("test error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           errors.New("test error"),
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(ctrl)
+	ticketsRepository := mockrepositories.NewMockTicketsRepository(ctrl)
+	ticketsService := services.NewTicketsService(ticketsRepository, logger)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsRepository, logger)
+			}
+
+			actual, err := ticketsService.GetTicketIDByIdempotencyKey(context.Background(), idempotencyKey)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.IsType(t, tc.err, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
 func TestTicketsService_GetTicketByID(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -189,6 +279,66 @@ func TestTicketsService_GetTicketByID(t *testing.T) {
 	}
 }
 
+func TestTicketsService_GetTicketsByIDs(t *testing.T) {
+	testCases := []struct {
+		name          string
+		ids           []uint64
+		setupMocks    func(ticketsRepository *mockrepositories.MockTicketsRepository)
+		expected      []entities.Ticket
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			ids:  []uint64{1, 2},
+			setupMocks: func(ticketsRepository *mockrepositories.MockTicketsRepository) {
+				ticketsRepository.
+					EXPECT().
+					GetTicketsByIDs(gomock.Any(), []uint64{1, 2}).
+					Return([]entities.Ticket{{ID: 2}, {ID: 1}}, nil).
+					Times(1)
+			},
+			expected:      []entities.Ticket{{ID: 2}, {ID: 1}},
+			errorExpected: false,
+		},
+		{
+			name: "repository error",
+			ids:  []uint64{1},
+			setupMocks: func(ticketsRepository *mockrepositories.MockTicketsRepository) {
+				ticketsRepository.
+					EXPECT().
+					GetTicketsByIDs(gomock.Any(), []uint64{1}).
+					Return(nil, errors.New("fetch failed")).
+					Times(1)
+			},
+			expected:      nil,
+			errorExpected: true,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(mockController)
+	ticketsRepository := mockrepositories.NewMockTicketsRepository(mockController)
+	ticketsService := services.NewTicketsService(ticketsRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsRepository)
+			}
+
+			actual, err := ticketsService.GetTicketsByIDs(ctx, tc.ids)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
 func TestTicketsService_GetTickets(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -739,3 +889,250 @@ func TestTicketsService_CountUserTickets(t *testing.T) {
 		})
 	}
 }
+
+func TestTicketsService_UpdateTicketStatus(t *testing.T) {
+	testCases := []struct {
+		name          string
+		id            uint64
+		currentStatus entities.TicketStatus
+		status        entities.TicketStatus
+		setupMocks    func(ticketsRepository *mockrepositories.MockTicketsRepository)
+		errorExpected bool
+	}{
+		{
+			name:          "success",
+			id:            1,
+			currentStatus: entities.TicketStatusOpen,
+			status:        entities.TicketStatusInProgress,
+			setupMocks: func(ticketsRepository *mockrepositories.MockTicketsRepository) {
+				ticketsRepository.
+					EXPECT().
+					UpdateTicketStatus(
+						gomock.Any(),
+						uint64(1),
+						entities.TicketStatusOpen,
+						entities.TicketStatusInProgress,
+					).
+					Return(nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name:          "repository error",
+			id:            1,
+			currentStatus: entities.TicketStatusOpen,
+			status:        entities.TicketStatusInProgress,
+			setupMocks: func(ticketsRepository *mockrepositories.MockTicketsRepository) {
+				ticketsRepository.
+					EXPECT().
+					UpdateTicketStatus(
+						gomock.Any(),
+						uint64(1),
+						entities.TicketStatusOpen,
+						entities.TicketStatusInProgress,
+					).
+					Return(errors.New("update failed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(ctrl)
+	ticketsRepository := mockrepositories.NewMockTicketsRepository(ctrl)
+	ticketsService := services.NewTicketsService(ticketsRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsRepository)
+			}
+
+			err := ticketsService.UpdateTicketStatus(ctx, tc.id, tc.currentStatus, tc.status)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTicketsService_UpdateTicketsCategory(t *testing.T) {
+	testCases := []struct {
+		name          string
+		oldCategoryID uint32
+		newCategoryID uint32
+		setupMocks    func(ticketsRepository *mockrepositories.MockTicketsRepository)
+		expected      []uint64
+		errorExpected bool
+	}{
+		{
+			name:          "success",
+			oldCategoryID: 1,
+			newCategoryID: 2,
+			setupMocks: func(ticketsRepository *mockrepositories.MockTicketsRepository) {
+				ticketsRepository.
+					EXPECT().
+					UpdateTicketsCategory(gomock.Any(), uint32(1), uint32(2)).
+					Return([]uint64{1, 2}, nil).
+					Times(1)
+			},
+			expected:      []uint64{1, 2},
+			errorExpected: false,
+		},
+		{
+			name:          "repository error",
+			oldCategoryID: 1,
+			newCategoryID: 2,
+			setupMocks: func(ticketsRepository *mockrepositories.MockTicketsRepository) {
+				ticketsRepository.
+					EXPECT().
+					UpdateTicketsCategory(gomock.Any(), uint32(1), uint32(2)).
+					Return(nil, errors.New("update failed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(ctrl)
+	ticketsRepository := mockrepositories.NewMockTicketsRepository(ctrl)
+	ticketsService := services.NewTicketsService(ticketsRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsRepository)
+			}
+
+			actual, err := ticketsService.UpdateTicketsCategory(ctx, tc.oldCategoryID, tc.newCategoryID)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestTicketsService_DeleteTagFromTickets(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tagID         uint32
+		setupMocks    func(ticketsRepository *mockrepositories.MockTicketsRepository)
+		expected      []uint64
+		errorExpected bool
+	}{
+		{
+			name:  "success",
+			tagID: 1,
+			setupMocks: func(ticketsRepository *mockrepositories.MockTicketsRepository) {
+				ticketsRepository.
+					EXPECT().
+					DeleteTagFromTickets(gomock.Any(), uint32(1)).
+					Return([]uint64{1, 2}, nil).
+					Times(1)
+			},
+			expected:      []uint64{1, 2},
+			errorExpected: false,
+		},
+		{
+			name:  "repository error",
+			tagID: 1,
+			setupMocks: func(ticketsRepository *mockrepositories.MockTicketsRepository) {
+				ticketsRepository.
+					EXPECT().
+					DeleteTagFromTickets(gomock.Any(), uint32(1)).
+					Return(nil, errors.New("delete failed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(ctrl)
+	ticketsRepository := mockrepositories.NewMockTicketsRepository(ctrl)
+	ticketsService := services.NewTicketsService(ticketsRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsRepository)
+			}
+
+			actual, err := ticketsService.DeleteTagFromTickets(ctx, tc.tagID)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestTicketsService_DeleteUserData(t *testing.T) {
+	testCases := []struct {
+		name          string
+		userData      entities.DeleteUserDataDTO
+		setupMocks    func(ticketsRepository *mockrepositories.MockTicketsRepository)
+		errorExpected bool
+	}{
+		{
+			name:     "success",
+			userData: entities.DeleteUserDataDTO{UserID: 1},
+			setupMocks: func(ticketsRepository *mockrepositories.MockTicketsRepository) {
+				ticketsRepository.
+					EXPECT().
+					DeleteUserData(gomock.Any(), entities.DeleteUserDataDTO{UserID: 1}).
+					Return(nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name:     "repository error",
+			userData: entities.DeleteUserDataDTO{UserID: 1},
+			setupMocks: func(ticketsRepository *mockrepositories.MockTicketsRepository) {
+				ticketsRepository.
+					EXPECT().
+					DeleteUserData(gomock.Any(), entities.DeleteUserDataDTO{UserID: 1}).
+					Return(errors.New("delete failed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(ctrl)
+	ticketsRepository := mockrepositories.NewMockTicketsRepository(ctrl)
+	ticketsService := services.NewTicketsService(ticketsRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsRepository)
+			}
+
+			err := ticketsService.DeleteUserData(ctx, tc.userData)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
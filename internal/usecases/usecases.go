@@ -2,35 +2,55 @@ package usecases
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 	"strconv"
+	"time"
 
 	"github.com/DKhorkov/libs/logging"
+	"github.com/DKhorkov/libs/pointers"
 
 	notifications "github.com/DKhorkov/hmtm-notifications/dto"
-	customnats "github.com/DKhorkov/libs/nats"
 
+	"github.com/DKhorkov/hmtm-tickets/dto"
 	"github.com/DKhorkov/hmtm-tickets/internal/config"
 	"github.com/DKhorkov/hmtm-tickets/internal/entities"
 	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
+	"github.com/DKhorkov/hmtm-tickets/internal/events"
 	"github.com/DKhorkov/hmtm-tickets/internal/interfaces"
 )
 
+// initialVersion is version of just created Ticket or Respond, which is set by database default.
+const initialVersion uint64 = 1
+
+// defaultTicketUpdateMask is used for clients, which do not provide update mask, so all fields are updated.
+var defaultTicketUpdateMask = []entities.TicketUpdateField{
+	entities.TicketUpdateFieldCategoryID,
+	entities.TicketUpdateFieldName,
+	entities.TicketUpdateFieldDescription,
+	entities.TicketUpdateFieldPrice,
+	entities.TicketUpdateFieldQuantity,
+	entities.TicketUpdateFieldTagIDs,
+	entities.TicketUpdateFieldAttachments,
+}
+
 func New(
 	ticketsService interfaces.TicketsService,
 	respondsService interfaces.RespondsService,
 	toysService interfaces.ToysService,
-	natsPublisher customnats.Publisher,
+	ticketEventsBus interfaces.TicketEventsBus,
 	natsConfig config.NATSConfig,
+	watchConfig config.WatchConfig,
 	logger logging.Logger,
 ) *UseCases {
 	return &UseCases{
 		ticketsService:  ticketsService,
 		respondsService: respondsService,
 		toysService:     toysService,
-		natsPublisher:   natsPublisher,
+		ticketEventsBus: ticketEventsBus,
 		natsConfig:      natsConfig,
+		watchConfig:     watchConfig,
 		logger:          logger,
 	}
 }
@@ -39,8 +59,9 @@ type UseCases struct {
 	ticketsService  interfaces.TicketsService
 	respondsService interfaces.RespondsService
 	toysService     interfaces.ToysService
-	natsPublisher   customnats.Publisher
+	ticketEventsBus interfaces.TicketEventsBus
 	natsConfig      config.NATSConfig
+	watchConfig     config.WatchConfig
 	logger          logging.Logger
 }
 
@@ -48,6 +69,16 @@ func (useCases *UseCases) CreateTicket(
 	ctx context.Context,
 	ticketData entities.CreateTicketDTO,
 ) (uint64, error) {
+	// Retried request returns ID of Ticket, created by original request:
+	if ticketData.IdempotencyKey != nil {
+		ticketID, err := useCases.ticketsService.GetTicketIDByIdempotencyKey(ctx, *ticketData.IdempotencyKey)
+
+		var idempotencyKeyNotFoundError *customerrors.IdempotencyKeyNotFoundError
+		if !errors.As(err, &idempotencyKeyNotFoundError) {
+			return ticketID, err
+		}
+	}
+
 	if err := useCases.validateCategory(ctx, ticketData.CategoryID); err != nil {
 		return 0, err
 	}
@@ -56,17 +87,79 @@ func (useCases *UseCases) CreateTicket(
 		return 0, err
 	}
 
-	if useCases.checkTicketExistence(ctx, ticketData) {
+	// Duplicates are searched by Ticket fields only for clients, which do not provide idempotency key:
+	if ticketData.IdempotencyKey == nil && useCases.checkTicketExistence(ctx, ticketData) {
 		return 0, &customerrors.TicketAlreadyExistsError{}
 	}
 
-	return useCases.ticketsService.CreateTicket(ctx, ticketData)
+	ticketID, err := useCases.ticketsService.CreateTicket(
+		ctx,
+		ticketData,
+		func(ticketID uint64) (entities.CreateOutboxMessageDTO, error) {
+			ticketCreatedDTO := &dto.TicketCreatedDTO{
+				TicketID:      ticketID,
+				TicketOwnerID: ticketData.UserID,
+				CategoryID:    ticketData.CategoryID,
+				TagIDs:        ticketData.TagIDs,
+			}
+
+			content, err := events.Marshal(ctx, dto.TicketCreatedEventType, ticketCreatedDTO)
+			if err != nil {
+				return entities.CreateOutboxMessageDTO{}, err
+			}
+
+			return entities.CreateOutboxMessageDTO{
+				Subject: useCases.natsConfig.Subjects.TicketCreated,
+				Payload: content,
+				DeduplicationID: ticketEventDeduplicationID(
+					useCases.natsConfig.Subjects.TicketCreated,
+					ticketID,
+					initialVersion,
+				),
+			}, nil
+		},
+	)
+	if err != nil && ticketData.IdempotencyKey != nil {
+		// Concurrent request with the same idempotency key could have created Ticket first:
+		if originalTicketID, lookupErr := useCases.ticketsService.GetTicketIDByIdempotencyKey(
+			ctx,
+			*ticketData.IdempotencyKey,
+		); lookupErr == nil {
+			return originalTicketID, nil
+		}
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	useCases.publishTicketEvent(ctx, entities.TicketEventTypeCreated, ticketID, nil)
+
+	return ticketID, nil
 }
 
 func (useCases *UseCases) GetTicketByID(ctx context.Context, id uint64) (*entities.Ticket, error) {
 	return useCases.ticketsService.GetTicketByID(ctx, id)
 }
 
+// GetTicketsByIDs returns found Tickets in order of provided IDs and IDs of Tickets, which were not found.
+// Duplicated IDs are removed before querying.
+func (useCases *UseCases) GetTicketsByIDs(
+	ctx context.Context,
+	ids []uint64,
+) ([]entities.Ticket, []uint64, error) {
+	ids = uniqueIDs(ids)
+
+	tickets, err := useCases.ticketsService.GetTicketsByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tickets, missingIDs := orderByIDs(ids, tickets, func(ticket entities.Ticket) uint64 { return ticket.ID })
+
+	return tickets, missingIDs, nil
+}
+
 func (useCases *UseCases) GetTickets(
 	ctx context.Context,
 	pagination *entities.Pagination,
@@ -100,6 +193,22 @@ func (useCases *UseCases) RespondToTicket(
 	ctx context.Context,
 	rawRespondData entities.RawRespondToTicketDTO,
 ) (uint64, error) {
+	var idempotencyKey *entities.IdempotencyKeyDTO
+	if rawRespondData.IdempotencyKey != nil {
+		idempotencyKey = &entities.IdempotencyKeyDTO{
+			UserID: rawRespondData.UserID,
+			Key:    *rawRespondData.IdempotencyKey,
+		}
+
+		// Retried request returns ID of Respond, created by original request:
+		respondID, err := useCases.respondsService.GetRespondIDByIdempotencyKey(ctx, *idempotencyKey)
+
+		var idempotencyKeyNotFoundError *customerrors.IdempotencyKeyNotFoundError
+		if !errors.As(err, &idempotencyKeyNotFoundError) {
+			return respondID, err
+		}
+	}
+
 	ticket, err := useCases.GetTicketByID(ctx, rawRespondData.TicketID)
 	if err != nil {
 		return 0, err
@@ -109,23 +218,58 @@ func (useCases *UseCases) RespondToTicket(
 		return 0, &customerrors.RespondToOwnTicketError{}
 	}
 
+	if ticket.Status != entities.TicketStatusOpen {
+		return 0, &customerrors.TicketIsNotOpenError{
+			Message: fmt.Sprintf("ticket with ID=%d is %s and does not accept responds", ticket.ID, ticket.Status),
+		}
+	}
+
 	master, err := useCases.toysService.GetMasterByUserID(ctx, rawRespondData.UserID)
 	if err != nil {
 		return 0, err
 	}
 
 	respondData := entities.RespondToTicketDTO{
-		MasterID: master.ID,
-		TicketID: rawRespondData.TicketID,
-		Price:    rawRespondData.Price,
-		Comment:  rawRespondData.Comment,
+		MasterID:       master.ID,
+		TicketID:       rawRespondData.TicketID,
+		Price:          rawRespondData.Price,
+		Comment:        rawRespondData.Comment,
+		IdempotencyKey: idempotencyKey,
 	}
 
-	if useCases.checkRespondExistence(ctx, respondData) {
-		return 0, &customerrors.RespondAlreadyExistsError{}
+	respondID, err := useCases.respondsService.RespondToTicket(
+		ctx,
+		respondData,
+		func(respondID uint64) (entities.CreateOutboxMessageDTO, error) {
+			respondCreatedDTO := &dto.RespondCreatedDTO{
+				TicketID:      ticket.ID,
+				TicketOwnerID: ticket.UserID,
+				RespondID:     respondID,
+				MasterID:      master.ID,
+			}
+
+			content, err := events.Marshal(ctx, dto.RespondCreatedEventType, respondCreatedDTO)
+			if err != nil {
+				return entities.CreateOutboxMessageDTO{}, err
+			}
+
+			return entities.CreateOutboxMessageDTO{
+				Subject: useCases.natsConfig.Subjects.RespondCreated,
+				Payload: content,
+			}, nil
+		},
+	)
+	if err != nil && idempotencyKey != nil {
+		// Concurrent request with the same idempotency key could have created Respond first:
+		if originalRespondID, lookupErr := useCases.respondsService.GetRespondIDByIdempotencyKey(
+			ctx,
+			*idempotencyKey,
+		); lookupErr == nil {
+			return originalRespondID, nil
+		}
 	}
 
-	return useCases.respondsService.RespondToTicket(ctx, respondData)
+	return respondID, err
 }
 
 func (useCases *UseCases) GetRespondByID(
@@ -135,56 +279,294 @@ func (useCases *UseCases) GetRespondByID(
 	return useCases.respondsService.GetRespondByID(ctx, id)
 }
 
+// GetRespondsByIDs returns found Responds in order of provided IDs and IDs of Responds, which were not found.
+// Duplicated IDs are removed before querying.
+func (useCases *UseCases) GetRespondsByIDs(
+	ctx context.Context,
+	ids []uint64,
+) ([]entities.Respond, []uint64, error) {
+	ids = uniqueIDs(ids)
+
+	responds, err := useCases.respondsService.GetRespondsByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responds, missingIDs := orderByIDs(ids, responds, func(respond entities.Respond) uint64 { return respond.ID })
+
+	return responds, missingIDs, nil
+}
+
 func (useCases *UseCases) GetTicketResponds(
 	ctx context.Context,
 	ticketID uint64,
+	pagination *entities.Pagination,
+	filters *entities.RespondsFilters,
 ) ([]entities.Respond, error) {
-	return useCases.respondsService.GetTicketResponds(ctx, ticketID)
+	return useCases.respondsService.GetTicketResponds(ctx, ticketID, pagination, filters)
+}
+
+func (useCases *UseCases) CountTicketResponds(
+	ctx context.Context,
+	ticketID uint64,
+	filters *entities.RespondsFilters,
+) (uint64, error) {
+	return useCases.respondsService.CountTicketResponds(ctx, ticketID, filters)
 }
 
 func (useCases *UseCases) GetUserResponds(
 	ctx context.Context,
 	userID uint64,
+	pagination *entities.Pagination,
+	filters *entities.RespondsFilters,
 ) ([]entities.Respond, error) {
 	master, err := useCases.toysService.GetMasterByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	return useCases.respondsService.GetMasterResponds(ctx, master.ID)
+	return useCases.respondsService.GetMasterResponds(ctx, master.ID, pagination, filters)
+}
+
+func (useCases *UseCases) CountUserResponds(
+	ctx context.Context,
+	userID uint64,
+	filters *entities.RespondsFilters,
+) (uint64, error) {
+	master, err := useCases.toysService.GetMasterByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return useCases.respondsService.CountMasterResponds(ctx, master.ID, filters)
 }
 
 func (useCases *UseCases) UpdateRespond(
 	ctx context.Context,
-	respondData entities.UpdateRespondDTO,
+	rawRespondData entities.RawUpdateRespondDTO,
 ) error {
-	if _, err := useCases.GetRespondByID(ctx, respondData.ID); err != nil {
+	respond, err := useCases.GetRespondByID(ctx, rawRespondData.ID)
+	if err != nil {
+		return err
+	}
+
+	if err = useCases.checkRespondOwnership(ctx, respond, rawRespondData.UserID); err != nil {
+		return err
+	}
+
+	// Repository checks status within update too, but Respond, which is not pending, is rejected without
+	// opening transaction:
+	if respond.Status != entities.RespondStatusPending {
+		return &customerrors.RespondIsNotPendingError{
+			Message: fmt.Sprintf("respond with ID=%d is %s and can not be updated", respond.ID, respond.Status),
+		}
+	}
+
+	ticket, err := useCases.GetTicketByID(ctx, respond.TicketID)
+	if err != nil {
+		return err
+	}
+
+	expectedVersion, err := resolveExpectedVersion(respond.Version, rawRespondData.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	respondData := entities.UpdateRespondDTO{
+		ID:              rawRespondData.ID,
+		Price:           rawRespondData.Price,
+		Comment:         rawRespondData.Comment,
+		ExpectedVersion: expectedVersion,
+	}
+
+	respondUpdatedDTO := &dto.RespondUpdatedDTO{
+		TicketID:      ticket.ID,
+		TicketOwnerID: ticket.UserID,
+		RespondID:     respond.ID,
+		MasterID:      respond.MasterID,
+	}
+
+	content, err := events.Marshal(ctx, dto.RespondUpdatedEventType, respondUpdatedDTO)
+	if err != nil {
+		return err
+	}
+
+	return useCases.respondsService.UpdateRespond(
+		ctx,
+		respondData,
+		entities.CreateOutboxMessageDTO{
+			Subject: useCases.natsConfig.Subjects.RespondUpdated,
+			Payload: content,
+		},
+	)
+}
+
+func (useCases *UseCases) DeleteRespond(ctx context.Context, id, userID uint64) error {
+	respond, err := useCases.GetRespondByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err = useCases.checkRespondOwnership(ctx, respond, userID); err != nil {
+		return err
+	}
+
+	ticket, err := useCases.GetTicketByID(ctx, respond.TicketID)
+	if err != nil {
+		return err
+	}
+
+	respondDeletedDTO := &dto.RespondDeletedDTO{
+		TicketID:      ticket.ID,
+		TicketOwnerID: ticket.UserID,
+		RespondID:     respond.ID,
+		MasterID:      respond.MasterID,
+	}
+
+	content, err := events.Marshal(ctx, dto.RespondDeletedEventType, respondDeletedDTO)
+	if err != nil {
+		return err
+	}
+
+	return useCases.respondsService.DeleteRespond(
+		ctx,
+		id,
+		entities.CreateOutboxMessageDTO{
+			Subject: useCases.natsConfig.Subjects.RespondDeleted,
+			Payload: content,
+		},
+	)
+}
+
+func (useCases *UseCases) AcceptRespond(ctx context.Context, id, userID uint64) error {
+	respond, err := useCases.GetRespondByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	ticket, err := useCases.GetTicketByID(ctx, respond.TicketID)
+	if err != nil {
+		return err
+	}
+
+	// Ownership is checked first, so status of Respond is not disclosed to other Users:
+	if err = checkTicketOwnership(ticket, userID); err != nil {
+		return err
+	}
+
+	if respond.Status != entities.RespondStatusPending {
+		return &customerrors.RespondIsNotPendingError{
+			Message: fmt.Sprintf("respond with ID=%d is %s and can not be accepted", respond.ID, respond.Status),
+		}
+	}
+
+	if ticket.Status != entities.TicketStatusOpen {
+		return &customerrors.TicketIsNotOpenError{
+			Message: fmt.Sprintf("ticket with ID=%d is %s and does not accept responds", ticket.ID, ticket.Status),
+		}
+	}
+
+	ticketResponds, err := useCases.GetTicketResponds(ctx, ticket.ID, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	// Other pending Responds are rejected within the same transaction as accepted one:
+	rejectedMastersIDs := make([]uint64, 0, len(ticketResponds))
+	for _, ticketRespond := range ticketResponds {
+		if ticketRespond.ID != respond.ID && ticketRespond.Status == entities.RespondStatusPending {
+			rejectedMastersIDs = append(rejectedMastersIDs, ticketRespond.MasterID)
+		}
+	}
+
+	respondAcceptedDTO := &dto.RespondAcceptedDTO{
+		TicketID:           ticket.ID,
+		TicketOwnerID:      ticket.UserID,
+		RespondID:          respond.ID,
+		MasterID:           respond.MasterID,
+		RejectedMastersIDs: rejectedMastersIDs,
+	}
+
+	content, err := events.Marshal(ctx, dto.RespondAcceptedEventType, respondAcceptedDTO)
+	if err != nil {
+		return err
+	}
+
+	// Message is saved within the same transaction as Respond acceptance and will be published by outbox relay:
+	if err = useCases.respondsService.AcceptRespond(
+		ctx,
+		id,
+		entities.CreateOutboxMessageDTO{
+			Subject: useCases.natsConfig.Subjects.RespondAccepted,
+			Payload: content,
+		},
+	); err != nil {
 		return err
 	}
 
-	return useCases.respondsService.UpdateRespond(ctx, respondData)
+	// Ticket is moved to in progress status within Respond acceptance:
+	useCases.publishTicketEvent(ctx, entities.TicketEventTypeUpdated, ticket.ID, ticket)
+
+	return nil
 }
 
-func (useCases *UseCases) DeleteRespond(ctx context.Context, id uint64) error {
-	if _, err := useCases.GetRespondByID(ctx, id); err != nil {
+func (useCases *UseCases) RejectRespond(ctx context.Context, id, userID uint64) error {
+	respond, err := useCases.GetRespondByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	ticket, err := useCases.GetTicketByID(ctx, respond.TicketID)
+	if err != nil {
+		return err
+	}
+
+	// Ownership is checked first, so status of Respond is not disclosed to other Users:
+	if err = checkTicketOwnership(ticket, userID); err != nil {
+		return err
+	}
+
+	if respond.Status != entities.RespondStatusPending {
+		return &customerrors.RespondIsNotPendingError{
+			Message: fmt.Sprintf("respond with ID=%d is %s and can not be rejected", respond.ID, respond.Status),
+		}
+	}
+
+	respondRejectedDTO := &dto.RespondRejectedDTO{
+		TicketID:      ticket.ID,
+		TicketOwnerID: ticket.UserID,
+		RespondID:     respond.ID,
+		MasterID:      respond.MasterID,
+	}
+
+	content, err := events.Marshal(ctx, dto.RespondRejectedEventType, respondRejectedDTO)
+	if err != nil {
 		return err
 	}
 
-	return useCases.respondsService.DeleteRespond(ctx, id)
+	return useCases.respondsService.UpdateRespondStatus(
+		ctx,
+		id,
+		entities.RespondStatusRejected,
+		entities.CreateOutboxMessageDTO{
+			Subject: useCases.natsConfig.Subjects.RespondRejected,
+			Payload: content,
+		},
+	)
 }
 
-func (useCases *UseCases) DeleteTicket(ctx context.Context, id uint64) error {
+func (useCases *UseCases) DeleteTicket(ctx context.Context, id, userID uint64) error {
 	ticket, err := useCases.GetTicketByID(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	ticketResponds, err := useCases.GetTicketResponds(ctx, ticket.ID)
-	if err != nil {
+	if err = checkTicketOwnership(ticket, userID); err != nil {
 		return err
 	}
 
-	if err = useCases.ticketsService.DeleteTicket(ctx, id); err != nil {
+	ticketResponds, err := useCases.GetTicketResponds(ctx, ticket.ID, nil, nil)
+	if err != nil {
 		return err
 	}
 
@@ -202,38 +584,30 @@ func (useCases *UseCases) DeleteTicket(ctx context.Context, id uint64) error {
 		RespondedMastersIDs: respondedMastersIDs,
 	}
 
-	// Not returning error (if exists) from sending message, because it is not main logic and a newsletter:
-	content, err := json.Marshal(ticketDeletedDTO)
+	content, err := events.Marshal(ctx, dto.TicketDeletedEventType, ticketDeletedDTO)
 	if err != nil {
-		logging.LogErrorContext(
-			ctx,
-			useCases.logger,
-			fmt.Sprintf(
-				"Error occurred while trying to encode data for "+
-					"delete ticket email for Ticket with Name=%s, Description=%s, Quantity=%d",
-				ticket.Name,
-				ticket.Description,
-				ticket.Quantity,
-			),
-			err,
-		)
+		return err
 	}
 
-	if err = useCases.natsPublisher.Publish(useCases.natsConfig.Subjects.TicketDeleted, content); err != nil {
-		logging.LogErrorContext(
-			ctx,
-			useCases.logger,
-			fmt.Sprintf(
-				"Error occurred while trying to send delete ticket email for "+
-					"Ticket with Name=%s, Description=%s, Quantity=%d",
-				ticket.Name,
-				ticket.Description,
-				ticket.Quantity,
+	if err = useCases.ticketsService.DeleteTicket(
+		ctx,
+		id,
+		entities.CreateOutboxMessageDTO{
+			Subject: useCases.natsConfig.Subjects.TicketDeleted,
+			Payload: content,
+			DeduplicationID: ticketEventDeduplicationID(
+				useCases.natsConfig.Subjects.TicketDeleted,
+				ticket.ID,
+				ticket.Version,
 			),
-			err,
-		)
+		},
+	); err != nil {
+		return err
 	}
 
+	// Deleted Ticket can not be read anymore, so its last known state is sent:
+	useCases.ticketEventsBus.Publish(entities.TicketEvent{Type: entities.TicketEventTypeDeleted, Ticket: *ticket})
+
 	return nil
 }
 
@@ -246,140 +620,387 @@ func (useCases *UseCases) UpdateTicket(
 		return err
 	}
 
-	if rawTicketData.CategoryID != nil {
+	if err = checkTicketOwnership(ticket, rawTicketData.UserID); err != nil {
+		return err
+	}
+
+	updateMask := rawTicketData.UpdateMask
+	if len(updateMask) == 0 {
+		updateMask = defaultTicketUpdateMask
+	}
+
+	if rawTicketData.CategoryID != nil && slices.Contains(updateMask, entities.TicketUpdateFieldCategoryID) {
 		if err = useCases.validateCategory(ctx, *rawTicketData.CategoryID); err != nil {
 			return err
 		}
 	}
 
-	if err = useCases.validateTags(ctx, rawTicketData.TagIDs); err != nil {
+	if slices.Contains(updateMask, entities.TicketUpdateFieldTagIDs) {
+		if err = useCases.validateTags(ctx, rawTicketData.TagIDs); err != nil {
+			return err
+		}
+	}
+
+	expectedVersion, err := resolveExpectedVersion(ticket.Version, rawTicketData.ExpectedVersion)
+	if err != nil {
 		return err
 	}
 
-	// Old Ticket Tags IDs set:
-	oldTagIDsSet := make(map[uint32]struct{}, len(ticket.TagIDs))
-	for _, tagID := range ticket.TagIDs {
-		oldTagIDsSet[tagID] = struct{}{}
+	// Tags and Attachments are compared with current ones by repository within update transaction:
+	ticketData := entities.UpdateTicketDTO{
+		ID:              rawTicketData.ID,
+		CategoryID:      rawTicketData.CategoryID,
+		Name:            rawTicketData.Name,
+		Description:     rawTicketData.Description,
+		Price:           rawTicketData.Price,
+		Quantity:        rawTicketData.Quantity,
+		TagIDs:          rawTicketData.TagIDs,
+		Attachments:     rawTicketData.Attachments,
+		ExpectedVersion: expectedVersion,
+		UpdateMask:      updateMask,
 	}
 
-	// New Ticket Tags IDs set:
-	newTagIDsSet := make(map[uint32]struct{}, len(rawTicketData.TagIDs))
-	for _, tagID := range rawTicketData.TagIDs {
-		newTagIDsSet[tagID] = struct{}{}
+	ticketUpdatedDTO := &notifications.TicketUpdatedDTO{
+		TicketID: ticket.ID,
 	}
 
-	// Add new Tag if it is not already exists:
-	tagIDsToAdd := make([]uint32, 0)
+	content, err := events.Marshal(ctx, dto.TicketUpdatedEventType, ticketUpdatedDTO)
+	if err != nil {
+		return err
+	}
 
-	for _, tagID := range rawTicketData.TagIDs {
-		if _, ok := oldTagIDsSet[tagID]; !ok {
-			tagIDsToAdd = append(tagIDsToAdd, tagID)
-		}
+	if err = useCases.ticketsService.UpdateTicket(
+		ctx,
+		ticketData,
+		entities.CreateOutboxMessageDTO{
+			Subject: useCases.natsConfig.Subjects.TicketUpdated,
+			Payload: content,
+			DeduplicationID: ticketEventDeduplicationID(
+				useCases.natsConfig.Subjects.TicketUpdated,
+				ticket.ID,
+				expectedVersion+1,
+			),
+		},
+	); err != nil {
+		return err
+	}
+
+	useCases.publishTicketEvent(ctx, entities.TicketEventTypeUpdated, ticket.ID, ticket)
+
+	return nil
+}
+
+func (useCases *UseCases) ChangeTicketStatus(
+	ctx context.Context,
+	id, userID uint64,
+	status entities.TicketStatus,
+) error {
+	ticket, err := useCases.GetTicketByID(ctx, id)
+	if err != nil {
+		return err
 	}
 
-	// Delete old Tag if it is not used by Ticket now:
-	tagIDsToDelete := make([]uint32, 0)
+	if err = checkTicketOwnership(ticket, userID); err != nil {
+		return err
+	}
 
-	for _, tagID := range ticket.TagIDs {
-		if _, ok := newTagIDsSet[tagID]; !ok {
-			tagIDsToDelete = append(tagIDsToDelete, tagID)
+	if !isTicketStatusTransitionAllowed(ticket.Status, status) {
+		return &customerrors.TicketStatusTransitionNotAllowedError{
+			Message: fmt.Sprintf(
+				"ticket status transition from %s to %s is not allowed",
+				ticket.Status,
+				status,
+			),
 		}
 	}
 
-	// Old Ticket Attachments set:
-	oldAttachmentsSet := make(map[string]struct{}, len(ticket.Attachments))
-	for _, attachment := range ticket.Attachments {
-		oldAttachmentsSet[attachment.Link] = struct{}{}
+	if err = useCases.ticketsService.UpdateTicketStatus(ctx, id, ticket.Status, status); err != nil {
+		return err
 	}
 
-	// New Ticket Attachments set:
-	newAttachmentsSet := make(map[string]struct{}, len(rawTicketData.Attachments))
-	for _, attachment := range rawTicketData.Attachments {
-		newAttachmentsSet[attachment] = struct{}{}
+	useCases.publishTicketEvent(ctx, entities.TicketEventTypeUpdated, ticket.ID, ticket)
+
+	return nil
+}
+
+// WatchTickets sends Tickets, matching filters, with provided send function: firstly snapshot of the newest
+// Tickets, if it was requested, and then Tickets changes until context is done. Subscription is made before
+// reading snapshot not to miss changes, so Ticket, changed during snapshot reading, could be sent twice.
+// Clients should use Ticket version to skip outdated states.
+func (useCases *UseCases) WatchTickets(
+	ctx context.Context,
+	filters *entities.TicketsFilters,
+	snapshot bool,
+	send func(event entities.TicketEvent) error,
+) error {
+	// Snapshot is selected via full-text search, if it is enabled. It can not be applied to events in memory,
+	// so events would not match the same search as snapshot:
+	if filters != nil && filters.Search != nil && *filters.Search != "" {
+		return &customerrors.WatchSearchNotSupportedError{}
 	}
 
-	// Add new Attachments if it is not already exists:
-	attachmentsToAdd := make([]string, 0)
+	ticketEvents, unsubscribe, err := useCases.ticketEventsBus.Subscribe()
+	if err != nil {
+		return err
+	}
+
+	defer unsubscribe()
+
+	if snapshot {
+		tickets, err := useCases.GetTickets(
+			ctx,
+			&entities.Pagination{Limit: &useCases.watchConfig.SnapshotLimit},
+			filters,
+		)
+		if err != nil {
+			return err
+		}
 
-	for _, attachment := range rawTicketData.Attachments {
-		if _, ok := oldAttachmentsSet[attachment]; !ok {
-			attachmentsToAdd = append(attachmentsToAdd, attachment)
+		for _, ticket := range tickets {
+			if err = send(entities.TicketEvent{Type: entities.TicketEventTypeSnapshot, Ticket: ticket}); err != nil {
+				return err
+			}
 		}
 	}
 
-	// Delete old Attachments if it is not used by Ticket now:
-	attachmentsToDelete := make([]uint64, 0)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-ticketEvents:
+			// Channel is closed by bus only if events buffer has overflowed:
+			if !ok {
+				return &customerrors.SlowWatchStreamError{
+					Message: fmt.Sprintf(
+						"watch stream has been closed, because %d Tickets events were not sent in time",
+						useCases.watchConfig.BufferSize,
+					),
+				}
+			}
+
+			if !matchTicketEvent(event, filters) {
+				continue
+			}
 
-	for _, attachment := range ticket.Attachments {
-		if _, ok := newAttachmentsSet[attachment.Link]; !ok {
-			attachmentsToDelete = append(attachmentsToDelete, attachment.ID)
+			if err = send(event); err != nil {
+				return err
+			}
 		}
 	}
+}
 
-	ticketData := entities.UpdateTicketDTO{
-		ID:                    rawTicketData.ID,
-		CategoryID:            rawTicketData.CategoryID,
-		Name:                  rawTicketData.Name,
-		Description:           rawTicketData.Description,
-		Price:                 rawTicketData.Price,
-		Quantity:              rawTicketData.Quantity,
-		TagIDsToAdd:           tagIDsToAdd,
-		TagIDsToDelete:        tagIDsToDelete,
-		AttachmentsToAdd:      attachmentsToAdd,
-		AttachmentIDsToDelete: attachmentsToDelete,
+// UpdateTicketsCategory moves Tickets of deleted Category to another one and notifies about each moved Ticket.
+func (useCases *UseCases) UpdateTicketsCategory(
+	ctx context.Context,
+	oldCategoryID, newCategoryID uint32,
+) error {
+	ticketIDs, err := useCases.ticketsService.UpdateTicketsCategory(
+		ctx,
+		oldCategoryID,
+		newCategoryID,
+		useCases.bulkTicketUpdatedOutboxMessageBuilder(ctx, fmt.Sprintf("category-deleted:%d", oldCategoryID)),
+	)
+	if err != nil {
+		return err
 	}
 
-	if err = useCases.ticketsService.UpdateTicket(ctx, ticketData); err != nil {
+	useCases.publishTicketsUpdatedEvents(
+		ctx,
+		ticketIDs,
+		func(previousTicket *entities.Ticket) {
+			previousTicket.CategoryID = oldCategoryID
+		},
+	)
+
+	return nil
+}
+
+// DeleteTagFromTickets removes deleted Tag from all Tickets and notifies about each changed Ticket.
+func (useCases *UseCases) DeleteTagFromTickets(ctx context.Context, tagID uint32) error {
+	ticketIDs, err := useCases.ticketsService.DeleteTagFromTickets(
+		ctx,
+		tagID,
+		useCases.bulkTicketUpdatedOutboxMessageBuilder(ctx, fmt.Sprintf("tag-deleted:%d", tagID)),
+	)
+	if err != nil {
 		return err
 	}
 
-	ticketUpdatedDTO := &notifications.TicketUpdatedDTO{
-		TicketID: ticket.ID,
+	useCases.publishTicketsUpdatedEvents(
+		ctx,
+		ticketIDs,
+		func(previousTicket *entities.Ticket) {
+			previousTicket.TagIDs = append(slices.Clone(previousTicket.TagIDs), tagID)
+		},
+	)
+
+	return nil
+}
+
+// WithdrawMasterResponds withdraws pending Responds of deleted Master.
+func (useCases *UseCases) WithdrawMasterResponds(ctx context.Context, masterID uint64) error {
+	return useCases.respondsService.WithdrawMasterResponds(ctx, masterID)
+}
+
+// DeleteUserData purges all Tickets of deleted User and all Responds, which User has created as a Master.
+func (useCases *UseCases) DeleteUserData(ctx context.Context, userID uint64) error {
+	userData := entities.DeleteUserDataDTO{UserID: userID}
+
+	master, err := useCases.toysService.GetMasterByUserID(ctx, userID)
+	if err == nil {
+		userData.MasterID = &master.ID
+	}
+
+	// User could be not a Master, so only Tickets of User should be deleted:
+	var masterNotFoundError *customerrors.MasterNotFoundError
+	if err != nil && !errors.As(err, &masterNotFoundError) {
+		return err
 	}
 
-	// Not returning error (if exists) from sending message, because it is not main logic and a newsletter:
-	content, err := json.Marshal(ticketUpdatedDTO)
+	return useCases.ticketsService.DeleteUserData(
+		ctx,
+		userData,
+		func(deletedData entities.DeletedUserDataDTO) (entities.CreateOutboxMessageDTO, error) {
+			userDataDeletedDTO := &dto.UserDataDeletedDTO{
+				UserID:                userData.UserID,
+				MasterID:              userData.MasterID,
+				TicketsCount:          deletedData.TicketsCount,
+				RespondsCount:         deletedData.RespondsCount,
+				AttachmentsCount:      deletedData.AttachmentsCount,
+				TagsAssociationsCount: deletedData.TagsAssociationsCount,
+				IdempotencyKeysCount:  deletedData.IdempotencyKeysCount,
+			}
+
+			content, err := events.Marshal(ctx, dto.UserDataDeletedEventType, userDataDeletedDTO)
+			if err != nil {
+				return entities.CreateOutboxMessageDTO{}, err
+			}
+
+			return entities.CreateOutboxMessageDTO{
+				Subject: useCases.natsConfig.Subjects.UserDataDeleted,
+				Payload: content,
+			}, nil
+		},
+	)
+}
+
+// publishTicketEvent sends new state of changed Ticket to watch streams. Ticket has already been changed,
+// so failure of reading its new state is only logged and does not fail request.
+func (useCases *UseCases) publishTicketEvent(
+	ctx context.Context,
+	eventType entities.TicketEventType,
+	ticketID uint64,
+	previousTicket *entities.Ticket,
+) {
+	if !useCases.ticketEventsBus.HasSubscribers() {
+		return
+	}
+
+	ticket, err := useCases.ticketsService.GetTicketByID(ctx, ticketID)
 	if err != nil {
 		logging.LogErrorContext(
 			ctx,
 			useCases.logger,
-			fmt.Sprintf(
-				"Error occurred while trying to encode data for update ticket email for Ticket with ID=%d",
-				ticket.ID,
-			),
+			fmt.Sprintf("Error occurred while trying to get Ticket with ID=%d for watch streams", ticketID),
 			err,
 		)
+
+		return
 	}
 
-	if err = useCases.natsPublisher.Publish(useCases.natsConfig.Subjects.TicketUpdated, content); err != nil {
+	useCases.ticketEventsBus.Publish(
+		entities.TicketEvent{
+			Type:           eventType,
+			Ticket:         *ticket,
+			PreviousTicket: previousTicket,
+		},
+	)
+}
+
+// bulkTicketUpdatedOutboxMessageBuilder creates builder of ticket-updated outbox message for Tickets, changed
+// by bulk operation. New versions of these Tickets are not read, but bulk operation changes each Ticket once,
+// so Ticket ID and operation identify event.
+func (useCases *UseCases) bulkTicketUpdatedOutboxMessageBuilder(
+	ctx context.Context,
+	operation string,
+) entities.OutboxMessageBuilder {
+	return func(ticketID uint64) (entities.CreateOutboxMessageDTO, error) {
+		ticketUpdatedDTO := &notifications.TicketUpdatedDTO{
+			TicketID: ticketID,
+		}
+
+		content, err := events.Marshal(ctx, dto.TicketUpdatedEventType, ticketUpdatedDTO)
+		if err != nil {
+			return entities.CreateOutboxMessageDTO{}, err
+		}
+
+		return entities.CreateOutboxMessageDTO{
+			Subject: useCases.natsConfig.Subjects.TicketUpdated,
+			Payload: content,
+			DeduplicationID: pointers.New(
+				fmt.Sprintf("%s:%d:%s", useCases.natsConfig.Subjects.TicketUpdated, ticketID, operation),
+			),
+		}, nil
+	}
+}
+
+// publishTicketsUpdatedEvents publishes events about Tickets, changed by bulk operation, to watch streams.
+// Previous state of each Ticket is restored from its current state by restorePrevious, since bulk operation
+// does not read Tickets before change.
+func (useCases *UseCases) publishTicketsUpdatedEvents(
+	ctx context.Context,
+	ticketIDs []uint64,
+	restorePrevious func(previousTicket *entities.Ticket),
+) {
+	if len(ticketIDs) == 0 || !useCases.ticketEventsBus.HasSubscribers() {
+		return
+	}
+
+	tickets, err := useCases.ticketsService.GetTicketsByIDs(ctx, ticketIDs)
+	if err != nil {
 		logging.LogErrorContext(
 			ctx,
 			useCases.logger,
-			fmt.Sprintf(
-				"Error occurred while trying send update ticket message for Ticket with ID=%d",
-				ticket.ID,
-			),
+			fmt.Sprintf("Error occurred while trying to get %d updated Tickets for watch streams", len(ticketIDs)),
 			err,
 		)
+
+		return
 	}
 
-	return nil
+	for _, ticket := range tickets {
+		previousTicket := ticket
+		previousTicket.Version--
+		restorePrevious(&previousTicket)
+
+		useCases.ticketEventsBus.Publish(
+			entities.TicketEvent{
+				Type:           entities.TicketEventTypeUpdated,
+				Ticket:         ticket,
+				PreviousTicket: &previousTicket,
+			},
+		)
+	}
 }
 
-func (useCases *UseCases) checkRespondExistence(
+// checkRespondOwnership checks that User with provided ID is the Master, who has created Respond.
+func (useCases *UseCases) checkRespondOwnership(
 	ctx context.Context,
-	respondData entities.RespondToTicketDTO,
-) bool {
-	responds, err := useCases.respondsService.GetMasterResponds(ctx, respondData.MasterID)
-	if err == nil {
-		for _, respond := range responds {
-			if respond.TicketID == respondData.TicketID {
-				return true
-			}
+	respond *entities.Respond,
+	userID uint64,
+) error {
+	master, err := useCases.toysService.GetMasterByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if respond.MasterID != master.ID {
+		return &customerrors.PermissionDeniedError{
+			Message: fmt.Sprintf("user with ID=%d is not the owner of Respond with ID=%d", userID, respond.ID),
 		}
 	}
 
-	return false
+	return nil
 }
 
 func (useCases *UseCases) checkTicketExistence(
@@ -411,9 +1032,9 @@ func (useCases *UseCases) validateTags(ctx context.Context, tagIDs []uint32) err
 		tagsMap[tag.ID] = struct{}{}
 	}
 
-	for _, tagID := range tagIDs {
+	for i, tagID := range tagIDs {
 		if _, ok := tagsMap[tagID]; !ok {
-			return &customerrors.TagNotFoundError{Message: strconv.FormatUint(uint64(tagID), 10)}
+			return &customerrors.TagNotFoundError{Message: strconv.FormatUint(uint64(tagID), 10), Index: i}
 		}
 	}
 
@@ -434,3 +1055,152 @@ func (useCases *UseCases) validateCategory(ctx context.Context, categoryID uint3
 
 	return &customerrors.CategoryNotFoundError{Message: strconv.FormatUint(uint64(categoryID), 10)}
 }
+
+// checkTicketOwnership checks that User with provided ID is the owner of Ticket.
+func checkTicketOwnership(ticket *entities.Ticket, userID uint64) error {
+	if ticket.UserID != userID {
+		return &customerrors.PermissionDeniedError{
+			Message: fmt.Sprintf("user with ID=%d is not the owner of Ticket with ID=%d", userID, ticket.ID),
+		}
+	}
+
+	return nil
+}
+
+// ticketEventDeduplicationID identifies Ticket event by Ticket ID and version of Ticket, which event
+// is based on, so events about different changes of the same Ticket are not treated as duplicates.
+func ticketEventDeduplicationID(subject string, ticketID, version uint64) *string {
+	return pointers.New(fmt.Sprintf("%s:%d:%d", subject, ticketID, version))
+}
+
+// resolveExpectedVersion returns version, which entity must have to be updated. If client has provided
+// version, it is checked against just read one to fail fast without opening transaction. Otherwise,
+// just read version is used to protect update from concurrent changes made after read.
+func resolveExpectedVersion(currentVersion uint64, providedVersion *uint64) (uint64, error) {
+	if providedVersion == nil {
+		return currentVersion, nil
+	}
+
+	if *providedVersion != currentVersion {
+		return 0, &customerrors.VersionConflictError{
+			Message: fmt.Sprintf(
+				"expected version %d does not match current version %d",
+				*providedVersion,
+				currentVersion,
+			),
+		}
+	}
+
+	return currentVersion, nil
+}
+
+// isTicketStatusTransitionAllowed describes Ticket lifecycle:
+// open -> in_progress -> completed, where open and in_progress Tickets can be cancelled
+// and in_progress Ticket can be returned to open pool. Completed and cancelled Tickets are final.
+func isTicketStatusTransitionAllowed(from, to entities.TicketStatus) bool {
+	switch from {
+	case entities.TicketStatusOpen:
+		return to == entities.TicketStatusInProgress || to == entities.TicketStatusCancelled
+	case entities.TicketStatusInProgress:
+		return to == entities.TicketStatusOpen ||
+			to == entities.TicketStatusCompleted ||
+			to == entities.TicketStatusCancelled
+	default:
+		return false
+	}
+}
+
+// uniqueIDs returns IDs without duplicates in order of their first occurrence.
+func uniqueIDs(ids []uint64) []uint64 {
+	unique := make([]uint64, 0, len(ids))
+	seen := make(map[uint64]struct{}, len(ids))
+
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	return unique
+}
+
+// orderByIDs returns found entities in order of requested IDs and IDs, for which entities were not found.
+// IDs must not contain duplicates.
+func orderByIDs[T any](ids []uint64, found []T, getID func(T) uint64) ([]T, []uint64) {
+	foundByID := make(map[uint64]T, len(found))
+	for _, entity := range found {
+		foundByID[getID(entity)] = entity
+	}
+
+	ordered := make([]T, 0, len(found))
+
+	var missingIDs []uint64
+
+	for _, id := range ids {
+		if entity, ok := foundByID[id]; ok {
+			ordered = append(ordered, entity)
+		} else {
+			missingIDs = append(missingIDs, id)
+		}
+	}
+
+	return ordered, missingIDs
+}
+
+// matchTicketEvent checks, whether event should be sent to watch stream with provided filters. Updated Ticket,
+// which matched filters before update, is also sent, so clients could find out that it does not match anymore.
+func matchTicketEvent(event entities.TicketEvent, filters *entities.TicketsFilters) bool {
+	return matchTicketsFilters(event.Ticket, filters) ||
+		(event.PreviousTicket != nil && matchTicketsFilters(*event.PreviousTicket, filters))
+}
+
+// matchTicketsFilters checks Ticket against filters in memory the same way as repository does. Search is not
+// checked, because it is rejected by WatchTickets. Sort options are ignored.
+func matchTicketsFilters(ticket entities.Ticket, filters *entities.TicketsFilters) bool {
+	if filters == nil {
+		return true
+	}
+
+	// Tickets without price do not match price filters as well as in database:
+	if filters.PriceFloor != nil && (ticket.Price == nil || *ticket.Price < *filters.PriceFloor) {
+		return false
+	}
+
+	if filters.PriceCeil != nil && (ticket.Price == nil || *ticket.Price > *filters.PriceCeil) {
+		return false
+	}
+
+	if filters.QuantityFloor != nil && ticket.Quantity < *filters.QuantityFloor {
+		return false
+	}
+
+	if len(filters.CategoryIDs) > 0 && !slices.Contains(filters.CategoryIDs, ticket.CategoryID) {
+		return false
+	}
+
+	// Ticket should have all provided Tags:
+	for _, tagID := range filters.TagIDs {
+		if !slices.Contains(ticket.TagIDs, tagID) {
+			return false
+		}
+	}
+
+	if len(filters.Statuses) > 0 && !slices.Contains(filters.Statuses, ticket.Status) {
+		return false
+	}
+
+	return matchTimeRange(ticket.CreatedAt, filters.CreatedAtFrom, filters.CreatedAtTo) &&
+		matchTimeRange(ticket.UpdatedAt, filters.UpdatedAtFrom, filters.UpdatedAtTo)
+}
+
+// matchTimeRange checks that value is within [from, to) range. Not provided bounds are not checked.
+func matchTimeRange(value time.Time, from, to *time.Time) bool {
+	if from != nil && value.Before(*from) {
+		return false
+	}
+
+	return to == nil || value.Before(*to)
+}
@@ -2,19 +2,23 @@ package usecases
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	mockservices "github.com/DKhorkov/hmtm-tickets/mocks/services"
 	mocklogging "github.com/DKhorkov/libs/logging/mocks"
-	mocknats "github.com/DKhorkov/libs/nats/mocks"
 	"github.com/DKhorkov/libs/pointers"
 
+	"github.com/DKhorkov/hmtm-tickets/dto"
 	"github.com/DKhorkov/hmtm-tickets/internal/config"
 	"github.com/DKhorkov/hmtm-tickets/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-tickets/internal/errors"
+	"github.com/DKhorkov/hmtm-tickets/internal/eventbus"
 )
 
 func TestUseCases_CreateTicket(t *testing.T) {
@@ -22,10 +26,10 @@ func TestUseCases_CreateTicket(t *testing.T) {
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
 	respondsService := mockservices.NewMockRespondsService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
-	natsPublisher := mocknats.NewMockPublisher(ctrl)
 	logger := mocklogging.NewMockLogger(ctrl)
 	natsConfig := config.NATSConfig{
 		Subjects: config.NATSSubjects{
+			TicketCreated: "create.ticket",
 			TicketUpdated: "update.ticket",
 			TicketDeleted: "delete.ticket",
 		},
@@ -35,8 +39,9 @@ func TestUseCases_CreateTicket(t *testing.T) {
 		ticketsService,
 		respondsService,
 		toysService,
-		natsPublisher,
+		eventbus.New(config.WatchConfig{}),
 		natsConfig,
+		config.WatchConfig{},
 		logger,
 	)
 
@@ -47,7 +52,6 @@ func TestUseCases_CreateTicket(t *testing.T) {
 			ticketsService *mockservices.MockTicketsService,
 			respondsService *mockservices.MockRespondsService,
 			toysService *mockservices.MockToysService,
-			natsPublisher *mocknats.MockPublisher,
 			logger *mocklogging.MockLogger,
 		)
 		expectedID    uint64
@@ -66,7 +70,6 @@ func TestUseCases_CreateTicket(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				toysService.
@@ -89,8 +92,27 @@ func TestUseCases_CreateTicket(t *testing.T) {
 
 				ticketsService.
 					EXPECT().
-					CreateTicket(gomock.Any(), gomock.Any()).
-					Return(uint64(1), nil).
+					CreateTicket(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(
+						func(
+							_ context.Context,
+							_ entities.CreateTicketDTO,
+							builders ...entities.OutboxMessageBuilder,
+						) (uint64, error) {
+							require.Len(t, builders, 1)
+
+							message, err := builders[0](1)
+							require.NoError(t, err)
+							require.Equal(t, "create.ticket", message.Subject)
+							require.JSONEq(
+								t,
+								`{"ticketId":1,"ticketOwnerId":1,"categoryId":1,"tagIds":[1,2]}`,
+								eventData(t, message.Payload, dto.TicketCreatedEventType),
+							)
+
+							return 1, nil
+						},
+					).
 					Times(1)
 			},
 			expectedID:    1,
@@ -109,7 +131,6 @@ func TestUseCases_CreateTicket(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				toysService.
@@ -134,7 +155,6 @@ func TestUseCases_CreateTicket(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				toysService.
@@ -165,7 +185,6 @@ func TestUseCases_CreateTicket(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				toysService.
@@ -206,7 +225,6 @@ func TestUseCases_CreateTicket(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				toysService.
@@ -229,13 +247,178 @@ func TestUseCases_CreateTicket(t *testing.T) {
 
 				ticketsService.
 					EXPECT().
-					CreateTicket(gomock.Any(), gomock.Any()).
+					CreateTicket(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(uint64(0), errors.New("create failed")).
 					Times(1)
 			},
 			expectedID:    0,
 			errorExpected: true,
 		},
+		{
+			name: "idempotency key replay",
+			ticketData: entities.CreateTicketDTO{
+				UserID:         1,
+				CategoryID:     1,
+				TagIDs:         []uint32{1},
+				Name:           "Test Ticket",
+				Description:    "Test Description",
+				IdempotencyKey: &entities.IdempotencyKeyDTO{UserID: 1, Key: "key"},
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketIDByIdempotencyKey(
+						gomock.Any(),
+						entities.IdempotencyKeyDTO{UserID: 1, Key: "key"},
+					).
+					Return(uint64(5), nil).
+					Times(1)
+			},
+			expectedID:    5,
+			errorExpected: false,
+		},
+		{
+			name: "new idempotency key",
+			ticketData: entities.CreateTicketDTO{
+				UserID:         1,
+				CategoryID:     1,
+				TagIDs:         []uint32{1},
+				Name:           "Test Ticket",
+				Description:    "Test Description",
+				IdempotencyKey: &entities.IdempotencyKeyDTO{UserID: 1, Key: "key"},
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketIDByIdempotencyKey(
+						gomock.Any(),
+						entities.IdempotencyKeyDTO{UserID: 1, Key: "key"},
+					).
+					Return(uint64(0), &customerrors.IdempotencyKeyNotFoundError{}).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetAllCategories(gomock.Any()).
+					Return([]entities.Category{{ID: 1}}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetAllTags(gomock.Any()).
+					Return([]entities.Tag{{ID: 1}}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					CreateTicket(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(
+						func(
+							_ context.Context,
+							ticketData entities.CreateTicketDTO,
+							_ ...entities.OutboxMessageBuilder,
+						) (uint64, error) {
+							require.Equal(
+								t,
+								&entities.IdempotencyKeyDTO{UserID: 1, Key: "key"},
+								ticketData.IdempotencyKey,
+							)
+
+							return 1, nil
+						},
+					).
+					Times(1)
+			},
+			expectedID:    1,
+			errorExpected: false,
+		},
+		{
+			name: "idempotency key used by concurrent request",
+			ticketData: entities.CreateTicketDTO{
+				UserID:         1,
+				CategoryID:     1,
+				TagIDs:         []uint32{1},
+				Name:           "Test Ticket",
+				Description:    "Test Description",
+				IdempotencyKey: &entities.IdempotencyKeyDTO{UserID: 1, Key: "key"},
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				gomock.InOrder(
+					ticketsService.
+						EXPECT().
+						GetTicketIDByIdempotencyKey(gomock.Any(), gomock.Any()).
+						Return(uint64(0), &customerrors.IdempotencyKeyNotFoundError{}).
+						Times(1),
+					ticketsService.
+						EXPECT().
+						GetTicketIDByIdempotencyKey(gomock.Any(), gomock.Any()).
+						Return(uint64(5), nil).
+						Times(1),
+				)
+
+				toysService.
+					EXPECT().
+					GetAllCategories(gomock.Any()).
+					Return([]entities.Category{{ID: 1}}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetAllTags(gomock.Any()).
+					Return([]entities.Tag{{ID: 1}}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					CreateTicket(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(0), errors.New("duplicate idempotency key")).
+					Times(1)
+			},
+			expectedID:    5,
+			errorExpected: false,
+		},
+		{
+			name: "get ticket ID by idempotency key error",
+			ticketData: entities.CreateTicketDTO{
+				UserID:         1,
+				CategoryID:     1,
+				TagIDs:         []uint32{1},
+				Name:           "Test Ticket",
+				Description:    "Test Description",
+				IdempotencyKey: &entities.IdempotencyKeyDTO{UserID: 1, Key: "key"},
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketIDByIdempotencyKey(
+						gomock.Any(),
+						entities.IdempotencyKeyDTO{UserID: 1, Key: "key"},
+					).
+					Return(uint64(0), errors.New("get ticket ID by idempotency key error")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
 		{
 			name: "get all tags error",
 			ticketData: entities.CreateTicketDTO{
@@ -249,7 +432,6 @@ func TestUseCases_CreateTicket(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				toysService.
@@ -279,7 +461,6 @@ func TestUseCases_CreateTicket(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				toysService.
@@ -295,7 +476,7 @@ func TestUseCases_CreateTicket(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
-				tc.setupMocks(ticketsService, respondsService, toysService, natsPublisher, logger)
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
 			}
 			id, err := useCases.CreateTicket(context.Background(), tc.ticketData)
 			if tc.errorExpected {
@@ -313,7 +494,6 @@ func TestUseCases_GetTicketByID(t *testing.T) {
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
 	respondsService := mockservices.NewMockRespondsService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
-	natsPublisher := mocknats.NewMockPublisher(ctrl)
 	logger := mocklogging.NewMockLogger(ctrl)
 	natsConfig := config.NATSConfig{}
 
@@ -321,8 +501,9 @@ func TestUseCases_GetTicketByID(t *testing.T) {
 		ticketsService,
 		respondsService,
 		toysService,
-		natsPublisher,
+		eventbus.New(config.WatchConfig{}),
 		natsConfig,
+		config.WatchConfig{},
 		logger,
 	)
 
@@ -333,7 +514,6 @@ func TestUseCases_GetTicketByID(t *testing.T) {
 			ticketsService *mockservices.MockTicketsService,
 			respondsService *mockservices.MockRespondsService,
 			toysService *mockservices.MockToysService,
-			natsPublisher *mocknats.MockPublisher,
 			logger *mocklogging.MockLogger,
 		)
 		expectedTicket *entities.Ticket
@@ -346,7 +526,6 @@ func TestUseCases_GetTicketByID(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				ticketsService.
@@ -365,7 +544,6 @@ func TestUseCases_GetTicketByID(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				ticketsService.
@@ -382,7 +560,7 @@ func TestUseCases_GetTicketByID(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
-				tc.setupMocks(ticketsService, respondsService, toysService, natsPublisher, logger)
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
 			}
 			ticket, err := useCases.GetTicketByID(context.Background(), tc.id)
 			if tc.errorExpected {
@@ -395,12 +573,116 @@ func TestUseCases_GetTicketByID(t *testing.T) {
 	}
 }
 
+func TestUseCases_GetTicketsByIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ticketsService := mockservices.NewMockTicketsService(ctrl)
+	respondsService := mockservices.NewMockRespondsService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	natsConfig := config.NATSConfig{}
+
+	useCases := New(
+		ticketsService,
+		respondsService,
+		toysService,
+		eventbus.New(config.WatchConfig{}),
+		natsConfig,
+		config.WatchConfig{},
+		logger,
+	)
+
+	testCases := []struct {
+		name       string
+		ids        []uint64
+		setupMocks func(
+			ticketsService *mockservices.MockTicketsService,
+			respondsService *mockservices.MockRespondsService,
+			toysService *mockservices.MockToysService,
+			logger *mocklogging.MockLogger,
+		)
+		expected           []entities.Ticket
+		expectedMissingIDs []uint64
+		errorExpected      bool
+	}{
+		{
+			name: "success",
+			ids:  []uint64{3, 1, 2},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketsByIDs(gomock.Any(), []uint64{3, 1, 2}).
+					Return([]entities.Ticket{{ID: 1}, {ID: 2}, {ID: 3}}, nil).
+					Times(1)
+			},
+			expected:           []entities.Ticket{{ID: 3}, {ID: 1}, {ID: 2}},
+			expectedMissingIDs: nil,
+			errorExpected:      false,
+		},
+		{
+			name: "missing and duplicated IDs",
+			ids:  []uint64{4, 1, 5, 1, 4},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketsByIDs(gomock.Any(), []uint64{4, 1, 5}).
+					Return([]entities.Ticket{{ID: 1}}, nil).
+					Times(1)
+			},
+			expected:           []entities.Ticket{{ID: 1}},
+			expectedMissingIDs: []uint64{4, 5},
+			errorExpected:      false,
+		},
+		{
+			name: "service error",
+			ids:  []uint64{1},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketsByIDs(gomock.Any(), []uint64{1}).
+					Return(nil, errors.New("fetch failed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
+			}
+			actual, missingIDs, err := useCases.GetTicketsByIDs(context.Background(), tc.ids)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, actual)
+				require.Equal(t, tc.expectedMissingIDs, missingIDs)
+			}
+		})
+	}
+}
+
 func TestUseCases_GetTickets(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
 	respondsService := mockservices.NewMockRespondsService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
-	natsPublisher := mocknats.NewMockPublisher(ctrl)
 	logger := mocklogging.NewMockLogger(ctrl)
 	natsConfig := config.NATSConfig{}
 
@@ -408,8 +690,9 @@ func TestUseCases_GetTickets(t *testing.T) {
 		ticketsService,
 		respondsService,
 		toysService,
-		natsPublisher,
+		eventbus.New(config.WatchConfig{}),
 		natsConfig,
+		config.WatchConfig{},
 		logger,
 	)
 
@@ -421,7 +704,6 @@ func TestUseCases_GetTickets(t *testing.T) {
 			ticketsService *mockservices.MockTicketsService,
 			respondsService *mockservices.MockRespondsService,
 			toysService *mockservices.MockToysService,
-			natsPublisher *mocknats.MockPublisher,
 			logger *mocklogging.MockLogger,
 		)
 		expectedTickets []entities.Ticket
@@ -446,7 +728,6 @@ func TestUseCases_GetTickets(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				ticketsService.
@@ -492,7 +773,6 @@ func TestUseCases_GetTickets(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				ticketsService.
@@ -524,7 +804,7 @@ func TestUseCases_GetTickets(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
-				tc.setupMocks(ticketsService, respondsService, toysService, natsPublisher, logger)
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
 			}
 			tickets, err := useCases.GetTickets(context.Background(), tc.pagination, tc.filters)
 			if tc.errorExpected {
@@ -542,7 +822,6 @@ func TestUseCases_GetUserTickets(t *testing.T) {
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
 	respondsService := mockservices.NewMockRespondsService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
-	natsPublisher := mocknats.NewMockPublisher(ctrl)
 	logger := mocklogging.NewMockLogger(ctrl)
 	natsConfig := config.NATSConfig{}
 
@@ -550,8 +829,9 @@ func TestUseCases_GetUserTickets(t *testing.T) {
 		ticketsService,
 		respondsService,
 		toysService,
-		natsPublisher,
+		eventbus.New(config.WatchConfig{}),
 		natsConfig,
+		config.WatchConfig{},
 		logger,
 	)
 
@@ -564,7 +844,6 @@ func TestUseCases_GetUserTickets(t *testing.T) {
 			ticketsService *mockservices.MockTicketsService,
 			respondsService *mockservices.MockRespondsService,
 			toysService *mockservices.MockToysService,
-			natsPublisher *mocknats.MockPublisher,
 			logger *mocklogging.MockLogger,
 		)
 		expectedTickets []entities.Ticket
@@ -590,7 +869,6 @@ func TestUseCases_GetUserTickets(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				ticketsService.
@@ -638,7 +916,6 @@ func TestUseCases_GetUserTickets(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				ticketsService.
@@ -671,7 +948,7 @@ func TestUseCases_GetUserTickets(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
-				tc.setupMocks(ticketsService, respondsService, toysService, natsPublisher, logger)
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
 			}
 			tickets, err := useCases.GetUserTickets(context.Background(), tc.userID, tc.pagination, tc.filters)
 			if tc.errorExpected {
@@ -689,16 +966,20 @@ func TestUseCases_RespondToTicket(t *testing.T) {
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
 	respondsService := mockservices.NewMockRespondsService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
-	natsPublisher := mocknats.NewMockPublisher(ctrl)
 	logger := mocklogging.NewMockLogger(ctrl)
-	natsConfig := config.NATSConfig{}
+	natsConfig := config.NATSConfig{
+		Subjects: config.NATSSubjects{
+			RespondCreated: "create.respond",
+		},
+	}
 
 	useCases := New(
 		ticketsService,
 		respondsService,
 		toysService,
-		natsPublisher,
+		eventbus.New(config.WatchConfig{}),
 		natsConfig,
+		config.WatchConfig{},
 		logger,
 	)
 
@@ -709,7 +990,6 @@ func TestUseCases_RespondToTicket(t *testing.T) {
 			ticketsService *mockservices.MockTicketsService,
 			respondsService *mockservices.MockRespondsService,
 			toysService *mockservices.MockToysService,
-			natsPublisher *mocknats.MockPublisher,
 			logger *mocklogging.MockLogger,
 		)
 		expectedID    uint64
@@ -727,13 +1007,12 @@ func TestUseCases_RespondToTicket(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				ticketsService.
 					EXPECT().
 					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&entities.Ticket{ID: 1, UserID: 1}, nil).
+					Return(&entities.Ticket{ID: 1, UserID: 1, Status: entities.TicketStatusOpen}, nil).
 					Times(1)
 
 				toysService.
@@ -744,14 +1023,27 @@ func TestUseCases_RespondToTicket(t *testing.T) {
 
 				respondsService.
 					EXPECT().
-					GetMasterResponds(gomock.Any(), uint64(2)).
-					Return([]entities.Respond{}, nil).
-					Times(1)
+					RespondToTicket(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(
+						func(
+							_ context.Context,
+							_ entities.RespondToTicketDTO,
+							builders ...entities.OutboxMessageBuilder,
+						) (uint64, error) {
+							require.Len(t, builders, 1)
 
-				respondsService.
-					EXPECT().
-					RespondToTicket(gomock.Any(), gomock.Any()).
-					Return(uint64(1), nil).
+							message, err := builders[0](1)
+							require.NoError(t, err)
+							require.Equal(t, "create.respond", message.Subject)
+							require.JSONEq(
+								t,
+								`{"ticketId":1,"ticketOwnerId":1,"respondId":1,"masterId":2}`,
+								eventData(t, message.Payload, dto.RespondCreatedEventType),
+							)
+
+							return 1, nil
+						},
+					).
 					Times(1)
 			},
 			expectedID:    1,
@@ -767,7 +1059,6 @@ func TestUseCases_RespondToTicket(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				ticketsService.
@@ -780,72 +1071,120 @@ func TestUseCases_RespondToTicket(t *testing.T) {
 			errorExpected: true,
 		},
 		{
-			name: "own ticket",
+			name: "idempotency key replay",
 			respondData: entities.RawRespondToTicketDTO{
-				TicketID: 1,
-				UserID:   1,
+				TicketID:       1,
+				UserID:         2,
+				Price:          100,
+				IdempotencyKey: pointers.New("key"),
 			},
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
-				ticketsService.
+				respondsService.
 					EXPECT().
-					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&entities.Ticket{ID: 1, UserID: 1}, nil).
+					GetRespondIDByIdempotencyKey(
+						gomock.Any(),
+						entities.IdempotencyKeyDTO{UserID: 2, Key: "key"},
+					).
+					Return(uint64(5), nil).
 					Times(1)
 			},
-			expectedID:    0,
-			errorExpected: true,
+			expectedID:    5,
+			errorExpected: false,
 		},
 		{
-			name: "master not found",
+			name: "new idempotency key",
 			respondData: entities.RawRespondToTicketDTO{
-				TicketID: 1,
-				UserID:   2,
+				TicketID:       1,
+				UserID:         2,
+				Price:          100,
+				IdempotencyKey: pointers.New("key"),
 			},
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
+				respondsService.
+					EXPECT().
+					GetRespondIDByIdempotencyKey(
+						gomock.Any(),
+						entities.IdempotencyKeyDTO{UserID: 2, Key: "key"},
+					).
+					Return(uint64(0), &customerrors.IdempotencyKeyNotFoundError{}).
+					Times(1)
+
 				ticketsService.
 					EXPECT().
 					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&entities.Ticket{ID: 1, UserID: 1}, nil).
+					Return(&entities.Ticket{ID: 1, UserID: 1, Status: entities.TicketStatusOpen}, nil).
 					Times(1)
 
 				toysService.
 					EXPECT().
 					GetMasterByUserID(gomock.Any(), uint64(2)).
-					Return(nil, errors.New("not found")).
+					Return(&entities.Master{ID: 2}, nil).
+					Times(1)
+
+				respondsService.
+					EXPECT().
+					RespondToTicket(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(
+						func(
+							_ context.Context,
+							respondData entities.RespondToTicketDTO,
+							_ ...entities.OutboxMessageBuilder,
+						) (uint64, error) {
+							require.Equal(
+								t,
+								&entities.IdempotencyKeyDTO{UserID: 2, Key: "key"},
+								respondData.IdempotencyKey,
+							)
+
+							return 1, nil
+						},
+					).
 					Times(1)
 			},
-			expectedID:    0,
-			errorExpected: true,
+			expectedID:    1,
+			errorExpected: false,
 		},
 		{
-			name: "respond already exists",
+			name: "idempotency key used by concurrent request",
 			respondData: entities.RawRespondToTicketDTO{
-				TicketID: 1,
-				UserID:   2,
+				TicketID:       1,
+				UserID:         2,
+				Price:          100,
+				IdempotencyKey: pointers.New("key"),
 			},
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
+				gomock.InOrder(
+					respondsService.
+						EXPECT().
+						GetRespondIDByIdempotencyKey(gomock.Any(), gomock.Any()).
+						Return(uint64(0), &customerrors.IdempotencyKeyNotFoundError{}).
+						Times(1),
+					respondsService.
+						EXPECT().
+						GetRespondIDByIdempotencyKey(gomock.Any(), gomock.Any()).
+						Return(uint64(5), nil).
+						Times(1),
+				)
+
 				ticketsService.
 					EXPECT().
 					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&entities.Ticket{ID: 1, UserID: 1}, nil).
+					Return(&entities.Ticket{ID: 1, UserID: 1, Status: entities.TicketStatusOpen}, nil).
 					Times(1)
 
 				toysService.
@@ -856,19 +1195,146 @@ func TestUseCases_RespondToTicket(t *testing.T) {
 
 				respondsService.
 					EXPECT().
-					GetMasterResponds(gomock.Any(), uint64(2)).
-					Return([]entities.Respond{{TicketID: 1}}, nil).
+					RespondToTicket(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(0), errors.New("duplicate idempotency key")).
+					Times(1)
+			},
+			expectedID:    5,
+			errorExpected: false,
+		},
+		{
+			name: "get respond ID by idempotency key error",
+			respondData: entities.RawRespondToTicketDTO{
+				TicketID:       1,
+				UserID:         2,
+				Price:          100,
+				IdempotencyKey: pointers.New("key"),
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondIDByIdempotencyKey(
+						gomock.Any(),
+						entities.IdempotencyKeyDTO{UserID: 2, Key: "key"},
+					).
+					Return(uint64(0), errors.New("get respond ID by idempotency key error")).
 					Times(1)
 			},
-			expectedID:    0,
 			errorExpected: true,
 		},
-	}
+		{
+			name: "own ticket",
+			respondData: entities.RawRespondToTicketDTO{
+				TicketID: 1,
+				UserID:   1,
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&entities.Ticket{ID: 1, UserID: 1, Status: entities.TicketStatusOpen}, nil).
+					Times(1)
+			},
+			expectedID:    0,
+			errorExpected: true,
+		},
+		{
+			name: "ticket is not open",
+			respondData: entities.RawRespondToTicketDTO{
+				TicketID: 1,
+				UserID:   2,
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&entities.Ticket{ID: 1, UserID: 1, Status: entities.TicketStatusInProgress}, nil).
+					Times(1)
+			},
+			expectedID:    0,
+			errorExpected: true,
+		},
+		{
+			name: "master not found",
+			respondData: entities.RawRespondToTicketDTO{
+				TicketID: 1,
+				UserID:   2,
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&entities.Ticket{ID: 1, UserID: 1, Status: entities.TicketStatusOpen}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), uint64(2)).
+					Return(nil, errors.New("not found")).
+					Times(1)
+			},
+			expectedID:    0,
+			errorExpected: true,
+		},
+		{
+			name: "respond already exists",
+			respondData: entities.RawRespondToTicketDTO{
+				TicketID: 1,
+				UserID:   2,
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&entities.Ticket{ID: 1, UserID: 1, Status: entities.TicketStatusOpen}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), uint64(2)).
+					Return(&entities.Master{ID: 2}, nil).
+					Times(1)
+
+				respondsService.
+					EXPECT().
+					RespondToTicket(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(0), &customerrors.RespondAlreadyExistsError{}).
+					Times(1)
+			},
+			expectedID:    0,
+			errorExpected: true,
+		},
+	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
-				tc.setupMocks(ticketsService, respondsService, toysService, natsPublisher, logger)
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
 			}
 			id, err := useCases.RespondToTicket(context.Background(), tc.respondData)
 			if tc.errorExpected {
@@ -886,7 +1352,6 @@ func TestUseCases_GetRespondByID(t *testing.T) {
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
 	respondsService := mockservices.NewMockRespondsService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
-	natsPublisher := mocknats.NewMockPublisher(ctrl)
 	logger := mocklogging.NewMockLogger(ctrl)
 	natsConfig := config.NATSConfig{}
 
@@ -894,8 +1359,9 @@ func TestUseCases_GetRespondByID(t *testing.T) {
 		ticketsService,
 		respondsService,
 		toysService,
-		natsPublisher,
+		eventbus.New(config.WatchConfig{}),
 		natsConfig,
+		config.WatchConfig{},
 		logger,
 	)
 
@@ -906,7 +1372,6 @@ func TestUseCases_GetRespondByID(t *testing.T) {
 			ticketsService *mockservices.MockTicketsService,
 			respondsService *mockservices.MockRespondsService,
 			toysService *mockservices.MockToysService,
-			natsPublisher *mocknats.MockPublisher,
 			logger *mocklogging.MockLogger,
 		)
 		expectedRespond *entities.Respond
@@ -919,7 +1384,6 @@ func TestUseCases_GetRespondByID(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				respondsService.
@@ -938,7 +1402,6 @@ func TestUseCases_GetRespondByID(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				respondsService.
@@ -955,7 +1418,7 @@ func TestUseCases_GetRespondByID(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
-				tc.setupMocks(ticketsService, respondsService, toysService, natsPublisher, logger)
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
 			}
 			respond, err := useCases.GetRespondByID(context.Background(), tc.id)
 			if tc.errorExpected {
@@ -968,12 +1431,116 @@ func TestUseCases_GetRespondByID(t *testing.T) {
 	}
 }
 
+func TestUseCases_GetRespondsByIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ticketsService := mockservices.NewMockTicketsService(ctrl)
+	respondsService := mockservices.NewMockRespondsService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	natsConfig := config.NATSConfig{}
+
+	useCases := New(
+		ticketsService,
+		respondsService,
+		toysService,
+		eventbus.New(config.WatchConfig{}),
+		natsConfig,
+		config.WatchConfig{},
+		logger,
+	)
+
+	testCases := []struct {
+		name       string
+		ids        []uint64
+		setupMocks func(
+			ticketsService *mockservices.MockTicketsService,
+			respondsService *mockservices.MockRespondsService,
+			toysService *mockservices.MockToysService,
+			logger *mocklogging.MockLogger,
+		)
+		expected           []entities.Respond
+		expectedMissingIDs []uint64
+		errorExpected      bool
+	}{
+		{
+			name: "success",
+			ids:  []uint64{3, 1, 2},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondsByIDs(gomock.Any(), []uint64{3, 1, 2}).
+					Return([]entities.Respond{{ID: 1}, {ID: 2}, {ID: 3}}, nil).
+					Times(1)
+			},
+			expected:           []entities.Respond{{ID: 3}, {ID: 1}, {ID: 2}},
+			expectedMissingIDs: nil,
+			errorExpected:      false,
+		},
+		{
+			name: "missing and duplicated IDs",
+			ids:  []uint64{4, 1, 5, 1, 4},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondsByIDs(gomock.Any(), []uint64{4, 1, 5}).
+					Return([]entities.Respond{{ID: 1}}, nil).
+					Times(1)
+			},
+			expected:           []entities.Respond{{ID: 1}},
+			expectedMissingIDs: []uint64{4, 5},
+			errorExpected:      false,
+		},
+		{
+			name: "service error",
+			ids:  []uint64{1},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondsByIDs(gomock.Any(), []uint64{1}).
+					Return(nil, errors.New("fetch failed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
+			}
+			actual, missingIDs, err := useCases.GetRespondsByIDs(context.Background(), tc.ids)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, actual)
+				require.Equal(t, tc.expectedMissingIDs, missingIDs)
+			}
+		})
+	}
+}
+
 func TestUseCases_GetTicketResponds(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
 	respondsService := mockservices.NewMockRespondsService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
-	natsPublisher := mocknats.NewMockPublisher(ctrl)
 	logger := mocklogging.NewMockLogger(ctrl)
 	natsConfig := config.NATSConfig{}
 
@@ -981,8 +1548,9 @@ func TestUseCases_GetTicketResponds(t *testing.T) {
 		ticketsService,
 		respondsService,
 		toysService,
-		natsPublisher,
+		eventbus.New(config.WatchConfig{}),
 		natsConfig,
+		config.WatchConfig{},
 		logger,
 	)
 
@@ -993,7 +1561,6 @@ func TestUseCases_GetTicketResponds(t *testing.T) {
 			ticketsService *mockservices.MockTicketsService,
 			respondsService *mockservices.MockRespondsService,
 			toysService *mockservices.MockToysService,
-			natsPublisher *mocknats.MockPublisher,
 			logger *mocklogging.MockLogger,
 		)
 		expectedResponds []entities.Respond
@@ -1006,12 +1573,11 @@ func TestUseCases_GetTicketResponds(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				respondsService.
 					EXPECT().
-					GetTicketResponds(gomock.Any(), uint64(1)).
+					GetTicketResponds(gomock.Any(), uint64(1), nil, nil).
 					Return([]entities.Respond{{ID: 1}}, nil).
 					Times(1)
 			},
@@ -1025,12 +1591,11 @@ func TestUseCases_GetTicketResponds(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				respondsService.
 					EXPECT().
-					GetTicketResponds(gomock.Any(), uint64(1)).
+					GetTicketResponds(gomock.Any(), uint64(1), nil, nil).
 					Return(nil, errors.New("fetch failed")).
 					Times(1)
 			},
@@ -1042,9 +1607,9 @@ func TestUseCases_GetTicketResponds(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
-				tc.setupMocks(ticketsService, respondsService, toysService, natsPublisher, logger)
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
 			}
-			responds, err := useCases.GetTicketResponds(context.Background(), tc.ticketID)
+			responds, err := useCases.GetTicketResponds(context.Background(), tc.ticketID, nil, nil)
 			if tc.errorExpected {
 				require.Error(t, err)
 			} else {
@@ -1060,7 +1625,6 @@ func TestUseCases_GetUserResponds(t *testing.T) {
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
 	respondsService := mockservices.NewMockRespondsService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
-	natsPublisher := mocknats.NewMockPublisher(ctrl)
 	logger := mocklogging.NewMockLogger(ctrl)
 	natsConfig := config.NATSConfig{}
 
@@ -1068,8 +1632,9 @@ func TestUseCases_GetUserResponds(t *testing.T) {
 		ticketsService,
 		respondsService,
 		toysService,
-		natsPublisher,
+		eventbus.New(config.WatchConfig{}),
 		natsConfig,
+		config.WatchConfig{},
 		logger,
 	)
 
@@ -1080,7 +1645,6 @@ func TestUseCases_GetUserResponds(t *testing.T) {
 			ticketsService *mockservices.MockTicketsService,
 			respondsService *mockservices.MockRespondsService,
 			toysService *mockservices.MockToysService,
-			natsPublisher *mocknats.MockPublisher,
 			logger *mocklogging.MockLogger,
 		)
 		expectedResponds []entities.Respond
@@ -1093,7 +1657,6 @@ func TestUseCases_GetUserResponds(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				toysService.
@@ -1104,7 +1667,7 @@ func TestUseCases_GetUserResponds(t *testing.T) {
 
 				respondsService.
 					EXPECT().
-					GetMasterResponds(gomock.Any(), uint64(1)).
+					GetMasterResponds(gomock.Any(), uint64(1), nil, nil).
 					Return([]entities.Respond{{ID: 1}}, nil).
 					Times(1)
 			},
@@ -1118,7 +1681,6 @@ func TestUseCases_GetUserResponds(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				toysService.
@@ -1137,7 +1699,6 @@ func TestUseCases_GetUserResponds(t *testing.T) {
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
 				toysService.
@@ -1148,7 +1709,7 @@ func TestUseCases_GetUserResponds(t *testing.T) {
 
 				respondsService.
 					EXPECT().
-					GetMasterResponds(gomock.Any(), uint64(1)).
+					GetMasterResponds(gomock.Any(), uint64(1), nil, nil).
 					Return(nil, errors.New("fetch failed")).
 					Times(1)
 			},
@@ -1160,9 +1721,9 @@ func TestUseCases_GetUserResponds(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
-				tc.setupMocks(ticketsService, respondsService, toysService, natsPublisher, logger)
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
 			}
-			responds, err := useCases.GetUserResponds(context.Background(), tc.userID)
+			responds, err := useCases.GetUserResponds(context.Background(), tc.userID, nil, nil)
 			if tc.errorExpected {
 				require.Error(t, err)
 			} else {
@@ -1173,12 +1734,11 @@ func TestUseCases_GetUserResponds(t *testing.T) {
 	}
 }
 
-func TestUseCases_UpdateRespond(t *testing.T) {
+func TestUseCases_CountTicketResponds(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
 	respondsService := mockservices.NewMockRespondsService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
-	natsPublisher := mocknats.NewMockPublisher(ctrl)
 	logger := mocklogging.NewMockLogger(ctrl)
 	natsConfig := config.NATSConfig{}
 
@@ -1186,95 +1746,50 @@ func TestUseCases_UpdateRespond(t *testing.T) {
 		ticketsService,
 		respondsService,
 		toysService,
-		natsPublisher,
+		eventbus.New(config.WatchConfig{}),
 		natsConfig,
+		config.WatchConfig{},
 		logger,
 	)
 
+	filters := &entities.RespondsFilters{
+		PriceFloor: pointers.New[float32](100),
+		Statuses:   []entities.RespondStatus{entities.RespondStatusPending},
+	}
+
 	testCases := []struct {
-		name        string
-		respondData entities.UpdateRespondDTO
-		setupMocks  func(
-			ticketsService *mockservices.MockTicketsService,
-			respondsService *mockservices.MockRespondsService,
-			toysService *mockservices.MockToysService,
-			natsPublisher *mocknats.MockPublisher,
-			logger *mocklogging.MockLogger,
-		)
+		name          string
+		ticketID      uint64
+		filters       *entities.RespondsFilters
+		setupMocks    func(respondsService *mockservices.MockRespondsService)
+		expectedCount uint64
 		errorExpected bool
 	}{
 		{
-			name: "success",
-			respondData: entities.UpdateRespondDTO{
-				ID:      1,
-				Price:   pointers.New[float32](200),
-				Comment: pointers.New("Test comment"),
-			},
-			setupMocks: func(
-				ticketsService *mockservices.MockTicketsService,
-				respondsService *mockservices.MockRespondsService,
-				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
-				logger *mocklogging.MockLogger,
-			) {
-				respondsService.
-					EXPECT().
-					GetRespondByID(gomock.Any(), uint64(1)).
-					Return(&entities.Respond{ID: 1}, nil).
-					Times(1)
-
+			name:     "success",
+			ticketID: 1,
+			filters:  filters,
+			setupMocks: func(respondsService *mockservices.MockRespondsService) {
 				respondsService.
 					EXPECT().
-					UpdateRespond(gomock.Any(), gomock.Any()).
-					Return(nil).
+					CountTicketResponds(gomock.Any(), uint64(1), filters).
+					Return(uint64(2), nil).
 					Times(1)
 			},
+			expectedCount: 2,
 			errorExpected: false,
 		},
 		{
-			name: "respond not found",
-			respondData: entities.UpdateRespondDTO{
-				ID: 1,
-			},
-			setupMocks: func(
-				ticketsService *mockservices.MockTicketsService,
-				respondsService *mockservices.MockRespondsService,
-				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
-				logger *mocklogging.MockLogger,
-			) {
-				respondsService.
-					EXPECT().
-					GetRespondByID(gomock.Any(), uint64(1)).
-					Return(nil, errors.New("not found")).
-					Times(1)
-			},
-			errorExpected: true,
-		},
-		{
-			name: "update error",
-			respondData: entities.UpdateRespondDTO{
-				ID: 1,
-			},
-			setupMocks: func(
-				ticketsService *mockservices.MockTicketsService,
-				respondsService *mockservices.MockRespondsService,
-				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
-				logger *mocklogging.MockLogger,
-			) {
-				respondsService.
-					EXPECT().
-					GetRespondByID(gomock.Any(), uint64(1)).
-					Return(&entities.Respond{ID: 1}, nil).
-					Times(1)
-
+			name:     "count error",
+			ticketID: 1,
+			setupMocks: func(respondsService *mockservices.MockRespondsService) {
 				respondsService.
 					EXPECT().
-					UpdateRespond(gomock.Any(), gomock.Any()).
-					Return(errors.New("update failed")).
+					CountTicketResponds(gomock.Any(), uint64(1), nil).
+					Return(uint64(0), errors.New("count failed")).
 					Times(1)
 			},
+			expectedCount: 0,
 			errorExpected: true,
 		},
 	}
@@ -1282,24 +1797,26 @@ func TestUseCases_UpdateRespond(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
-				tc.setupMocks(ticketsService, respondsService, toysService, natsPublisher, logger)
+				tc.setupMocks(respondsService)
 			}
-			err := useCases.UpdateRespond(context.Background(), tc.respondData)
+
+			count, err := useCases.CountTicketResponds(context.Background(), tc.ticketID, tc.filters)
 			if tc.errorExpected {
 				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
 			}
+
+			require.Equal(t, tc.expectedCount, count)
 		})
 	}
 }
 
-func TestUseCases_DeleteRespond(t *testing.T) {
+func TestUseCases_CountUserResponds(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
 	respondsService := mockservices.NewMockRespondsService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
-	natsPublisher := mocknats.NewMockPublisher(ctrl)
 	logger := mocklogging.NewMockLogger(ctrl)
 	natsConfig := config.NATSConfig{}
 
@@ -1307,87 +1824,80 @@ func TestUseCases_DeleteRespond(t *testing.T) {
 		ticketsService,
 		respondsService,
 		toysService,
-		natsPublisher,
+		eventbus.New(config.WatchConfig{}),
 		natsConfig,
+		config.WatchConfig{},
 		logger,
 	)
 
 	testCases := []struct {
 		name       string
-		id         uint64
+		userID     uint64
 		setupMocks func(
-			ticketsService *mockservices.MockTicketsService,
 			respondsService *mockservices.MockRespondsService,
 			toysService *mockservices.MockToysService,
-			natsPublisher *mocknats.MockPublisher,
-			logger *mocklogging.MockLogger,
 		)
+		expectedCount uint64
 		errorExpected bool
 	}{
 		{
-			name: "success",
-			id:   1,
+			name:   "success",
+			userID: 1,
 			setupMocks: func(
-				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
-				logger *mocklogging.MockLogger,
 			) {
-				respondsService.
+				toysService.
 					EXPECT().
-					GetRespondByID(gomock.Any(), uint64(1)).
-					Return(&entities.Respond{ID: 1}, nil).
+					GetMasterByUserID(gomock.Any(), uint64(1)).
+					Return(&entities.Master{ID: 2}, nil).
 					Times(1)
 
 				respondsService.
 					EXPECT().
-					DeleteRespond(gomock.Any(), uint64(1)).
-					Return(nil).
+					CountMasterResponds(gomock.Any(), uint64(2), nil).
+					Return(uint64(4), nil).
 					Times(1)
 			},
+			expectedCount: 4,
 			errorExpected: false,
 		},
 		{
-			name: "respond not found",
-			id:   1,
+			name:   "master not found",
+			userID: 1,
 			setupMocks: func(
-				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
-				logger *mocklogging.MockLogger,
 			) {
-				respondsService.
+				toysService.
 					EXPECT().
-					GetRespondByID(gomock.Any(), uint64(1)).
+					GetMasterByUserID(gomock.Any(), uint64(1)).
 					Return(nil, errors.New("not found")).
 					Times(1)
 			},
+			expectedCount: 0,
 			errorExpected: true,
 		},
 		{
-			name: "delete error",
-			id:   1,
+			name:   "count error",
+			userID: 1,
 			setupMocks: func(
-				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
-				logger *mocklogging.MockLogger,
 			) {
-				respondsService.
+				toysService.
 					EXPECT().
-					GetRespondByID(gomock.Any(), uint64(1)).
-					Return(&entities.Respond{ID: 1}, nil).
+					GetMasterByUserID(gomock.Any(), uint64(1)).
+					Return(&entities.Master{ID: 2}, nil).
 					Times(1)
 
 				respondsService.
 					EXPECT().
-					DeleteRespond(gomock.Any(), uint64(1)).
-					Return(errors.New("delete failed")).
+					CountMasterResponds(gomock.Any(), uint64(2), nil).
+					Return(uint64(0), errors.New("count failed")).
 					Times(1)
 			},
+			expectedCount: 0,
 			errorExpected: true,
 		},
 	}
@@ -1395,28 +1905,30 @@ func TestUseCases_DeleteRespond(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
-				tc.setupMocks(ticketsService, respondsService, toysService, natsPublisher, logger)
+				tc.setupMocks(respondsService, toysService)
 			}
-			err := useCases.DeleteRespond(context.Background(), tc.id)
+
+			count, err := useCases.CountUserResponds(context.Background(), tc.userID, nil)
 			if tc.errorExpected {
 				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
 			}
+
+			require.Equal(t, tc.expectedCount, count)
 		})
 	}
 }
 
-func TestUseCases_DeleteTicket(t *testing.T) {
+func TestUseCases_UpdateRespond(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
 	respondsService := mockservices.NewMockRespondsService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
-	natsPublisher := mocknats.NewMockPublisher(ctrl)
 	logger := mocklogging.NewMockLogger(ctrl)
 	natsConfig := config.NATSConfig{
 		Subjects: config.NATSSubjects{
-			TicketDeleted: "delete.ticket",
+			RespondUpdated: "update.respond",
 		},
 	}
 
@@ -1424,182 +1936,282 @@ func TestUseCases_DeleteTicket(t *testing.T) {
 		ticketsService,
 		respondsService,
 		toysService,
-		natsPublisher,
+		eventbus.New(config.WatchConfig{}),
 		natsConfig,
+		config.WatchConfig{},
 		logger,
 	)
 
 	testCases := []struct {
-		name       string
-		id         uint64
-		setupMocks func(
+		name        string
+		respondData entities.RawUpdateRespondDTO
+		setupMocks  func(
 			ticketsService *mockservices.MockTicketsService,
 			respondsService *mockservices.MockRespondsService,
 			toysService *mockservices.MockToysService,
-			natsPublisher *mocknats.MockPublisher,
 			logger *mocklogging.MockLogger,
 		)
 		errorExpected bool
 	}{
 		{
 			name: "success",
-			id:   1,
+			respondData: entities.RawUpdateRespondDTO{
+				ID:      1,
+				UserID:  5,
+				Price:   pointers.New[float32](200),
+				Comment: pointers.New("Test comment"),
+			},
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
-				ticket := entities.Ticket{ID: 1, UserID: 1, Name: "Test", Description: "Desc", Quantity: 1}
-				ticketsService.
+				respondsService.
 					EXPECT().
-					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&ticket, nil).
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, MasterID: 3, Status: entities.RespondStatusPending, Version: 2}, nil).
 					Times(1)
 
-				respondsService.
+				toysService.
 					EXPECT().
-					GetTicketResponds(gomock.Any(), uint64(1)).
-					Return([]entities.Respond{{MasterID: 2}}, nil).
+					GetMasterByUserID(gomock.Any(), uint64(5)).
+					Return(&entities.Master{ID: 3}, nil).
 					Times(1)
 
 				ticketsService.
 					EXPECT().
-					DeleteTicket(gomock.Any(), uint64(1)).
-					Return(nil).
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(&entities.Ticket{ID: 2, UserID: 7}, nil).
 					Times(1)
 
-				natsPublisher.
+				respondsService.
 					EXPECT().
-					Publish("delete.ticket", gomock.Any()).
+					UpdateRespond(
+						gomock.Any(),
+						entities.UpdateRespondDTO{
+							ID:              1,
+							Price:           pointers.New[float32](200),
+							Comment:         pointers.New("Test comment"),
+							ExpectedVersion: 2,
+						},
+						outboxMessageWithSubject("update.respond"),
+					).
 					Return(nil).
 					Times(1)
 			},
 			errorExpected: false,
 		},
 		{
-			name: "ticket not found",
-			id:   1,
+			name: "provided version is stale",
+			respondData: entities.RawUpdateRespondDTO{
+				ID:              1,
+				UserID:          5,
+				ExpectedVersion: pointers.New[uint64](1),
+			},
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
+				respondsService.
+					EXPECT().
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, MasterID: 3, Status: entities.RespondStatusPending, Version: 2}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), uint64(5)).
+					Return(&entities.Master{ID: 3}, nil).
+					Times(1)
+
 				ticketsService.
 					EXPECT().
-					GetTicketByID(gomock.Any(), uint64(1)).
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(&entities.Ticket{ID: 2, UserID: 7}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "respond not found",
+			respondData: entities.RawUpdateRespondDTO{
+				ID:     1,
+				UserID: 5,
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondByID(gomock.Any(), uint64(1)).
 					Return(nil, errors.New("not found")).
 					Times(1)
 			},
 			errorExpected: true,
 		},
 		{
-			name: "responds fetch error",
-			id:   1,
+			name: "master not found",
+			respondData: entities.RawUpdateRespondDTO{
+				ID:     1,
+				UserID: 5,
+			},
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
-				ticketsService.
+				respondsService.
 					EXPECT().
-					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&entities.Ticket{ID: 1}, nil).
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, MasterID: 3, Status: entities.RespondStatusPending}, nil).
 					Times(1)
 
-				respondsService.
+				toysService.
 					EXPECT().
-					GetTicketResponds(gomock.Any(), uint64(1)).
-					Return(nil, errors.New("fetch failed")).
+					GetMasterByUserID(gomock.Any(), uint64(5)).
+					Return(nil, errors.New("not found")).
 					Times(1)
 			},
 			errorExpected: true,
 		},
 		{
-			name: "delete error",
-			id:   1,
+			name: "permission denied",
+			respondData: entities.RawUpdateRespondDTO{
+				ID:     1,
+				UserID: 5,
+			},
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
-				ticketsService.
+				respondsService.
 					EXPECT().
-					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&entities.Ticket{ID: 1}, nil).
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, MasterID: 3, Status: entities.RespondStatusPending}, nil).
 					Times(1)
 
+				toysService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), uint64(5)).
+					Return(&entities.Master{ID: 4}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "update error",
+			respondData: entities.RawUpdateRespondDTO{
+				ID:     1,
+				UserID: 5,
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
 				respondsService.
 					EXPECT().
-					GetTicketResponds(gomock.Any(), uint64(1)).
-					Return([]entities.Respond{}, nil).
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, MasterID: 3, Status: entities.RespondStatusPending}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), uint64(5)).
+					Return(&entities.Master{ID: 3}, nil).
 					Times(1)
 
 				ticketsService.
 					EXPECT().
-					DeleteTicket(gomock.Any(), uint64(1)).
-					Return(errors.New("delete failed")).
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(&entities.Ticket{ID: 2, UserID: 7}, nil).
+					Times(1)
+
+				respondsService.
+					EXPECT().
+					UpdateRespond(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("update failed")).
 					Times(1)
 			},
 			errorExpected: true,
 		},
 		{
-			name: "nats publish error",
-			id:   1,
+			name: "ticket not found",
+			respondData: entities.RawUpdateRespondDTO{
+				ID:     1,
+				UserID: 5,
+			},
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
-				ticket := entities.Ticket{ID: 1, Name: "Test", Description: "Desc", Quantity: 1}
-				ticketsService.
+				respondsService.
 					EXPECT().
-					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&ticket, nil).
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, MasterID: 3, Status: entities.RespondStatusPending}, nil).
 					Times(1)
 
-				respondsService.
+				toysService.
 					EXPECT().
-					GetTicketResponds(gomock.Any(), uint64(1)).
-					Return([]entities.Respond{}, nil).
+					GetMasterByUserID(gomock.Any(), uint64(5)).
+					Return(&entities.Master{ID: 3}, nil).
 					Times(1)
 
 				ticketsService.
 					EXPECT().
-					DeleteTicket(gomock.Any(), uint64(1)).
-					Return(nil).
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(nil, errors.New("not found")).
 					Times(1)
-
-				natsPublisher.
+			},
+			errorExpected: true,
+		},
+		{
+			name: "respond is not pending",
+			respondData: entities.RawUpdateRespondDTO{
+				ID:      1,
+				UserID:  5,
+				Comment: pointers.New("Test comment"),
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
 					EXPECT().
-					Publish("delete.ticket", gomock.Any()).
-					Return(errors.New("publish failed")).
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, MasterID: 3, Status: entities.RespondStatusAccepted}, nil).
 					Times(1)
 
-				logger.
+				toysService.
 					EXPECT().
-					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					GetMasterByUserID(gomock.Any(), uint64(5)).
+					Return(&entities.Master{ID: 3}, nil).
 					Times(1)
 			},
-			errorExpected: false, // NATS error не возвращается
+			errorExpected: true,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
-				tc.setupMocks(ticketsService, respondsService, toysService, natsPublisher, logger)
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
 			}
-			err := useCases.DeleteTicket(context.Background(), tc.id)
+			err := useCases.UpdateRespond(context.Background(), tc.respondData)
 			if tc.errorExpected {
 				require.Error(t, err)
 			} else {
@@ -1609,16 +2221,15 @@ func TestUseCases_DeleteTicket(t *testing.T) {
 	}
 }
 
-func TestUseCases_UpdateTicket(t *testing.T) {
+func TestUseCases_DeleteRespond(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
 	respondsService := mockservices.NewMockRespondsService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
-	natsPublisher := mocknats.NewMockPublisher(ctrl)
 	logger := mocklogging.NewMockLogger(ctrl)
 	natsConfig := config.NATSConfig{
 		Subjects: config.NATSSubjects{
-			TicketUpdated: "update.ticket",
+			RespondDeleted: "delete.respond",
 		},
 	}
 
@@ -1626,429 +2237,370 @@ func TestUseCases_UpdateTicket(t *testing.T) {
 		ticketsService,
 		respondsService,
 		toysService,
-		natsPublisher,
+		eventbus.New(config.WatchConfig{}),
 		natsConfig,
+		config.WatchConfig{},
 		logger,
 	)
 
 	testCases := []struct {
 		name       string
-		ticketData entities.RawUpdateTicketDTO
+		id         uint64
+		userID     uint64
 		setupMocks func(
 			ticketsService *mockservices.MockTicketsService,
 			respondsService *mockservices.MockRespondsService,
 			toysService *mockservices.MockToysService,
-			natsPublisher *mocknats.MockPublisher,
 			logger *mocklogging.MockLogger,
 		)
 		errorExpected bool
 	}{
 		{
-			name: "success",
-			ticketData: entities.RawUpdateTicketDTO{
-				ID:          1,
-				CategoryID:  pointers.New[uint32](1),
-				TagIDs:      []uint32{1},
-				Name:        pointers.New("Updated Ticket"),
-				Description: pointers.New("Updated Desc"),
-			},
+			name:   "success",
+			id:     1,
+			userID: 5,
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
-				ticket := entities.Ticket{ID: 1, TagIDs: []uint32{2}}
-				ticketsService.
-					EXPECT().
-					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&ticket, nil).
-					Times(1)
-
-				toysService.
+				respondsService.
 					EXPECT().
-					GetAllCategories(gomock.Any()).
-					Return([]entities.Category{{ID: 1}}, nil).
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, MasterID: 3}, nil).
 					Times(1)
 
 				toysService.
 					EXPECT().
-					GetAllTags(gomock.Any()).
-					Return([]entities.Tag{{ID: 1}}, nil).
+					GetMasterByUserID(gomock.Any(), uint64(5)).
+					Return(&entities.Master{ID: 3}, nil).
 					Times(1)
 
 				ticketsService.
 					EXPECT().
-					UpdateTicket(gomock.Any(), gomock.Any()).
-					Return(nil).
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(&entities.Ticket{ID: 2, UserID: 7}, nil).
 					Times(1)
 
-				natsPublisher.
+				respondsService.
 					EXPECT().
-					Publish("update.ticket", gomock.Any()).
+					DeleteRespond(gomock.Any(), uint64(1), outboxMessageWithSubject("delete.respond")).
 					Return(nil).
 					Times(1)
 			},
 			errorExpected: false,
 		},
 		{
-			name: "ticket not found",
-			ticketData: entities.RawUpdateTicketDTO{
-				ID: 1,
-			},
+			name:   "respond not found",
+			id:     1,
+			userID: 5,
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
-				ticketsService.
+				respondsService.
 					EXPECT().
-					GetTicketByID(gomock.Any(), uint64(1)).
+					GetRespondByID(gomock.Any(), uint64(1)).
 					Return(nil, errors.New("not found")).
 					Times(1)
 			},
 			errorExpected: true,
 		},
 		{
-			name: "category not found",
-			ticketData: entities.RawUpdateTicketDTO{
-				ID:          1,
-				CategoryID:  pointers.New[uint32](1),
-				TagIDs:      []uint32{1},
-				Name:        pointers.New("Updated Ticket"),
-				Description: pointers.New("Updated Desc"),
-			},
+			name:   "permission denied",
+			id:     1,
+			userID: 5,
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
-				ticketsService.
+				respondsService.
 					EXPECT().
-					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&entities.Ticket{ID: 1}, nil).
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, MasterID: 3}, nil).
 					Times(1)
 
 				toysService.
 					EXPECT().
-					GetAllCategories(gomock.Any()).
-					Return([]entities.Category{{ID: 2}}, nil).
+					GetMasterByUserID(gomock.Any(), uint64(5)).
+					Return(&entities.Master{ID: 4}, nil).
 					Times(1)
 			},
 			errorExpected: true,
 		},
 		{
-			name: "tag not found",
-			ticketData: entities.RawUpdateTicketDTO{
-				ID:     1,
-				TagIDs: []uint32{1},
-			},
+			name:   "delete error",
+			id:     1,
+			userID: 5,
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
-				ticketsService.
+				respondsService.
 					EXPECT().
-					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&entities.Ticket{ID: 1}, nil).
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, MasterID: 3}, nil).
 					Times(1)
 
 				toysService.
 					EXPECT().
-					GetAllTags(gomock.Any()).
-					Return([]entities.Tag{{ID: 2}}, nil).
+					GetMasterByUserID(gomock.Any(), uint64(5)).
+					Return(&entities.Master{ID: 3}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(&entities.Ticket{ID: 2, UserID: 7}, nil).
+					Times(1)
+
+				respondsService.
+					EXPECT().
+					DeleteRespond(gomock.Any(), uint64(1), gomock.Any()).
+					Return(errors.New("delete failed")).
 					Times(1)
 			},
 			errorExpected: true,
 		},
 		{
-			name: "update error",
-			ticketData: entities.RawUpdateTicketDTO{
-				ID: 1,
-			},
+			name:   "ticket not found",
+			id:     1,
+			userID: 5,
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
-				ticketsService.
+				respondsService.
 					EXPECT().
-					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&entities.Ticket{ID: 1}, nil).
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, MasterID: 3}, nil).
 					Times(1)
 
 				toysService.
 					EXPECT().
-					GetAllTags(gomock.Any()).
-					Return([]entities.Tag{}, nil).
+					GetMasterByUserID(gomock.Any(), uint64(5)).
+					Return(&entities.Master{ID: 3}, nil).
 					Times(1)
 
 				ticketsService.
 					EXPECT().
-					UpdateTicket(gomock.Any(), gomock.Any()).
-					Return(errors.New("update failed")).
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(nil, errors.New("not found")).
 					Times(1)
 			},
 			errorExpected: true,
 		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
+			}
+			err := useCases.DeleteRespond(context.Background(), tc.id, tc.userID)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_DeleteTicket(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ticketsService := mockservices.NewMockTicketsService(ctrl)
+	respondsService := mockservices.NewMockRespondsService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	natsConfig := config.NATSConfig{
+		Subjects: config.NATSSubjects{
+			TicketDeleted: "delete.ticket",
+		},
+	}
+
+	useCases := New(
+		ticketsService,
+		respondsService,
+		toysService,
+		eventbus.New(config.WatchConfig{}),
+		natsConfig,
+		config.WatchConfig{},
+		logger,
+	)
+
+	testCases := []struct {
+		name       string
+		id         uint64
+		userID     uint64
+		setupMocks func(
+			ticketsService *mockservices.MockTicketsService,
+			respondsService *mockservices.MockRespondsService,
+			toysService *mockservices.MockToysService,
+			logger *mocklogging.MockLogger,
+		)
+		errorExpected bool
+	}{
 		{
-			name: "nats publish error",
-			ticketData: entities.RawUpdateTicketDTO{
-				ID: 1,
-			},
+			name:   "success",
+			id:     1,
+			userID: 1,
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
-				ticket := entities.Ticket{ID: 1}
+				ticket := entities.Ticket{
+					ID:          1,
+					UserID:      1,
+					Name:        "Test",
+					Description: "Desc",
+					Quantity:    1,
+					Version:     5,
+				}
 				ticketsService.
 					EXPECT().
 					GetTicketByID(gomock.Any(), uint64(1)).
 					Return(&ticket, nil).
 					Times(1)
 
-				toysService.
+				respondsService.
 					EXPECT().
-					GetAllTags(gomock.Any()).
-					Return([]entities.Tag{}, nil).
+					GetTicketResponds(gomock.Any(), uint64(1), nil, nil).
+					Return([]entities.Respond{{MasterID: 2}}, nil).
 					Times(1)
 
+				// Deduplication ID is derived from Ticket ID and version:
 				ticketsService.
 					EXPECT().
-					UpdateTicket(gomock.Any(), gomock.Any()).
+					DeleteTicket(
+						gomock.Any(),
+						uint64(1),
+						gomock.All(
+							outboxMessageWithSubject("delete.ticket"),
+							gomock.Cond(func(message entities.CreateOutboxMessageDTO) bool {
+								return message.DeduplicationID != nil &&
+									*message.DeduplicationID == "delete.ticket:1:5"
+							}),
+						),
+					).
 					Return(nil).
 					Times(1)
-
-				natsPublisher.
-					EXPECT().
-					Publish("update.ticket", gomock.Any()).
-					Return(errors.New("publish failed")).
-					Times(1)
-
-				logger.
-					EXPECT().
-					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
-					Times(1)
 			},
-			errorExpected: false, // NATS error не возвращается
+			errorExpected: false,
 		},
-		// Новый случай: добавление нового вложения
 		{
-			name: "add new attachment",
-			ticketData: entities.RawUpdateTicketDTO{
-				ID:          1,
-				Attachments: []string{"new_attachment.jpg"},
-			},
+			name:   "ticket not found",
+			id:     1,
+			userID: 1,
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
-				ticket := entities.Ticket{
-					ID:          1,
-					Attachments: []entities.Attachment{{ID: 1, Link: "old_attachment.jpg"}},
-				}
 				ticketsService.
 					EXPECT().
 					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&ticket, nil).
-					Times(1)
-
-				toysService.
-					EXPECT().
-					GetAllTags(gomock.Any()).
-					Return([]entities.Tag{}, nil).
-					Times(1)
-
-				ticketsService.
-					EXPECT().
-					UpdateTicket(gomock.Any(), gomock.Any()).
-					Do(func(ctx context.Context, updateData entities.UpdateTicketDTO) {
-						require.Equal(t, []string{"new_attachment.jpg"}, updateData.AttachmentsToAdd)
-						require.Equal(t, []uint64{1}, updateData.AttachmentIDsToDelete)
-					}).
-					Return(nil).
-					Times(1)
-
-				natsPublisher.
-					EXPECT().
-					Publish("update.ticket", gomock.Any()).
-					Return(nil).
+					Return(nil, errors.New("not found")).
 					Times(1)
 			},
-			errorExpected: false,
+			errorExpected: true,
 		},
-
-		// Новый случай: удаление старого вложения
 		{
-			name: "delete old attachment",
-			ticketData: entities.RawUpdateTicketDTO{
-				ID:          1,
-				Attachments: []string{},
-			},
+			name:   "permission denied",
+			id:     1,
+			userID: 2,
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
-				ticket := entities.Ticket{
-					ID:          1,
-					Attachments: []entities.Attachment{{ID: 1, Link: "old_attachment.jpg"}},
-				}
 				ticketsService.
 					EXPECT().
 					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&ticket, nil).
-					Times(1)
-
-				toysService.
-					EXPECT().
-					GetAllTags(gomock.Any()).
-					Return([]entities.Tag{}, nil).
-					Times(1)
-
-				ticketsService.
-					EXPECT().
-					UpdateTicket(gomock.Any(), gomock.Any()).
-					Do(func(ctx context.Context, updateData entities.UpdateTicketDTO) {
-						require.Equal(t, []string{}, updateData.AttachmentsToAdd)
-						require.Equal(t, []uint64{1}, updateData.AttachmentIDsToDelete)
-					}).
-					Return(nil).
-					Times(1)
-
-				natsPublisher.
-					EXPECT().
-					Publish("update.ticket", gomock.Any()).
-					Return(nil).
+					Return(&entities.Ticket{ID: 1, UserID: 1}, nil).
 					Times(1)
 			},
-			errorExpected: false,
+			errorExpected: true,
 		},
-
-		// Новый случай: сохранение существующего вложения без изменений
 		{
-			name: "keep existing attachment",
-			ticketData: entities.RawUpdateTicketDTO{
-				ID:          1,
-				Attachments: []string{"old_attachment.jpg"},
-			},
+			name:   "responds fetch error",
+			id:     1,
+			userID: 1,
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
-				ticket := entities.Ticket{
-					ID:          1,
-					Attachments: []entities.Attachment{{ID: 1, Link: "old_attachment.jpg"}},
-				}
 				ticketsService.
 					EXPECT().
 					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&ticket, nil).
-					Times(1)
-
-				toysService.
-					EXPECT().
-					GetAllTags(gomock.Any()).
-					Return([]entities.Tag{}, nil).
-					Times(1)
-
-				ticketsService.
-					EXPECT().
-					UpdateTicket(gomock.Any(), gomock.Any()).
-					Do(func(ctx context.Context, updateData entities.UpdateTicketDTO) {
-						require.Equal(t, []string{}, updateData.AttachmentsToAdd)
-						require.Equal(t, []uint64{}, updateData.AttachmentIDsToDelete)
-					}).
-					Return(nil).
+					Return(&entities.Ticket{ID: 1, UserID: 1}, nil).
 					Times(1)
 
-				natsPublisher.
+				respondsService.
 					EXPECT().
-					Publish("update.ticket", gomock.Any()).
-					Return(nil).
+					GetTicketResponds(gomock.Any(), uint64(1), nil, nil).
+					Return(nil, errors.New("fetch failed")).
 					Times(1)
 			},
-			errorExpected: false,
+			errorExpected: true,
 		},
-
-		// Новый случай: добавление и удаление вложений одновременно
 		{
-			name: "add and delete attachments",
-			ticketData: entities.RawUpdateTicketDTO{
-				ID:          1,
-				Attachments: []string{"old_attachment.jpg", "new_attachment.jpg"},
-			},
+			name:   "delete error",
+			id:     1,
+			userID: 1,
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
 				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
 				logger *mocklogging.MockLogger,
 			) {
-				ticket := entities.Ticket{
-					ID:          1,
-					Attachments: []entities.Attachment{{ID: 1, Link: "old_attachment.jpg"}, {ID: 2, Link: "to_delete.jpg"}},
-				}
 				ticketsService.
 					EXPECT().
 					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&ticket, nil).
+					Return(&entities.Ticket{ID: 1, UserID: 1}, nil).
 					Times(1)
 
-				toysService.
+				respondsService.
 					EXPECT().
-					GetAllTags(gomock.Any()).
-					Return([]entities.Tag{}, nil).
+					GetTicketResponds(gomock.Any(), uint64(1), nil, nil).
+					Return([]entities.Respond{}, nil).
 					Times(1)
 
 				ticketsService.
 					EXPECT().
-					UpdateTicket(gomock.Any(), gomock.Any()).
-					Do(func(ctx context.Context, updateData entities.UpdateTicketDTO) {
-						require.Equal(t, []string{"new_attachment.jpg"}, updateData.AttachmentsToAdd)
-						require.Equal(t, []uint64{2}, updateData.AttachmentIDsToDelete)
-					}).
-					Return(nil).
-					Times(1)
-
-				natsPublisher.
-					EXPECT().
-					Publish("update.ticket", gomock.Any()).
-					Return(nil).
+					DeleteTicket(
+						gomock.Any(),
+						uint64(1),
+						outboxMessageWithSubject("delete.ticket"),
+					).
+					Return(errors.New("delete failed")).
 					Times(1)
 			},
-			errorExpected: false,
+			errorExpected: true,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
-				tc.setupMocks(ticketsService, respondsService, toysService, natsPublisher, logger)
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
 			}
-
-			err := useCases.UpdateTicket(context.Background(), tc.ticketData)
+			err := useCases.DeleteTicket(context.Background(), tc.id, tc.userID)
 			if tc.errorExpected {
 				require.Error(t, err)
 			} else {
@@ -2058,64 +2610,11 @@ func TestUseCases_UpdateTicket(t *testing.T) {
 	}
 }
 
-func TestUseCases_CountTickets(t *testing.T) {
-	testCases := []struct {
-		name       string
-		filters    *entities.TicketsFilters
-		setupMocks func(
-			ticketsService *mockservices.MockTicketsService,
-			respondsService *mockservices.MockRespondsService,
-			toysService *mockservices.MockToysService,
-			natsPublisher *mocknats.MockPublisher,
-			logger *mocklogging.MockLogger,
-		)
-		expected      uint64
-		errorExpected bool
-	}{
-		{
-			name: "success",
-			filters: &entities.TicketsFilters{
-				Search:              pointers.New("toy2"),
-				PriceCeil:           pointers.New[float32](1000),
-				PriceFloor:          pointers.New[float32](10),
-				QuantityFloor:       pointers.New[uint32](1),
-				CategoryIDs:         []uint32{1},
-				TagIDs:              []uint32{1},
-				CreatedAtOrderByAsc: pointers.New(true),
-			},
-			setupMocks: func(
-				ticketsService *mockservices.MockTicketsService,
-				respondsService *mockservices.MockRespondsService,
-				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
-				logger *mocklogging.MockLogger,
-			) {
-				ticketsService.
-					EXPECT().
-					CountTickets(
-						gomock.Any(),
-						&entities.TicketsFilters{
-							Search:              pointers.New("toy2"),
-							PriceCeil:           pointers.New[float32](1000),
-							PriceFloor:          pointers.New[float32](10),
-							QuantityFloor:       pointers.New[uint32](1),
-							CategoryIDs:         []uint32{1},
-							TagIDs:              []uint32{1},
-							CreatedAtOrderByAsc: pointers.New(true),
-						},
-					).
-					Return(uint64(1), nil).
-					Times(1)
-			},
-			expected: 1,
-		},
-	}
-
+func TestUseCases_UpdateTicket(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
 	respondsService := mockservices.NewMockRespondsService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
-	natsPublisher := mocknats.NewMockPublisher(ctrl)
 	logger := mocklogging.NewMockLogger(ctrl)
 	natsConfig := config.NATSConfig{
 		Subjects: config.NATSSubjects{
@@ -2127,119 +2626,2230 @@ func TestUseCases_CountTickets(t *testing.T) {
 		ticketsService,
 		respondsService,
 		toysService,
-		natsPublisher,
+		eventbus.New(config.WatchConfig{}),
 		natsConfig,
+		config.WatchConfig{},
 		logger,
 	)
 
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			if tc.setupMocks != nil {
-				tc.setupMocks(ticketsService, respondsService, toysService, natsPublisher, logger)
-			}
-
-			actual, err := useCases.CountTickets(context.Background(), tc.filters)
-			if tc.errorExpected {
-				require.Error(t, err)
-			} else {
-				require.NoError(t, err)
-			}
-
-			require.Equal(t, tc.expected, actual)
-		})
-	}
-}
-func TestUseCases_CountUserTickets(t *testing.T) {
 	testCases := []struct {
 		name       string
-		userID     uint64
-		filters    *entities.TicketsFilters
+		ticketData entities.RawUpdateTicketDTO
+		setupMocks func(
+			ticketsService *mockservices.MockTicketsService,
+			respondsService *mockservices.MockRespondsService,
+			toysService *mockservices.MockToysService,
+			logger *mocklogging.MockLogger,
+		)
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			ticketData: entities.RawUpdateTicketDTO{
+				ID:          1,
+				CategoryID:  pointers.New[uint32](1),
+				TagIDs:      []uint32{1},
+				Name:        pointers.New("Updated Ticket"),
+				Description: pointers.New("Updated Desc"),
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticket := entities.Ticket{ID: 1, TagIDs: []uint32{2}}
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&ticket, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetAllCategories(gomock.Any()).
+					Return([]entities.Category{{ID: 1}}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetAllTags(gomock.Any()).
+					Return([]entities.Tag{{ID: 1}}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					UpdateTicket(
+						gomock.Any(),
+						gomock.Any(),
+						outboxMessageWithSubject("update.ticket"),
+					).
+					Return(nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name: "ticket not found",
+			ticketData: entities.RawUpdateTicketDTO{
+				ID: 1,
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(nil, errors.New("not found")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "permission denied",
+			ticketData: entities.RawUpdateTicketDTO{
+				ID:     1,
+				UserID: 2,
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&entities.Ticket{ID: 1, UserID: 1}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "category not found",
+			ticketData: entities.RawUpdateTicketDTO{
+				ID:          1,
+				CategoryID:  pointers.New[uint32](1),
+				TagIDs:      []uint32{1},
+				Name:        pointers.New("Updated Ticket"),
+				Description: pointers.New("Updated Desc"),
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&entities.Ticket{ID: 1}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetAllCategories(gomock.Any()).
+					Return([]entities.Category{{ID: 2}}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "tag not found",
+			ticketData: entities.RawUpdateTicketDTO{
+				ID:     1,
+				TagIDs: []uint32{1},
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&entities.Ticket{ID: 1}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetAllTags(gomock.Any()).
+					Return([]entities.Tag{{ID: 2}}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "update error",
+			ticketData: entities.RawUpdateTicketDTO{
+				ID: 1,
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&entities.Ticket{ID: 1}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetAllTags(gomock.Any()).
+					Return([]entities.Tag{}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					UpdateTicket(
+						gomock.Any(),
+						gomock.Any(),
+						outboxMessageWithSubject("update.ticket"),
+					).
+					Return(errors.New("update failed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "new Tags and Attachments are passed with current version",
+			ticketData: entities.RawUpdateTicketDTO{
+				ID:          1,
+				Attachments: []string{"old_attachment.jpg", "new_attachment.jpg"},
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticket := entities.Ticket{
+					ID:          1,
+					Version:     3,
+					Attachments: []entities.Attachment{{ID: 1, Link: "old_attachment.jpg"}, {ID: 2, Link: "to_delete.jpg"}},
+				}
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&ticket, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetAllTags(gomock.Any()).
+					Return([]entities.Tag{}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					UpdateTicket(
+						gomock.Any(),
+						gomock.Any(),
+						outboxMessageWithSubject("update.ticket"),
+					).
+					Do(func(
+						ctx context.Context,
+						updateData entities.UpdateTicketDTO,
+						outboxMessages ...entities.CreateOutboxMessageDTO,
+					) {
+						require.Equal(t, []string{"old_attachment.jpg", "new_attachment.jpg"}, updateData.Attachments)
+						require.Equal(t, uint64(3), updateData.ExpectedVersion)
+						require.Equal(t, defaultTicketUpdateMask, updateData.UpdateMask)
+						require.Equal(t, "update.ticket:1:4", *outboxMessages[0].DeduplicationID)
+					}).
+					Return(nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name: "Tags are not validated, if they are not listed in update mask",
+			ticketData: entities.RawUpdateTicketDTO{
+				ID:         1,
+				UpdateMask: []entities.TicketUpdateField{entities.TicketUpdateFieldPrice},
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&entities.Ticket{ID: 1, TagIDs: []uint32{1}}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					UpdateTicket(
+						gomock.Any(),
+						gomock.Any(),
+						outboxMessageWithSubject("update.ticket"),
+					).
+					Do(func(ctx context.Context, updateData entities.UpdateTicketDTO, _ ...entities.CreateOutboxMessageDTO) {
+						require.Equal(
+							t,
+							[]entities.TicketUpdateField{entities.TicketUpdateFieldPrice},
+							updateData.UpdateMask,
+						)
+					}).
+					Return(nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name: "provided version matches current one",
+			ticketData: entities.RawUpdateTicketDTO{
+				ID:              1,
+				ExpectedVersion: pointers.New[uint64](3),
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&entities.Ticket{ID: 1, Version: 3}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetAllTags(gomock.Any()).
+					Return([]entities.Tag{}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					UpdateTicket(
+						gomock.Any(),
+						gomock.Any(),
+						outboxMessageWithSubject("update.ticket"),
+					).
+					Do(func(ctx context.Context, updateData entities.UpdateTicketDTO, _ ...entities.CreateOutboxMessageDTO) {
+						require.Equal(t, uint64(3), updateData.ExpectedVersion)
+					}).
+					Return(nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name: "provided version is stale",
+			ticketData: entities.RawUpdateTicketDTO{
+				ID:              1,
+				ExpectedVersion: pointers.New[uint64](2),
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&entities.Ticket{ID: 1, Version: 3}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetAllTags(gomock.Any()).
+					Return([]entities.Tag{}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
+			}
+
+			err := useCases.UpdateTicket(context.Background(), tc.ticketData)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_CountTickets(t *testing.T) {
+	testCases := []struct {
+		name       string
+		filters    *entities.TicketsFilters
+		setupMocks func(
+			ticketsService *mockservices.MockTicketsService,
+			respondsService *mockservices.MockRespondsService,
+			toysService *mockservices.MockToysService,
+			logger *mocklogging.MockLogger,
+		)
+		expected      uint64
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			filters: &entities.TicketsFilters{
+				Search:              pointers.New("toy2"),
+				PriceCeil:           pointers.New[float32](1000),
+				PriceFloor:          pointers.New[float32](10),
+				QuantityFloor:       pointers.New[uint32](1),
+				CategoryIDs:         []uint32{1},
+				TagIDs:              []uint32{1},
+				CreatedAtOrderByAsc: pointers.New(true),
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					CountTickets(
+						gomock.Any(),
+						&entities.TicketsFilters{
+							Search:              pointers.New("toy2"),
+							PriceCeil:           pointers.New[float32](1000),
+							PriceFloor:          pointers.New[float32](10),
+							QuantityFloor:       pointers.New[uint32](1),
+							CategoryIDs:         []uint32{1},
+							TagIDs:              []uint32{1},
+							CreatedAtOrderByAsc: pointers.New(true),
+						},
+					).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+			expected: 1,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	ticketsService := mockservices.NewMockTicketsService(ctrl)
+	respondsService := mockservices.NewMockRespondsService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	natsConfig := config.NATSConfig{
+		Subjects: config.NATSSubjects{
+			TicketUpdated: "update.ticket",
+		},
+	}
+
+	useCases := New(
+		ticketsService,
+		respondsService,
+		toysService,
+		eventbus.New(config.WatchConfig{}),
+		natsConfig,
+		config.WatchConfig{},
+		logger,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
+			}
+
+			actual, err := useCases.CountTickets(context.Background(), tc.filters)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+func TestUseCases_CountUserTickets(t *testing.T) {
+	testCases := []struct {
+		name       string
+		userID     uint64
+		filters    *entities.TicketsFilters
 		setupMocks func(
 			ticketsService *mockservices.MockTicketsService,
 			respondsService *mockservices.MockRespondsService,
 			toysService *mockservices.MockToysService,
-			natsPublisher *mocknats.MockPublisher,
 			logger *mocklogging.MockLogger,
 		)
 		expected      uint64
 		errorExpected bool
 	}{
 		{
-			name:   "success",
-			userID: 1,
-			filters: &entities.TicketsFilters{
-				Search:              pointers.New("toy2"),
-				PriceCeil:           pointers.New[float32](1000),
-				PriceFloor:          pointers.New[float32](10),
-				QuantityFloor:       pointers.New[uint32](1),
-				CategoryIDs:         []uint32{1},
-				TagIDs:              []uint32{1},
-				CreatedAtOrderByAsc: pointers.New(true),
+			name:   "success",
+			userID: 1,
+			filters: &entities.TicketsFilters{
+				Search:              pointers.New("toy2"),
+				PriceCeil:           pointers.New[float32](1000),
+				PriceFloor:          pointers.New[float32](10),
+				QuantityFloor:       pointers.New[uint32](1),
+				CategoryIDs:         []uint32{1},
+				TagIDs:              []uint32{1},
+				CreatedAtOrderByAsc: pointers.New(true),
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					CountUserTickets(
+						gomock.Any(),
+						uint64(1),
+						&entities.TicketsFilters{
+							Search:              pointers.New("toy2"),
+							PriceCeil:           pointers.New[float32](1000),
+							PriceFloor:          pointers.New[float32](10),
+							QuantityFloor:       pointers.New[uint32](1),
+							CategoryIDs:         []uint32{1},
+							TagIDs:              []uint32{1},
+							CreatedAtOrderByAsc: pointers.New(true),
+						},
+					).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+			expected: 1,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	ticketsService := mockservices.NewMockTicketsService(ctrl)
+	respondsService := mockservices.NewMockRespondsService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	natsConfig := config.NATSConfig{
+		Subjects: config.NATSSubjects{
+			TicketUpdated: "update.ticket",
+		},
+	}
+
+	useCases := New(
+		ticketsService,
+		respondsService,
+		toysService,
+		eventbus.New(config.WatchConfig{}),
+		natsConfig,
+		config.WatchConfig{},
+		logger,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
+			}
+
+			actual, err := useCases.CountUserTickets(context.Background(), tc.userID, tc.filters)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestUseCases_ChangeTicketStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ticketsService := mockservices.NewMockTicketsService(ctrl)
+	respondsService := mockservices.NewMockRespondsService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	natsConfig := config.NATSConfig{}
+
+	useCases := New(
+		ticketsService,
+		respondsService,
+		toysService,
+		eventbus.New(config.WatchConfig{}),
+		natsConfig,
+		config.WatchConfig{},
+		logger,
+	)
+
+	testCases := []struct {
+		name       string
+		ticketID   uint64
+		userID     uint64
+		status     entities.TicketStatus
+		setupMocks func(
+			ticketsService *mockservices.MockTicketsService,
+			respondsService *mockservices.MockRespondsService,
+			toysService *mockservices.MockToysService,
+			logger *mocklogging.MockLogger,
+		)
+		errorExpected bool
+	}{
+		{
+			name:     "success",
+			ticketID: 1,
+			status:   entities.TicketStatusInProgress,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&entities.Ticket{ID: 1, Status: entities.TicketStatusOpen}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					UpdateTicketStatus(gomock.Any(), uint64(1), entities.TicketStatusOpen, entities.TicketStatusInProgress).
+					Return(nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name:     "ticket not found",
+			ticketID: 1,
+			status:   entities.TicketStatusInProgress,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(nil, errors.New("not found")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:     "transition from final status",
+			ticketID: 1,
+			status:   entities.TicketStatusOpen,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&entities.Ticket{ID: 1, Status: entities.TicketStatusCompleted}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:     "open ticket can not be completed",
+			ticketID: 1,
+			status:   entities.TicketStatusCompleted,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&entities.Ticket{ID: 1, Status: entities.TicketStatusOpen}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:     "update error",
+			ticketID: 1,
+			status:   entities.TicketStatusCompleted,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&entities.Ticket{ID: 1, Status: entities.TicketStatusInProgress}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					UpdateTicketStatus(gomock.Any(), uint64(1), entities.TicketStatusInProgress, entities.TicketStatusCompleted).
+					Return(errors.New("update failed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:     "permission denied",
+			ticketID: 1,
+			userID:   2,
+			status:   entities.TicketStatusInProgress,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&entities.Ticket{ID: 1, UserID: 1, Status: entities.TicketStatusOpen}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
+			}
+
+			err := useCases.ChangeTicketStatus(context.Background(), tc.ticketID, tc.userID, tc.status)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_AcceptRespond(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ticketsService := mockservices.NewMockTicketsService(ctrl)
+	respondsService := mockservices.NewMockRespondsService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	natsConfig := config.NATSConfig{
+		Subjects: config.NATSSubjects{
+			RespondAccepted: "accept.respond",
+		},
+	}
+
+	useCases := New(
+		ticketsService,
+		respondsService,
+		toysService,
+		eventbus.New(config.WatchConfig{}),
+		natsConfig,
+		config.WatchConfig{},
+		logger,
+	)
+
+	testCases := []struct {
+		name       string
+		id         uint64
+		userID     uint64
+		setupMocks func(
+			ticketsService *mockservices.MockTicketsService,
+			respondsService *mockservices.MockRespondsService,
+			toysService *mockservices.MockToysService,
+			logger *mocklogging.MockLogger,
+		)
+		errorExpected bool
+	}{
+		{
+			name:   "success",
+			id:     1,
+			userID: 4,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(
+						&entities.Respond{ID: 1, TicketID: 2, MasterID: 3, Status: entities.RespondStatusPending},
+						nil,
+					).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(&entities.Ticket{ID: 2, UserID: 4, Status: entities.TicketStatusOpen}, nil).
+					Times(1)
+
+				respondsService.
+					EXPECT().
+					GetTicketResponds(gomock.Any(), uint64(2), nil, nil).
+					Return(
+						[]entities.Respond{
+							{ID: 1, MasterID: 3, Status: entities.RespondStatusPending},
+							{ID: 5, MasterID: 6, Status: entities.RespondStatusPending},
+							{ID: 7, MasterID: 8, Status: entities.RespondStatusRejected},
+						},
+						nil,
+					).
+					Times(1)
+
+				respondsService.
+					EXPECT().
+					AcceptRespond(
+						gomock.Any(),
+						uint64(1),
+						outboxMessageWithSubject("accept.respond"),
+					).
+					DoAndReturn(func(_ context.Context, _ uint64, messages ...entities.CreateOutboxMessageDTO) error {
+						var respondAcceptedDTO dto.RespondAcceptedDTO
+						data := eventData(t, messages[0].Payload, dto.RespondAcceptedEventType)
+						require.NoError(t, json.Unmarshal([]byte(data), &respondAcceptedDTO))
+						require.Equal(
+							t,
+							dto.RespondAcceptedDTO{
+								TicketID:           2,
+								TicketOwnerID:      4,
+								RespondID:          1,
+								MasterID:           3,
+								RejectedMastersIDs: []uint64{6},
+							},
+							respondAcceptedDTO,
+						)
+
+						return nil
+					}).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name:   "respond not found",
+			id:     1,
+			userID: 4,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(nil, &customerrors.RespondNotFoundError{}).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:   "respond is not pending",
+			id:     1,
+			userID: 4,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, Status: entities.RespondStatusRejected}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(&entities.Ticket{ID: 2, UserID: 4, Status: entities.TicketStatusInProgress}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:   "ticket is not open",
+			id:     1,
+			userID: 4,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, Status: entities.RespondStatusPending}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(&entities.Ticket{ID: 2, UserID: 4, Status: entities.TicketStatusInProgress}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:   "accept error",
+			id:     1,
+			userID: 4,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, Status: entities.RespondStatusPending}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(&entities.Ticket{ID: 2, UserID: 4, Status: entities.TicketStatusOpen}, nil).
+					Times(1)
+
+				respondsService.
+					EXPECT().
+					GetTicketResponds(gomock.Any(), uint64(2), nil, nil).
+					Return([]entities.Respond{}, nil).
+					Times(1)
+
+				respondsService.
+					EXPECT().
+					AcceptRespond(
+						gomock.Any(),
+						uint64(1),
+						outboxMessageWithSubject("accept.respond"),
+					).
+					Return(errors.New("accept failed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:   "permission denied",
+			id:     1,
+			userID: 5,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, Status: entities.RespondStatusPending}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(&entities.Ticket{ID: 2, UserID: 4, Status: entities.TicketStatusOpen}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
+			}
+
+			err := useCases.AcceptRespond(context.Background(), tc.id, tc.userID)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_RejectRespond(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ticketsService := mockservices.NewMockTicketsService(ctrl)
+	respondsService := mockservices.NewMockRespondsService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	natsConfig := config.NATSConfig{
+		Subjects: config.NATSSubjects{
+			RespondRejected: "reject.respond",
+		},
+	}
+
+	useCases := New(
+		ticketsService,
+		respondsService,
+		toysService,
+		eventbus.New(config.WatchConfig{}),
+		natsConfig,
+		config.WatchConfig{},
+		logger,
+	)
+
+	testCases := []struct {
+		name       string
+		id         uint64
+		userID     uint64
+		setupMocks func(
+			ticketsService *mockservices.MockTicketsService,
+			respondsService *mockservices.MockRespondsService,
+			toysService *mockservices.MockToysService,
+			logger *mocklogging.MockLogger,
+		)
+		errorExpected bool
+	}{
+		{
+			name:   "success",
+			id:     1,
+			userID: 4,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(
+						&entities.Respond{ID: 1, TicketID: 2, MasterID: 3, Status: entities.RespondStatusPending},
+						nil,
+					).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(&entities.Ticket{ID: 2, UserID: 4}, nil).
+					Times(1)
+
+				respondsService.
+					EXPECT().
+					UpdateRespondStatus(
+						gomock.Any(),
+						uint64(1),
+						entities.RespondStatusRejected,
+						outboxMessageWithSubject("reject.respond"),
+					).
+					Return(nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name:   "respond not found",
+			id:     1,
+			userID: 4,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(nil, &customerrors.RespondNotFoundError{}).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:   "respond is not pending",
+			id:     1,
+			userID: 4,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, Status: entities.RespondStatusAccepted}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(&entities.Ticket{ID: 2, UserID: 4, Status: entities.TicketStatusInProgress}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:   "ticket not found",
+			id:     1,
+			userID: 4,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, Status: entities.RespondStatusPending}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(nil, &customerrors.TicketNotFoundError{}).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:   "update status error",
+			id:     1,
+			userID: 4,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, Status: entities.RespondStatusPending}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(&entities.Ticket{ID: 2, UserID: 4}, nil).
+					Times(1)
+
+				respondsService.
+					EXPECT().
+					UpdateRespondStatus(
+						gomock.Any(),
+						uint64(1),
+						entities.RespondStatusRejected,
+						outboxMessageWithSubject("reject.respond"),
+					).
+					Return(errors.New("update failed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:   "permission denied",
+			id:     1,
+			userID: 5,
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+				toysService *mockservices.MockToysService,
+				logger *mocklogging.MockLogger,
+			) {
+				respondsService.
+					EXPECT().
+					GetRespondByID(gomock.Any(), uint64(1)).
+					Return(&entities.Respond{ID: 1, TicketID: 2, Status: entities.RespondStatusPending}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(2)).
+					Return(&entities.Ticket{ID: 2, UserID: 4, Status: entities.TicketStatusOpen}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsService, respondsService, toysService, logger)
+			}
+
+			err := useCases.RejectRespond(context.Background(), tc.id, tc.userID)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_UpdateTicketsCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ticketsService := mockservices.NewMockTicketsService(ctrl)
+	respondsService := mockservices.NewMockRespondsService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	useCases := New(
+		ticketsService,
+		respondsService,
+		toysService,
+		eventbus.New(config.WatchConfig{}),
+		config.NATSConfig{Subjects: config.NATSSubjects{TicketUpdated: "update.ticket"}},
+		config.WatchConfig{},
+		logger,
+	)
+
+	testCases := []struct {
+		name          string
+		setupMocks    func(ticketsService *mockservices.MockTicketsService, respondsService *mockservices.MockRespondsService)
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+			) {
+				ticketsService.
+					EXPECT().
+					UpdateTicketsCategory(gomock.Any(), uint32(1), uint32(2), gomock.Any()).
+					DoAndReturn(
+						func(
+							_ context.Context,
+							_, _ uint32,
+							builders ...entities.OutboxMessageBuilder,
+						) ([]uint64, error) {
+							require.Len(t, builders, 1)
+
+							message, err := builders[0](5)
+							require.NoError(t, err)
+							require.Equal(t, "update.ticket", message.Subject)
+							require.Equal(t, pointers.New("update.ticket:5:category-deleted:1"), message.DeduplicationID)
+							require.JSONEq(
+								t,
+								`{"ticketId":5}`,
+								eventData(t, message.Payload, dto.TicketUpdatedEventType),
+							)
+
+							return []uint64{5}, nil
+						},
+					).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name: "service error",
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+			) {
+				ticketsService.
+					EXPECT().
+					UpdateTicketsCategory(gomock.Any(), uint32(1), uint32(2), gomock.Any()).
+					Return(nil, errors.New("update failed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsService, respondsService)
+			}
+
+			err := useCases.UpdateTicketsCategory(context.Background(), uint32(1), uint32(2))
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_DeleteTagFromTickets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ticketsService := mockservices.NewMockTicketsService(ctrl)
+	respondsService := mockservices.NewMockRespondsService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	useCases := New(
+		ticketsService,
+		respondsService,
+		toysService,
+		eventbus.New(config.WatchConfig{}),
+		config.NATSConfig{Subjects: config.NATSSubjects{TicketUpdated: "update.ticket"}},
+		config.WatchConfig{},
+		logger,
+	)
+
+	testCases := []struct {
+		name          string
+		setupMocks    func(ticketsService *mockservices.MockTicketsService, respondsService *mockservices.MockRespondsService)
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+			) {
+				ticketsService.
+					EXPECT().
+					DeleteTagFromTickets(gomock.Any(), uint32(1), gomock.Any()).
+					DoAndReturn(
+						func(
+							_ context.Context,
+							_ uint32,
+							builders ...entities.OutboxMessageBuilder,
+						) ([]uint64, error) {
+							require.Len(t, builders, 1)
+
+							message, err := builders[0](5)
+							require.NoError(t, err)
+							require.Equal(t, "update.ticket", message.Subject)
+							require.Equal(t, pointers.New("update.ticket:5:tag-deleted:1"), message.DeduplicationID)
+							require.JSONEq(
+								t,
+								`{"ticketId":5}`,
+								eventData(t, message.Payload, dto.TicketUpdatedEventType),
+							)
+
+							return []uint64{5}, nil
+						},
+					).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name: "service error",
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+			) {
+				ticketsService.
+					EXPECT().
+					DeleteTagFromTickets(gomock.Any(), uint32(1), gomock.Any()).
+					Return(nil, errors.New("delete failed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsService, respondsService)
+			}
+
+			err := useCases.DeleteTagFromTickets(context.Background(), uint32(1))
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_WithdrawMasterResponds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ticketsService := mockservices.NewMockTicketsService(ctrl)
+	respondsService := mockservices.NewMockRespondsService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	useCases := New(
+		ticketsService,
+		respondsService,
+		toysService,
+		eventbus.New(config.WatchConfig{}),
+		config.NATSConfig{},
+		config.WatchConfig{},
+		logger,
+	)
+
+	testCases := []struct {
+		name          string
+		setupMocks    func(ticketsService *mockservices.MockTicketsService, respondsService *mockservices.MockRespondsService)
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+			) {
+				respondsService.
+					EXPECT().
+					WithdrawMasterResponds(gomock.Any(), uint64(1)).
+					Return(nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name: "service error",
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				respondsService *mockservices.MockRespondsService,
+			) {
+				respondsService.
+					EXPECT().
+					WithdrawMasterResponds(gomock.Any(), uint64(1)).
+					Return(errors.New("update failed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ticketsService, respondsService)
+			}
+
+			err := useCases.WithdrawMasterResponds(context.Background(), uint64(1))
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_DeleteUserData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ticketsService := mockservices.NewMockTicketsService(ctrl)
+	respondsService := mockservices.NewMockRespondsService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	natsConfig := config.NATSConfig{
+		Subjects: config.NATSSubjects{
+			UserDataDeleted: "user-data-deleted",
+		},
+	}
+
+	useCases := New(
+		ticketsService,
+		respondsService,
+		toysService,
+		eventbus.New(config.WatchConfig{}),
+		natsConfig,
+		config.WatchConfig{},
+		logger,
+	)
+
+	deletedData := entities.DeletedUserDataDTO{
+		TicketsCount:          1,
+		RespondsCount:         2,
+		AttachmentsCount:      3,
+		TagsAssociationsCount: 4,
+		IdempotencyKeysCount:  5,
+	}
+
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			ticketsService *mockservices.MockTicketsService,
+			toysService *mockservices.MockToysService,
+		)
+		errorExpected bool
+	}{
+		{
+			name: "success for master",
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				toysService *mockservices.MockToysService,
+			) {
+				toysService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), uint64(1)).
+					Return(&entities.Master{ID: 2, UserID: 1}, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					DeleteUserData(
+						gomock.Any(),
+						entities.DeleteUserDataDTO{UserID: 1, MasterID: pointers.New[uint64](2)},
+						gomock.Any(),
+					).
+					DoAndReturn(
+						func(
+							_ context.Context,
+							_ entities.DeleteUserDataDTO,
+							builders ...entities.DeletedUserDataOutboxMessageBuilder,
+						) error {
+							require.Len(t, builders, 1)
+
+							message, err := builders[0](deletedData)
+							require.NoError(t, err)
+							require.Equal(t, "user-data-deleted", message.Subject)
+							require.JSONEq(
+								t,
+								`{"userId":1,"masterId":2,"ticketsCount":1,"respondsCount":2,`+
+									`"attachmentsCount":3,"tagsAssociationsCount":4,"idempotencyKeysCount":5}`,
+								eventData(t, message.Payload, dto.UserDataDeletedEventType),
+							)
+
+							return nil
+						},
+					).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name: "success for not master",
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				toysService *mockservices.MockToysService,
+			) {
+				toysService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), uint64(1)).
+					Return(nil, &customerrors.MasterNotFoundError{}).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					DeleteUserData(
+						gomock.Any(),
+						entities.DeleteUserDataDTO{UserID: 1},
+						gomock.Any(),
+					).
+					DoAndReturn(
+						func(
+							_ context.Context,
+							_ entities.DeleteUserDataDTO,
+							builders ...entities.DeletedUserDataOutboxMessageBuilder,
+						) error {
+							require.Len(t, builders, 1)
+
+							message, err := builders[0](deletedData)
+							require.NoError(t, err)
+							require.JSONEq(
+								t,
+								`{"userId":1,"ticketsCount":1,"respondsCount":2,`+
+									`"attachmentsCount":3,"tagsAssociationsCount":4,"idempotencyKeysCount":5}`,
+								eventData(t, message.Payload, dto.UserDataDeletedEventType),
+							)
+
+							return nil
+						},
+					).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name: "toys service error",
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				toysService *mockservices.MockToysService,
+			) {
+				toysService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), uint64(1)).
+					Return(nil, errors.New("unavailable")).
+					Times(1)
 			},
+			errorExpected: true,
+		},
+		{
+			name: "tickets service error",
 			setupMocks: func(
 				ticketsService *mockservices.MockTicketsService,
-				respondsService *mockservices.MockRespondsService,
 				toysService *mockservices.MockToysService,
-				natsPublisher *mocknats.MockPublisher,
-				logger *mocklogging.MockLogger,
 			) {
+				toysService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), uint64(1)).
+					Return(&entities.Master{ID: 2, UserID: 1}, nil).
+					Times(1)
+
 				ticketsService.
 					EXPECT().
-					CountUserTickets(
-						gomock.Any(),
-						uint64(1),
-						&entities.TicketsFilters{
-							Search:              pointers.New("toy2"),
-							PriceCeil:           pointers.New[float32](1000),
-							PriceFloor:          pointers.New[float32](10),
-							QuantityFloor:       pointers.New[uint32](1),
-							CategoryIDs:         []uint32{1},
-							TagIDs:              []uint32{1},
-							CreatedAtOrderByAsc: pointers.New(true),
-						},
-					).
-					Return(uint64(1), nil).
+					DeleteUserData(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("delete failed")).
 					Times(1)
 			},
-			expected: 1,
-		},
-	}
-
-	ctrl := gomock.NewController(t)
-	ticketsService := mockservices.NewMockTicketsService(ctrl)
-	respondsService := mockservices.NewMockRespondsService(ctrl)
-	toysService := mockservices.NewMockToysService(ctrl)
-	natsPublisher := mocknats.NewMockPublisher(ctrl)
-	logger := mocklogging.NewMockLogger(ctrl)
-	natsConfig := config.NATSConfig{
-		Subjects: config.NATSSubjects{
-			TicketUpdated: "update.ticket",
+			errorExpected: true,
 		},
 	}
 
-	useCases := New(
-		ticketsService,
-		respondsService,
-		toysService,
-		natsPublisher,
-		natsConfig,
-		logger,
-	)
-
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
-				tc.setupMocks(ticketsService, respondsService, toysService, natsPublisher, logger)
+				tc.setupMocks(ticketsService, toysService)
 			}
 
-			actual, err := useCases.CountUserTickets(context.Background(), tc.userID, tc.filters)
+			err := useCases.DeleteUserData(context.Background(), uint64(1))
 			if tc.errorExpected {
 				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
 			}
+		})
+	}
+}
 
-			require.Equal(t, tc.expected, actual)
+func outboxMessageWithSubject(subject string) gomock.Matcher {
+	return gomock.Cond(func(message entities.CreateOutboxMessageDTO) bool {
+		return message.Subject == subject && len(message.Payload) > 0
+	})
+}
+
+// eventData checks event envelope and returns its data.
+func eventData(t *testing.T, payload []byte, eventType string) string {
+	t.Helper()
+
+	var envelope dto.EventEnvelope
+	require.NoError(t, json.Unmarshal(payload, &envelope))
+	require.Equal(t, eventType, envelope.Type)
+	require.NotEmpty(t, envelope.ID)
+
+	return string(envelope.Data)
+}
+
+func TestUseCases_WatchTickets(t *testing.T) {
+	snapshotTicket := entities.Ticket{ID: 1, CategoryID: 1, Status: entities.TicketStatusOpen}
+	createdTicket := entities.Ticket{ID: 2, CategoryID: 1, Status: entities.TicketStatusOpen}
+	otherCategoryTicket := entities.Ticket{ID: 3, CategoryID: 2, Status: entities.TicketStatusOpen}
+	filters := &entities.TicketsFilters{CategoryIDs: []uint32{1}}
+
+	t.Run("snapshot and matching events", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ticketsService := mockservices.NewMockTicketsService(ctrl)
+		watchConfig := config.WatchConfig{MaxStreams: 1, BufferSize: 10, SnapshotLimit: 5}
+		ticketEventsBus := eventbus.New(watchConfig)
+
+		useCases := New(
+			ticketsService,
+			mockservices.NewMockRespondsService(ctrl),
+			mockservices.NewMockToysService(ctrl),
+			ticketEventsBus,
+			config.NATSConfig{},
+			watchConfig,
+			mocklogging.NewMockLogger(ctrl),
+		)
+
+		ticketsService.
+			EXPECT().
+			GetTickets(gomock.Any(), &entities.Pagination{Limit: pointers.New[uint64](5)}, filters).
+			Return([]entities.Ticket{snapshotTicket}, nil).
+			Times(1)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		sentEvents := make(chan entities.TicketEvent, 10)
+		watchResult := make(chan error, 1)
+
+		go func() {
+			watchResult <- useCases.WatchTickets(
+				ctx,
+				filters,
+				true,
+				func(event entities.TicketEvent) error {
+					sentEvents <- event
+
+					return nil
+				},
+			)
+		}()
+
+		require.Equal(
+			t,
+			entities.TicketEvent{Type: entities.TicketEventTypeSnapshot, Ticket: snapshotTicket},
+			<-sentEvents,
+		)
+
+		ticketEventsBus.Publish(entities.TicketEvent{Type: entities.TicketEventTypeCreated, Ticket: otherCategoryTicket})
+		ticketEventsBus.Publish(entities.TicketEvent{Type: entities.TicketEventTypeCreated, Ticket: createdTicket})
+
+		// Ticket of other Category is skipped:
+		require.Equal(
+			t,
+			entities.TicketEvent{Type: entities.TicketEventTypeCreated, Ticket: createdTicket},
+			<-sentEvents,
+		)
+
+		cancel()
+		require.NoError(t, <-watchResult)
+		require.False(t, ticketEventsBus.HasSubscribers())
+	})
+
+	t.Run("search is not supported", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		watchConfig := config.WatchConfig{MaxStreams: 1, BufferSize: 1}
+		ticketEventsBus := eventbus.New(watchConfig)
+
+		useCases := New(
+			mockservices.NewMockTicketsService(ctrl),
+			mockservices.NewMockRespondsService(ctrl),
+			mockservices.NewMockToysService(ctrl),
+			ticketEventsBus,
+			config.NATSConfig{},
+			watchConfig,
+			mocklogging.NewMockLogger(ctrl),
+		)
+
+		err := useCases.WatchTickets(
+			context.Background(),
+			&entities.TicketsFilters{Search: pointers.New("horse")},
+			true,
+			func(_ entities.TicketEvent) error { return nil },
+		)
+		require.IsType(t, &customerrors.WatchSearchNotSupportedError{}, err)
+		require.False(t, ticketEventsBus.HasSubscribers())
+	})
+
+	t.Run("too many streams", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		watchConfig := config.WatchConfig{MaxStreams: 0, BufferSize: 1}
+
+		useCases := New(
+			mockservices.NewMockTicketsService(ctrl),
+			mockservices.NewMockRespondsService(ctrl),
+			mockservices.NewMockToysService(ctrl),
+			eventbus.New(watchConfig),
+			config.NATSConfig{},
+			watchConfig,
+			mocklogging.NewMockLogger(ctrl),
+		)
+
+		err := useCases.WatchTickets(
+			context.Background(),
+			nil,
+			false,
+			func(_ entities.TicketEvent) error { return nil },
+		)
+
+		var tooManyWatchStreamsError *customerrors.TooManyWatchStreamsError
+		require.True(t, errors.As(err, &tooManyWatchStreamsError))
+	})
+
+	t.Run("snapshot error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ticketsService := mockservices.NewMockTicketsService(ctrl)
+		watchConfig := config.WatchConfig{MaxStreams: 1, BufferSize: 1, SnapshotLimit: 5}
+		ticketEventsBus := eventbus.New(watchConfig)
+
+		useCases := New(
+			ticketsService,
+			mockservices.NewMockRespondsService(ctrl),
+			mockservices.NewMockToysService(ctrl),
+			ticketEventsBus,
+			config.NATSConfig{},
+			watchConfig,
+			mocklogging.NewMockLogger(ctrl),
+		)
+
+		ticketsService.
+			EXPECT().
+			GetTickets(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("database error")).
+			Times(1)
+
+		err := useCases.WatchTickets(
+			context.Background(),
+			nil,
+			true,
+			func(_ entities.TicketEvent) error { return nil },
+		)
+		require.Error(t, err)
+		require.False(t, ticketEventsBus.HasSubscribers())
+	})
+
+	t.Run("slow stream", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ticketsService := mockservices.NewMockTicketsService(ctrl)
+		watchConfig := config.WatchConfig{MaxStreams: 1, BufferSize: 1, SnapshotLimit: 5}
+		ticketEventsBus := eventbus.New(watchConfig)
+
+		useCases := New(
+			ticketsService,
+			mockservices.NewMockRespondsService(ctrl),
+			mockservices.NewMockToysService(ctrl),
+			ticketEventsBus,
+			config.NATSConfig{},
+			watchConfig,
+			mocklogging.NewMockLogger(ctrl),
+		)
+
+		ticketsService.
+			EXPECT().
+			GetTickets(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]entities.Ticket{snapshotTicket}, nil).
+			Times(1)
+
+		var sentEvents []entities.TicketEvent
+
+		err := useCases.WatchTickets(
+			context.Background(),
+			nil,
+			true,
+			func(event entities.TicketEvent) error {
+				// Events are published while snapshot is being sent, so buffer of one event overflows:
+				if event.Type == entities.TicketEventTypeSnapshot {
+					ticketEventsBus.Publish(
+						entities.TicketEvent{Type: entities.TicketEventTypeCreated, Ticket: createdTicket},
+					)
+					ticketEventsBus.Publish(
+						entities.TicketEvent{Type: entities.TicketEventTypeCreated, Ticket: otherCategoryTicket},
+					)
+				}
+
+				sentEvents = append(sentEvents, event)
+
+				return nil
+			},
+		)
+
+		var slowWatchStreamError *customerrors.SlowWatchStreamError
+		require.True(t, errors.As(err, &slowWatchStreamError))
+		require.Equal(
+			t,
+			[]entities.TicketEvent{
+				{Type: entities.TicketEventTypeSnapshot, Ticket: snapshotTicket},
+				{Type: entities.TicketEventTypeCreated, Ticket: createdTicket},
+			},
+			sentEvents,
+		)
+	})
+
+	t.Run("send error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ticketsService := mockservices.NewMockTicketsService(ctrl)
+		watchConfig := config.WatchConfig{MaxStreams: 1, BufferSize: 1, SnapshotLimit: 5}
+
+		useCases := New(
+			ticketsService,
+			mockservices.NewMockRespondsService(ctrl),
+			mockservices.NewMockToysService(ctrl),
+			eventbus.New(watchConfig),
+			config.NATSConfig{},
+			watchConfig,
+			mocklogging.NewMockLogger(ctrl),
+		)
+
+		ticketsService.
+			EXPECT().
+			GetTickets(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]entities.Ticket{snapshotTicket}, nil).
+			Times(1)
+
+		err := useCases.WatchTickets(
+			context.Background(),
+			nil,
+			true,
+			func(_ entities.TicketEvent) error { return errors.New("stream closed") },
+		)
+		require.Error(t, err)
+	})
+}
+
+func TestUseCases_PublishTicketEvents(t *testing.T) {
+	openTicket := &entities.Ticket{ID: 1, UserID: 1, Status: entities.TicketStatusOpen}
+	inProgressTicket := &entities.Ticket{ID: 1, UserID: 1, Status: entities.TicketStatusInProgress, Version: 2}
+
+	testCases := []struct {
+		name       string
+		action     func(useCases *UseCases) error
+		setupMocks func(
+			ticketsService *mockservices.MockTicketsService,
+			logger *mocklogging.MockLogger,
+		)
+		expectedEvent *entities.TicketEvent
+	}{
+		{
+			name: "status change publishes updated Ticket with previous state",
+			action: func(useCases *UseCases) error {
+				return useCases.ChangeTicketStatus(context.Background(), 1, 1, entities.TicketStatusInProgress)
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				_ *mocklogging.MockLogger,
+			) {
+				gomock.InOrder(
+					ticketsService.
+						EXPECT().
+						GetTicketByID(gomock.Any(), uint64(1)).
+						Return(openTicket, nil),
+					ticketsService.
+						EXPECT().
+						UpdateTicketStatus(gomock.Any(), uint64(1), entities.TicketStatusOpen, entities.TicketStatusInProgress).
+						Return(nil),
+					ticketsService.
+						EXPECT().
+						GetTicketByID(gomock.Any(), uint64(1)).
+						Return(inProgressTicket, nil),
+				)
+			},
+			expectedEvent: &entities.TicketEvent{
+				Type:           entities.TicketEventTypeUpdated,
+				Ticket:         *inProgressTicket,
+				PreviousTicket: openTicket,
+			},
+		},
+		{
+			name: "failed reading of changed Ticket is only logged",
+			action: func(useCases *UseCases) error {
+				return useCases.ChangeTicketStatus(context.Background(), 1, 1, entities.TicketStatusInProgress)
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				logger *mocklogging.MockLogger,
+			) {
+				gomock.InOrder(
+					ticketsService.
+						EXPECT().
+						GetTicketByID(gomock.Any(), uint64(1)).
+						Return(openTicket, nil),
+					ticketsService.
+						EXPECT().
+						UpdateTicketStatus(gomock.Any(), uint64(1), entities.TicketStatusOpen, entities.TicketStatusInProgress).
+						Return(nil),
+					ticketsService.
+						EXPECT().
+						GetTicketByID(gomock.Any(), uint64(1)).
+						Return(nil, errors.New("database error")),
+				)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+		},
+		{
+			name: "moving Tickets of deleted Category publishes previous Category",
+			action: func(useCases *UseCases) error {
+				return useCases.UpdateTicketsCategory(context.Background(), 2, 1)
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				_ *mocklogging.MockLogger,
+			) {
+				gomock.InOrder(
+					ticketsService.
+						EXPECT().
+						UpdateTicketsCategory(gomock.Any(), uint32(2), uint32(1), gomock.Any()).
+						Return([]uint64{1}, nil),
+					ticketsService.
+						EXPECT().
+						GetTicketsByIDs(gomock.Any(), []uint64{1}).
+						Return([]entities.Ticket{{ID: 1, CategoryID: 1, Version: 2}}, nil),
+				)
+			},
+			expectedEvent: &entities.TicketEvent{
+				Type:           entities.TicketEventTypeUpdated,
+				Ticket:         entities.Ticket{ID: 1, CategoryID: 1, Version: 2},
+				PreviousTicket: &entities.Ticket{ID: 1, CategoryID: 2, Version: 1},
+			},
+		},
+		{
+			name: "removing deleted Tag from Tickets publishes previous Tags",
+			action: func(useCases *UseCases) error {
+				return useCases.DeleteTagFromTickets(context.Background(), 3)
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				_ *mocklogging.MockLogger,
+			) {
+				gomock.InOrder(
+					ticketsService.
+						EXPECT().
+						DeleteTagFromTickets(gomock.Any(), uint32(3), gomock.Any()).
+						Return([]uint64{1}, nil),
+					ticketsService.
+						EXPECT().
+						GetTicketsByIDs(gomock.Any(), []uint64{1}).
+						Return([]entities.Ticket{{ID: 1, TagIDs: []uint32{1}, Version: 2}}, nil),
+				)
+			},
+			expectedEvent: &entities.TicketEvent{
+				Type:           entities.TicketEventTypeUpdated,
+				Ticket:         entities.Ticket{ID: 1, TagIDs: []uint32{1}, Version: 2},
+				PreviousTicket: &entities.Ticket{ID: 1, TagIDs: []uint32{1, 3}, Version: 1},
+			},
+		},
+		{
+			name: "bulk change without changed Tickets publishes nothing",
+			action: func(useCases *UseCases) error {
+				return useCases.DeleteTagFromTickets(context.Background(), 3)
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				_ *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					DeleteTagFromTickets(gomock.Any(), uint32(3), gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "failed reading of Tickets, changed by bulk operation, is only logged",
+			action: func(useCases *UseCases) error {
+				return useCases.UpdateTicketsCategory(context.Background(), 2, 1)
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				logger *mocklogging.MockLogger,
+			) {
+				gomock.InOrder(
+					ticketsService.
+						EXPECT().
+						UpdateTicketsCategory(gomock.Any(), uint32(2), uint32(1), gomock.Any()).
+						Return([]uint64{1}, nil),
+					ticketsService.
+						EXPECT().
+						GetTicketsByIDs(gomock.Any(), []uint64{1}).
+						Return(nil, errors.New("database error")),
+				)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+		},
+		{
+			name: "deletion publishes last known Ticket state",
+			action: func(useCases *UseCases) error {
+				return useCases.DeleteTicket(context.Background(), 1, 1)
+			},
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				_ *mocklogging.MockLogger,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(openTicket, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					DeleteTicket(gomock.Any(), uint64(1), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			expectedEvent: &entities.TicketEvent{
+				Type:   entities.TicketEventTypeDeleted,
+				Ticket: *openTicket,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ticketsService := mockservices.NewMockTicketsService(ctrl)
+			respondsService := mockservices.NewMockRespondsService(ctrl)
+			logger := mocklogging.NewMockLogger(ctrl)
+			watchConfig := config.WatchConfig{MaxStreams: 1, BufferSize: 1}
+			ticketEventsBus := eventbus.New(watchConfig)
+
+			useCases := New(
+				ticketsService,
+				respondsService,
+				mockservices.NewMockToysService(ctrl),
+				ticketEventsBus,
+				config.NATSConfig{},
+				watchConfig,
+				logger,
+			)
+
+			// Deletion reads Ticket Responds before deleting Ticket:
+			respondsService.
+				EXPECT().
+				GetTicketResponds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, nil).
+				AnyTimes()
+
+			tc.setupMocks(ticketsService, logger)
+
+			ticketEvents, unsubscribe, err := ticketEventsBus.Subscribe()
+			require.NoError(t, err)
+
+			defer unsubscribe()
+
+			require.NoError(t, tc.action(useCases))
+
+			if tc.expectedEvent == nil {
+				require.Empty(t, ticketEvents)
+
+				return
+			}
+
+			require.Equal(t, *tc.expectedEvent, <-ticketEvents)
+		})
+	}
+}
+
+func TestMatchTicketsFilters(t *testing.T) {
+	createdAt := time.Date(2025, 5, 10, 0, 0, 0, 0, time.UTC)
+	ticket := entities.Ticket{
+		ID:          1,
+		CategoryID:  1,
+		Name:        "Wooden Horse",
+		Description: "Toy for kids",
+		Price:       pointers.New[float32](300),
+		Quantity:    2,
+		Status:      entities.TicketStatusOpen,
+		TagIDs:      []uint32{1, 2},
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}
+
+	testCases := []struct {
+		name     string
+		ticket   entities.Ticket
+		filters  *entities.TicketsFilters
+		expected bool
+	}{
+		{
+			name:     "without filters",
+			ticket:   ticket,
+			filters:  nil,
+			expected: true,
+		},
+		{
+			name:   "all filters match",
+			ticket: ticket,
+			filters: &entities.TicketsFilters{
+				PriceFloor:    pointers.New[float32](100),
+				PriceCeil:     pointers.New[float32](300),
+				QuantityFloor: pointers.New[uint32](2),
+				CategoryIDs:   []uint32{1, 3},
+				TagIDs:        []uint32{2},
+				Statuses:      []entities.TicketStatus{entities.TicketStatusOpen},
+				CreatedAtFrom: pointers.New(createdAt),
+				UpdatedAtTo:   pointers.New(createdAt.Add(time.Hour)),
+			},
+			expected: true,
+		},
+		{
+			name:     "Ticket without price does not match price filter",
+			ticket:   entities.Ticket{ID: 2},
+			filters:  &entities.TicketsFilters{PriceCeil: pointers.New[float32](500)},
+			expected: false,
+		},
+		{
+			name:     "price is too high",
+			ticket:   ticket,
+			filters:  &entities.TicketsFilters{PriceCeil: pointers.New[float32](200)},
+			expected: false,
+		},
+		{
+			name:     "not enough quantity",
+			ticket:   ticket,
+			filters:  &entities.TicketsFilters{QuantityFloor: pointers.New[uint32](3)},
+			expected: false,
+		},
+		{
+			name:     "other category",
+			ticket:   ticket,
+			filters:  &entities.TicketsFilters{CategoryIDs: []uint32{2}},
+			expected: false,
+		},
+		{
+			name:     "empty categories are not checked",
+			ticket:   ticket,
+			filters:  &entities.TicketsFilters{CategoryIDs: []uint32{}},
+			expected: true,
+		},
+		{
+			name:     "not all tags",
+			ticket:   ticket,
+			filters:  &entities.TicketsFilters{TagIDs: []uint32{1, 3}},
+			expected: false,
+		},
+		{
+			name:     "other status",
+			ticket:   ticket,
+			filters:  &entities.TicketsFilters{Statuses: []entities.TicketStatus{entities.TicketStatusCompleted}},
+			expected: false,
+		},
+		{
+			name:     "created at upper bound is exclusive",
+			ticket:   ticket,
+			filters:  &entities.TicketsFilters{CreatedAtTo: pointers.New(createdAt)},
+			expected: false,
+		},
+		{
+			name:     "updated before range",
+			ticket:   ticket,
+			filters:  &entities.TicketsFilters{UpdatedAtFrom: pointers.New(createdAt.Add(time.Hour))},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, matchTicketsFilters(tc.ticket, tc.filters))
 		})
 	}
 }
+
+func TestMatchTicketEvent(t *testing.T) {
+	filters := &entities.TicketsFilters{Statuses: []entities.TicketStatus{entities.TicketStatusOpen}}
+	openTicket := entities.Ticket{ID: 1, Status: entities.TicketStatusOpen}
+	inProgressTicket := entities.Ticket{ID: 1, Status: entities.TicketStatusInProgress}
+
+	// Ticket, which does not match filters anymore, is sent to let client know about it:
+	require.True(
+		t,
+		matchTicketEvent(
+			entities.TicketEvent{
+				Type:           entities.TicketEventTypeUpdated,
+				Ticket:         inProgressTicket,
+				PreviousTicket: &openTicket,
+			},
+			filters,
+		),
+	)
+
+	require.False(
+		t,
+		matchTicketEvent(
+			entities.TicketEvent{Type: entities.TicketEventTypeCreated, Ticket: inProgressTicket},
+			filters,
+		),
+	)
+}
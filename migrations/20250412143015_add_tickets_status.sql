@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE tickets
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'open';
+
+CREATE INDEX IF NOT EXISTS tickets_status_idx ON tickets (status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS tickets_status_idx;
+
+ALTER TABLE tickets
+    DROP COLUMN status;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE responds
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'pending';
+
+CREATE INDEX IF NOT EXISTS responds_status_idx ON responds (status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS responds_status_idx;
+
+ALTER TABLE responds
+    DROP COLUMN status;
+-- +goose StatementEnd
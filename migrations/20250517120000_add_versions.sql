@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE tickets
+    ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE responds
+    ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE responds
+    DROP COLUMN version;
+
+ALTER TABLE tickets
+    DROP COLUMN version;
+-- +goose StatementEnd
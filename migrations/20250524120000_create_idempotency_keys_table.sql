@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS idempotency_keys
+(
+    id              SERIAL PRIMARY KEY,
+    user_id         INTEGER      NOT NULL,
+    operation       VARCHAR(50)  NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    resource_id     INTEGER      NOT NULL,
+    created_at      TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at      TIMESTAMP    NOT NULL,
+    UNIQUE (user_id, operation, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idempotency_keys_expires_at_idx;
+DROP TABLE IF EXISTS idempotency_keys;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Unique index can not be created while duplicates exist, so only one Respond of each master to each Ticket
+-- is kept. Accepted Respond must survive, since its Ticket is in progress, then rejected or pending one is
+-- preferred to withdrawn. The newest Respond is kept among Responds with the same status rank:
+DELETE FROM responds
+WHERE id IN (
+    SELECT dropped.id
+    FROM responds AS dropped
+    JOIN responds AS kept
+        ON kept.ticket_id = dropped.ticket_id
+        AND kept.master_id = dropped.master_id
+        AND kept.id <> dropped.id
+    WHERE
+        (CASE kept.status WHEN 'accepted' THEN 0 WHEN 'withdrawn' THEN 2 ELSE 1 END)
+            < (CASE dropped.status WHEN 'accepted' THEN 0 WHEN 'withdrawn' THEN 2 ELSE 1 END)
+        OR (
+            (CASE kept.status WHEN 'accepted' THEN 0 WHEN 'withdrawn' THEN 2 ELSE 1 END)
+                = (CASE dropped.status WHEN 'accepted' THEN 0 WHEN 'withdrawn' THEN 2 ELSE 1 END)
+            AND (
+                kept.created_at > dropped.created_at
+                OR (kept.created_at = dropped.created_at AND kept.id > dropped.id)
+            )
+        )
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE UNIQUE INDEX IF NOT EXISTS responds_ticket_id_master_id_idx ON responds (ticket_id, master_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS responds_ticket_id_master_id_idx;
+-- +goose StatementEnd
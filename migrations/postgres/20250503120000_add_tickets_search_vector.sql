@@ -0,0 +1,22 @@
+-- Postgres-specific migrations are stored separately, because they can not be applied to sqlite,
+-- which is used for integration tests.
+
+-- +goose Up
+-- +goose StatementBegin
+-- Languages must be the same as config.SearchVectorLanguages, which SEARCH_LANGUAGES is validated against:
+ALTER TABLE tickets
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(description, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS tickets_search_vector_idx ON tickets USING GIN (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS tickets_search_vector_idx;
+ALTER TABLE tickets DROP COLUMN IF EXISTS search_vector;
+-- +goose StatementEnd
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: buses.go
+//
+// Generated by this command:
+//
+//	mockgen -source=buses.go -destination=../../mocks/buses/ticket_events_bus.go -package=mockbuses -exclude_interfaces=
+//
+
+// Package mockbuses is a generated GoMock package.
+package mockbuses
+
+import (
+	reflect "reflect"
+
+	entities "github.com/DKhorkov/hmtm-tickets/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTicketEventsBus is a mock of TicketEventsBus interface.
+type MockTicketEventsBus struct {
+	ctrl     *gomock.Controller
+	recorder *MockTicketEventsBusMockRecorder
+	isgomock struct{}
+}
+
+// MockTicketEventsBusMockRecorder is the mock recorder for MockTicketEventsBus.
+type MockTicketEventsBusMockRecorder struct {
+	mock *MockTicketEventsBus
+}
+
+// NewMockTicketEventsBus creates a new mock instance.
+func NewMockTicketEventsBus(ctrl *gomock.Controller) *MockTicketEventsBus {
+	mock := &MockTicketEventsBus{ctrl: ctrl}
+	mock.recorder = &MockTicketEventsBusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTicketEventsBus) EXPECT() *MockTicketEventsBusMockRecorder {
+	return m.recorder
+}
+
+// HasSubscribers mocks base method.
+func (m *MockTicketEventsBus) HasSubscribers() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasSubscribers")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasSubscribers indicates an expected call of HasSubscribers.
+func (mr *MockTicketEventsBusMockRecorder) HasSubscribers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSubscribers", reflect.TypeOf((*MockTicketEventsBus)(nil).HasSubscribers))
+}
+
+// Publish mocks base method.
+func (m *MockTicketEventsBus) Publish(event entities.TicketEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", event)
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockTicketEventsBusMockRecorder) Publish(event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockTicketEventsBus)(nil).Publish), event)
+}
+
+// Subscribe mocks base method.
+func (m *MockTicketEventsBus) Subscribe() (<-chan entities.TicketEvent, func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe")
+	ret0, _ := ret[0].(<-chan entities.TicketEvent)
+	ret1, _ := ret[1].(func())
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockTicketEventsBusMockRecorder) Subscribe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockTicketEventsBus)(nil).Subscribe))
+}
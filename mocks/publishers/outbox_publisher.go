@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: publishers.go
+//
+// Generated by this command:
+//
+//	mockgen -source=publishers.go -destination=../../mocks/publishers/outbox_publisher.go -package=mockpublishers -exclude_interfaces=
+//
+
+// Package mockpublishers is a generated GoMock package.
+package mockpublishers
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/DKhorkov/hmtm-tickets/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOutboxPublisher is a mock of OutboxPublisher interface.
+type MockOutboxPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxPublisherMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxPublisherMockRecorder is the mock recorder for MockOutboxPublisher.
+type MockOutboxPublisherMockRecorder struct {
+	mock *MockOutboxPublisher
+}
+
+// NewMockOutboxPublisher creates a new mock instance.
+func NewMockOutboxPublisher(ctrl *gomock.Controller) *MockOutboxPublisher {
+	mock := &MockOutboxPublisher{ctrl: ctrl}
+	mock.recorder = &MockOutboxPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxPublisher) EXPECT() *MockOutboxPublisherMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockOutboxPublisher) Publish(ctx context.Context, message entities.OutboxMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockOutboxPublisherMockRecorder) Publish(ctx, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockOutboxPublisher)(nil).Publish), ctx, message)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repositories.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repositories.go -destination=../../mocks/repositories/idempotency_keys_repository.go -exclude_interfaces=RespondsRepository,TicketsRepository,ToysRepository,OutboxRepository -package=mockrepositories
+//
+
+// Package mockrepositories is a generated GoMock package.
+package mockrepositories
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIdempotencyKeysRepository is a mock of IdempotencyKeysRepository interface.
+type MockIdempotencyKeysRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyKeysRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIdempotencyKeysRepositoryMockRecorder is the mock recorder for MockIdempotencyKeysRepository.
+type MockIdempotencyKeysRepositoryMockRecorder struct {
+	mock *MockIdempotencyKeysRepository
+}
+
+// NewMockIdempotencyKeysRepository creates a new mock instance.
+func NewMockIdempotencyKeysRepository(ctrl *gomock.Controller) *MockIdempotencyKeysRepository {
+	mock := &MockIdempotencyKeysRepository{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyKeysRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyKeysRepository) EXPECT() *MockIdempotencyKeysRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteExpiredIdempotencyKeys mocks base method.
+func (m *MockIdempotencyKeysRepository) DeleteExpiredIdempotencyKeys(ctx context.Context, expiredBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredIdempotencyKeys", ctx, expiredBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredIdempotencyKeys indicates an expected call of DeleteExpiredIdempotencyKeys.
+func (mr *MockIdempotencyKeysRepositoryMockRecorder) DeleteExpiredIdempotencyKeys(ctx, expiredBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredIdempotencyKeys", reflect.TypeOf((*MockIdempotencyKeysRepository)(nil).DeleteExpiredIdempotencyKeys), ctx, expiredBefore)
+}
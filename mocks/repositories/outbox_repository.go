@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repositories.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repositories.go -destination=../../mocks/repositories/outbox_repository.go -exclude_interfaces=RespondsRepository,TicketsRepository,ToysRepository,IdempotencyKeysRepository -package=mockrepositories
+//
+
+// Package mockrepositories is a generated GoMock package.
+package mockrepositories
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/DKhorkov/hmtm-tickets/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOutboxRepository is a mock of OutboxRepository interface.
+type MockOutboxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxRepositoryMockRecorder is the mock recorder for MockOutboxRepository.
+type MockOutboxRepositoryMockRecorder struct {
+	mock *MockOutboxRepository
+}
+
+// NewMockOutboxRepository creates a new mock instance.
+func NewMockOutboxRepository(ctrl *gomock.Controller) *MockOutboxRepository {
+	mock := &MockOutboxRepository{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRepository) EXPECT() *MockOutboxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimPendingOutboxMessages mocks base method.
+func (m *MockOutboxRepository) ClaimPendingOutboxMessages(ctx context.Context, limit uint64, leaseTimeout time.Duration) ([]entities.OutboxMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPendingOutboxMessages", ctx, limit, leaseTimeout)
+	ret0, _ := ret[0].([]entities.OutboxMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPendingOutboxMessages indicates an expected call of ClaimPendingOutboxMessages.
+func (mr *MockOutboxRepositoryMockRecorder) ClaimPendingOutboxMessages(ctx, limit, leaseTimeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPendingOutboxMessages", reflect.TypeOf((*MockOutboxRepository)(nil).ClaimPendingOutboxMessages), ctx, limit, leaseTimeout)
+}
+
+// DeleteSentOutboxMessages mocks base method.
+func (m *MockOutboxRepository) DeleteSentOutboxMessages(ctx context.Context, sentBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSentOutboxMessages", ctx, sentBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSentOutboxMessages indicates an expected call of DeleteSentOutboxMessages.
+func (mr *MockOutboxRepositoryMockRecorder) DeleteSentOutboxMessages(ctx, sentBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSentOutboxMessages", reflect.TypeOf((*MockOutboxRepository)(nil).DeleteSentOutboxMessages), ctx, sentBefore)
+}
+
+// MarkOutboxMessageAsFailed mocks base method.
+func (m *MockOutboxRepository) MarkOutboxMessageAsFailed(ctx context.Context, id uint64, lastError string, nextAttemptAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxMessageAsFailed", ctx, id, lastError, nextAttemptAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxMessageAsFailed indicates an expected call of MarkOutboxMessageAsFailed.
+func (mr *MockOutboxRepositoryMockRecorder) MarkOutboxMessageAsFailed(ctx, id, lastError, nextAttemptAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxMessageAsFailed", reflect.TypeOf((*MockOutboxRepository)(nil).MarkOutboxMessageAsFailed), ctx, id, lastError, nextAttemptAt)
+}
+
+// MarkOutboxMessageAsSent mocks base method.
+func (m *MockOutboxRepository) MarkOutboxMessageAsSent(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxMessageAsSent", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxMessageAsSent indicates an expected call of MarkOutboxMessageAsSent.
+func (mr *MockOutboxRepositoryMockRecorder) MarkOutboxMessageAsSent(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxMessageAsSent", reflect.TypeOf((*MockOutboxRepository)(nil).MarkOutboxMessageAsSent), ctx, id)
+}
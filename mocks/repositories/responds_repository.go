@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=repositories.go -destination=../../mocks/repositories/responds_repository.go -exclude_interfaces=TicketsRepository,ToysRepository -package=mockrepositories
+//	mockgen -source=repositories.go -destination=../../mocks/repositories/responds_repository.go -exclude_interfaces=TicketsRepository,ToysRepository,OutboxRepository,IdempotencyKeysRepository -package=mockrepositories
 //
 
 // Package mockrepositories is a generated GoMock package.
@@ -41,33 +41,87 @@ func (m *MockRespondsRepository) EXPECT() *MockRespondsRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AcceptRespond mocks base method.
+func (m *MockRespondsRepository) AcceptRespond(ctx context.Context, id uint64, outboxMessages ...entities.CreateOutboxMessageDTO) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range outboxMessages {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AcceptRespond", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptRespond indicates an expected call of AcceptRespond.
+func (mr *MockRespondsRepositoryMockRecorder) AcceptRespond(ctx, id any, outboxMessages ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, outboxMessages...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptRespond", reflect.TypeOf((*MockRespondsRepository)(nil).AcceptRespond), varargs...)
+}
+
+// CountMasterResponds mocks base method.
+func (m *MockRespondsRepository) CountMasterResponds(ctx context.Context, masterID uint64, filters *entities.RespondsFilters) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountMasterResponds", ctx, masterID, filters)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMasterResponds indicates an expected call of CountMasterResponds.
+func (mr *MockRespondsRepositoryMockRecorder) CountMasterResponds(ctx, masterID, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMasterResponds", reflect.TypeOf((*MockRespondsRepository)(nil).CountMasterResponds), ctx, masterID, filters)
+}
+
+// CountTicketResponds mocks base method.
+func (m *MockRespondsRepository) CountTicketResponds(ctx context.Context, ticketID uint64, filters *entities.RespondsFilters) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTicketResponds", ctx, ticketID, filters)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTicketResponds indicates an expected call of CountTicketResponds.
+func (mr *MockRespondsRepositoryMockRecorder) CountTicketResponds(ctx, ticketID, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTicketResponds", reflect.TypeOf((*MockRespondsRepository)(nil).CountTicketResponds), ctx, ticketID, filters)
+}
+
 // DeleteRespond mocks base method.
-func (m *MockRespondsRepository) DeleteRespond(ctx context.Context, id uint64) error {
+func (m *MockRespondsRepository) DeleteRespond(ctx context.Context, id uint64, outboxMessages ...entities.CreateOutboxMessageDTO) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRespond", ctx, id)
+	varargs := []any{ctx, id}
+	for _, a := range outboxMessages {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRespond", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteRespond indicates an expected call of DeleteRespond.
-func (mr *MockRespondsRepositoryMockRecorder) DeleteRespond(ctx, id any) *gomock.Call {
+func (mr *MockRespondsRepositoryMockRecorder) DeleteRespond(ctx, id any, outboxMessages ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRespond", reflect.TypeOf((*MockRespondsRepository)(nil).DeleteRespond), ctx, id)
+	varargs := append([]any{ctx, id}, outboxMessages...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRespond", reflect.TypeOf((*MockRespondsRepository)(nil).DeleteRespond), varargs...)
 }
 
 // GetMasterResponds mocks base method.
-func (m *MockRespondsRepository) GetMasterResponds(ctx context.Context, masterID uint64) ([]entities.Respond, error) {
+func (m *MockRespondsRepository) GetMasterResponds(ctx context.Context, masterID uint64, pagination *entities.Pagination, filters *entities.RespondsFilters) ([]entities.Respond, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMasterResponds", ctx, masterID)
+	ret := m.ctrl.Call(m, "GetMasterResponds", ctx, masterID, pagination, filters)
 	ret0, _ := ret[0].([]entities.Respond)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMasterResponds indicates an expected call of GetMasterResponds.
-func (mr *MockRespondsRepositoryMockRecorder) GetMasterResponds(ctx, masterID any) *gomock.Call {
+func (mr *MockRespondsRepositoryMockRecorder) GetMasterResponds(ctx, masterID, pagination, filters any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterResponds", reflect.TypeOf((*MockRespondsRepository)(nil).GetMasterResponds), ctx, masterID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterResponds", reflect.TypeOf((*MockRespondsRepository)(nil).GetMasterResponds), ctx, masterID, pagination, filters)
 }
 
 // GetRespondByID mocks base method.
@@ -85,46 +139,119 @@ func (mr *MockRespondsRepositoryMockRecorder) GetRespondByID(ctx, id any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRespondByID", reflect.TypeOf((*MockRespondsRepository)(nil).GetRespondByID), ctx, id)
 }
 
+// GetRespondIDByIdempotencyKey mocks base method.
+func (m *MockRespondsRepository) GetRespondIDByIdempotencyKey(ctx context.Context, idempotencyKey entities.IdempotencyKeyDTO) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRespondIDByIdempotencyKey", ctx, idempotencyKey)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRespondIDByIdempotencyKey indicates an expected call of GetRespondIDByIdempotencyKey.
+func (mr *MockRespondsRepositoryMockRecorder) GetRespondIDByIdempotencyKey(ctx, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRespondIDByIdempotencyKey", reflect.TypeOf((*MockRespondsRepository)(nil).GetRespondIDByIdempotencyKey), ctx, idempotencyKey)
+}
+
+// GetRespondsByIDs mocks base method.
+func (m *MockRespondsRepository) GetRespondsByIDs(ctx context.Context, ids []uint64) ([]entities.Respond, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRespondsByIDs", ctx, ids)
+	ret0, _ := ret[0].([]entities.Respond)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRespondsByIDs indicates an expected call of GetRespondsByIDs.
+func (mr *MockRespondsRepositoryMockRecorder) GetRespondsByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRespondsByIDs", reflect.TypeOf((*MockRespondsRepository)(nil).GetRespondsByIDs), ctx, ids)
+}
+
 // GetTicketResponds mocks base method.
-func (m *MockRespondsRepository) GetTicketResponds(ctx context.Context, ticketID uint64) ([]entities.Respond, error) {
+func (m *MockRespondsRepository) GetTicketResponds(ctx context.Context, ticketID uint64, pagination *entities.Pagination, filters *entities.RespondsFilters) ([]entities.Respond, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketResponds", ctx, ticketID)
+	ret := m.ctrl.Call(m, "GetTicketResponds", ctx, ticketID, pagination, filters)
 	ret0, _ := ret[0].([]entities.Respond)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTicketResponds indicates an expected call of GetTicketResponds.
-func (mr *MockRespondsRepositoryMockRecorder) GetTicketResponds(ctx, ticketID any) *gomock.Call {
+func (mr *MockRespondsRepositoryMockRecorder) GetTicketResponds(ctx, ticketID, pagination, filters any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketResponds", reflect.TypeOf((*MockRespondsRepository)(nil).GetTicketResponds), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketResponds", reflect.TypeOf((*MockRespondsRepository)(nil).GetTicketResponds), ctx, ticketID, pagination, filters)
 }
 
 // RespondToTicket mocks base method.
-func (m *MockRespondsRepository) RespondToTicket(ctx context.Context, respondData entities.RespondToTicketDTO) (uint64, error) {
+func (m *MockRespondsRepository) RespondToTicket(ctx context.Context, respondData entities.RespondToTicketDTO, outboxMessageBuilders ...entities.OutboxMessageBuilder) (uint64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RespondToTicket", ctx, respondData)
+	varargs := []any{ctx, respondData}
+	for _, a := range outboxMessageBuilders {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RespondToTicket", varargs...)
 	ret0, _ := ret[0].(uint64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RespondToTicket indicates an expected call of RespondToTicket.
-func (mr *MockRespondsRepositoryMockRecorder) RespondToTicket(ctx, respondData any) *gomock.Call {
+func (mr *MockRespondsRepositoryMockRecorder) RespondToTicket(ctx, respondData any, outboxMessageBuilders ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondToTicket", reflect.TypeOf((*MockRespondsRepository)(nil).RespondToTicket), ctx, respondData)
+	varargs := append([]any{ctx, respondData}, outboxMessageBuilders...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondToTicket", reflect.TypeOf((*MockRespondsRepository)(nil).RespondToTicket), varargs...)
 }
 
 // UpdateRespond mocks base method.
-func (m *MockRespondsRepository) UpdateRespond(ctx context.Context, respondData entities.UpdateRespondDTO) error {
+func (m *MockRespondsRepository) UpdateRespond(ctx context.Context, respondData entities.UpdateRespondDTO, outboxMessages ...entities.CreateOutboxMessageDTO) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateRespond", ctx, respondData)
+	varargs := []any{ctx, respondData}
+	for _, a := range outboxMessages {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRespond", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateRespond indicates an expected call of UpdateRespond.
-func (mr *MockRespondsRepositoryMockRecorder) UpdateRespond(ctx, respondData any) *gomock.Call {
+func (mr *MockRespondsRepositoryMockRecorder) UpdateRespond(ctx, respondData any, outboxMessages ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, respondData}, outboxMessages...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRespond", reflect.TypeOf((*MockRespondsRepository)(nil).UpdateRespond), varargs...)
+}
+
+// UpdateRespondStatus mocks base method.
+func (m *MockRespondsRepository) UpdateRespondStatus(ctx context.Context, id uint64, status entities.RespondStatus, outboxMessages ...entities.CreateOutboxMessageDTO) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, status}
+	for _, a := range outboxMessages {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRespondStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRespondStatus indicates an expected call of UpdateRespondStatus.
+func (mr *MockRespondsRepositoryMockRecorder) UpdateRespondStatus(ctx, id, status any, outboxMessages ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, status}, outboxMessages...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRespondStatus", reflect.TypeOf((*MockRespondsRepository)(nil).UpdateRespondStatus), varargs...)
+}
+
+// WithdrawMasterResponds mocks base method.
+func (m *MockRespondsRepository) WithdrawMasterResponds(ctx context.Context, masterID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawMasterResponds", ctx, masterID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithdrawMasterResponds indicates an expected call of WithdrawMasterResponds.
+func (mr *MockRespondsRepositoryMockRecorder) WithdrawMasterResponds(ctx, masterID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRespond", reflect.TypeOf((*MockRespondsRepository)(nil).UpdateRespond), ctx, respondData)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawMasterResponds", reflect.TypeOf((*MockRespondsRepository)(nil).WithdrawMasterResponds), ctx, masterID)
 }
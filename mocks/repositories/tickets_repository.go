@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=repositories.go -destination=../../mocks/repositories/tickets_repository.go -exclude_interfaces=RespondsRepository,ToysRepository -package=mockrepositories
+//	mockgen -source=repositories.go -destination=../../mocks/repositories/tickets_repository.go -exclude_interfaces=RespondsRepository,ToysRepository,OutboxRepository,IdempotencyKeysRepository -package=mockrepositories
 //
 
 // Package mockrepositories is a generated GoMock package.
@@ -72,32 +72,81 @@ func (mr *MockTicketsRepositoryMockRecorder) CountUserTickets(ctx, userID, filte
 }
 
 // CreateTicket mocks base method.
-func (m *MockTicketsRepository) CreateTicket(ctx context.Context, ticketData entities.CreateTicketDTO) (uint64, error) {
+func (m *MockTicketsRepository) CreateTicket(ctx context.Context, ticketData entities.CreateTicketDTO, outboxMessageBuilders ...entities.OutboxMessageBuilder) (uint64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTicket", ctx, ticketData)
+	varargs := []any{ctx, ticketData}
+	for _, a := range outboxMessageBuilders {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTicket", varargs...)
 	ret0, _ := ret[0].(uint64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateTicket indicates an expected call of CreateTicket.
-func (mr *MockTicketsRepositoryMockRecorder) CreateTicket(ctx, ticketData any) *gomock.Call {
+func (mr *MockTicketsRepositoryMockRecorder) CreateTicket(ctx, ticketData any, outboxMessageBuilders ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*MockTicketsRepository)(nil).CreateTicket), ctx, ticketData)
+	varargs := append([]any{ctx, ticketData}, outboxMessageBuilders...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*MockTicketsRepository)(nil).CreateTicket), varargs...)
+}
+
+// DeleteTagFromTickets mocks base method.
+func (m *MockTicketsRepository) DeleteTagFromTickets(ctx context.Context, tagID uint32, outboxMessageBuilders ...entities.OutboxMessageBuilder) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tagID}
+	for _, a := range outboxMessageBuilders {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTagFromTickets", varargs...)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTagFromTickets indicates an expected call of DeleteTagFromTickets.
+func (mr *MockTicketsRepositoryMockRecorder) DeleteTagFromTickets(ctx, tagID any, outboxMessageBuilders ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tagID}, outboxMessageBuilders...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagFromTickets", reflect.TypeOf((*MockTicketsRepository)(nil).DeleteTagFromTickets), varargs...)
 }
 
 // DeleteTicket mocks base method.
-func (m *MockTicketsRepository) DeleteTicket(ctx context.Context, id uint64) error {
+func (m *MockTicketsRepository) DeleteTicket(ctx context.Context, id uint64, outboxMessages ...entities.CreateOutboxMessageDTO) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTicket", ctx, id)
+	varargs := []any{ctx, id}
+	for _, a := range outboxMessages {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTicket", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteTicket indicates an expected call of DeleteTicket.
-func (mr *MockTicketsRepositoryMockRecorder) DeleteTicket(ctx, id any) *gomock.Call {
+func (mr *MockTicketsRepositoryMockRecorder) DeleteTicket(ctx, id any, outboxMessages ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicket", reflect.TypeOf((*MockTicketsRepository)(nil).DeleteTicket), ctx, id)
+	varargs := append([]any{ctx, id}, outboxMessages...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicket", reflect.TypeOf((*MockTicketsRepository)(nil).DeleteTicket), varargs...)
+}
+
+// DeleteUserData mocks base method.
+func (m *MockTicketsRepository) DeleteUserData(ctx context.Context, userData entities.DeleteUserDataDTO, outboxMessageBuilders ...entities.DeletedUserDataOutboxMessageBuilder) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userData}
+	for _, a := range outboxMessageBuilders {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteUserData", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserData indicates an expected call of DeleteUserData.
+func (mr *MockTicketsRepositoryMockRecorder) DeleteUserData(ctx, userData any, outboxMessageBuilders ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userData}, outboxMessageBuilders...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserData", reflect.TypeOf((*MockTicketsRepository)(nil).DeleteUserData), varargs...)
 }
 
 // GetTicketByID mocks base method.
@@ -115,6 +164,21 @@ func (mr *MockTicketsRepositoryMockRecorder) GetTicketByID(ctx, id any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketByID", reflect.TypeOf((*MockTicketsRepository)(nil).GetTicketByID), ctx, id)
 }
 
+// GetTicketIDByIdempotencyKey mocks base method.
+func (m *MockTicketsRepository) GetTicketIDByIdempotencyKey(ctx context.Context, idempotencyKey entities.IdempotencyKeyDTO) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketIDByIdempotencyKey", ctx, idempotencyKey)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketIDByIdempotencyKey indicates an expected call of GetTicketIDByIdempotencyKey.
+func (mr *MockTicketsRepositoryMockRecorder) GetTicketIDByIdempotencyKey(ctx, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketIDByIdempotencyKey", reflect.TypeOf((*MockTicketsRepository)(nil).GetTicketIDByIdempotencyKey), ctx, idempotencyKey)
+}
+
 // GetTickets mocks base method.
 func (m *MockTicketsRepository) GetTickets(ctx context.Context, pagination *entities.Pagination, filters *entities.TicketsFilters) ([]entities.Ticket, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +194,21 @@ func (mr *MockTicketsRepositoryMockRecorder) GetTickets(ctx, pagination, filters
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTickets", reflect.TypeOf((*MockTicketsRepository)(nil).GetTickets), ctx, pagination, filters)
 }
 
+// GetTicketsByIDs mocks base method.
+func (m *MockTicketsRepository) GetTicketsByIDs(ctx context.Context, ids []uint64) ([]entities.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketsByIDs", ctx, ids)
+	ret0, _ := ret[0].([]entities.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketsByIDs indicates an expected call of GetTicketsByIDs.
+func (mr *MockTicketsRepositoryMockRecorder) GetTicketsByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsByIDs", reflect.TypeOf((*MockTicketsRepository)(nil).GetTicketsByIDs), ctx, ids)
+}
+
 // GetUserTickets mocks base method.
 func (m *MockTicketsRepository) GetUserTickets(ctx context.Context, userID uint64, pagination *entities.Pagination, filters *entities.TicketsFilters) ([]entities.Ticket, error) {
 	m.ctrl.T.Helper()
@@ -146,15 +225,54 @@ func (mr *MockTicketsRepositoryMockRecorder) GetUserTickets(ctx, userID, paginat
 }
 
 // UpdateTicket mocks base method.
-func (m *MockTicketsRepository) UpdateTicket(ctx context.Context, ticketData entities.UpdateTicketDTO) error {
+func (m *MockTicketsRepository) UpdateTicket(ctx context.Context, ticketData entities.UpdateTicketDTO, outboxMessages ...entities.CreateOutboxMessageDTO) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTicket", ctx, ticketData)
+	varargs := []any{ctx, ticketData}
+	for _, a := range outboxMessages {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTicket", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateTicket indicates an expected call of UpdateTicket.
-func (mr *MockTicketsRepositoryMockRecorder) UpdateTicket(ctx, ticketData any) *gomock.Call {
+func (mr *MockTicketsRepositoryMockRecorder) UpdateTicket(ctx, ticketData any, outboxMessages ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, ticketData}, outboxMessages...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicket", reflect.TypeOf((*MockTicketsRepository)(nil).UpdateTicket), varargs...)
+}
+
+// UpdateTicketStatus mocks base method.
+func (m *MockTicketsRepository) UpdateTicketStatus(ctx context.Context, id uint64, currentStatus, status entities.TicketStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTicketStatus", ctx, id, currentStatus, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTicketStatus indicates an expected call of UpdateTicketStatus.
+func (mr *MockTicketsRepositoryMockRecorder) UpdateTicketStatus(ctx, id, currentStatus, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicketStatus", reflect.TypeOf((*MockTicketsRepository)(nil).UpdateTicketStatus), ctx, id, currentStatus, status)
+}
+
+// UpdateTicketsCategory mocks base method.
+func (m *MockTicketsRepository) UpdateTicketsCategory(ctx context.Context, oldCategoryID, newCategoryID uint32, outboxMessageBuilders ...entities.OutboxMessageBuilder) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, oldCategoryID, newCategoryID}
+	for _, a := range outboxMessageBuilders {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTicketsCategory", varargs...)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTicketsCategory indicates an expected call of UpdateTicketsCategory.
+func (mr *MockTicketsRepositoryMockRecorder) UpdateTicketsCategory(ctx, oldCategoryID, newCategoryID any, outboxMessageBuilders ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicket", reflect.TypeOf((*MockTicketsRepository)(nil).UpdateTicket), ctx, ticketData)
+	varargs := append([]any{ctx, oldCategoryID, newCategoryID}, outboxMessageBuilders...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicketsCategory", reflect.TypeOf((*MockTicketsRepository)(nil).UpdateTicketsCategory), varargs...)
 }
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services.go
+//
+// Generated by this command:
+//
+//	mockgen -source=services.go -destination=../../mocks/services/idempotency_keys_service.go -package=mockservices -exclude_interfaces=RespondsService,TicketsService,ToysService,OutboxService
+//
+
+// Package mockservices is a generated GoMock package.
+package mockservices
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIdempotencyKeysService is a mock of IdempotencyKeysService interface.
+type MockIdempotencyKeysService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyKeysServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockIdempotencyKeysServiceMockRecorder is the mock recorder for MockIdempotencyKeysService.
+type MockIdempotencyKeysServiceMockRecorder struct {
+	mock *MockIdempotencyKeysService
+}
+
+// NewMockIdempotencyKeysService creates a new mock instance.
+func NewMockIdempotencyKeysService(ctrl *gomock.Controller) *MockIdempotencyKeysService {
+	mock := &MockIdempotencyKeysService{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyKeysServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyKeysService) EXPECT() *MockIdempotencyKeysServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteExpiredIdempotencyKeys mocks base method.
+func (m *MockIdempotencyKeysService) DeleteExpiredIdempotencyKeys(ctx context.Context, expiredBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredIdempotencyKeys", ctx, expiredBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredIdempotencyKeys indicates an expected call of DeleteExpiredIdempotencyKeys.
+func (mr *MockIdempotencyKeysServiceMockRecorder) DeleteExpiredIdempotencyKeys(ctx, expiredBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredIdempotencyKeys", reflect.TypeOf((*MockIdempotencyKeysService)(nil).DeleteExpiredIdempotencyKeys), ctx, expiredBefore)
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services.go
+//
+// Generated by this command:
+//
+//	mockgen -source=services.go -destination=../../mocks/services/outbox_service.go -package=mockservices -exclude_interfaces=RespondsService,TicketsService,ToysService,IdempotencyKeysService
+//
+
+// Package mockservices is a generated GoMock package.
+package mockservices
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/DKhorkov/hmtm-tickets/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOutboxService is a mock of OutboxService interface.
+type MockOutboxService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxServiceMockRecorder is the mock recorder for MockOutboxService.
+type MockOutboxServiceMockRecorder struct {
+	mock *MockOutboxService
+}
+
+// NewMockOutboxService creates a new mock instance.
+func NewMockOutboxService(ctrl *gomock.Controller) *MockOutboxService {
+	mock := &MockOutboxService{ctrl: ctrl}
+	mock.recorder = &MockOutboxServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxService) EXPECT() *MockOutboxServiceMockRecorder {
+	return m.recorder
+}
+
+// ClaimPendingOutboxMessages mocks base method.
+func (m *MockOutboxService) ClaimPendingOutboxMessages(ctx context.Context, limit uint64, leaseTimeout time.Duration) ([]entities.OutboxMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPendingOutboxMessages", ctx, limit, leaseTimeout)
+	ret0, _ := ret[0].([]entities.OutboxMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPendingOutboxMessages indicates an expected call of ClaimPendingOutboxMessages.
+func (mr *MockOutboxServiceMockRecorder) ClaimPendingOutboxMessages(ctx, limit, leaseTimeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPendingOutboxMessages", reflect.TypeOf((*MockOutboxService)(nil).ClaimPendingOutboxMessages), ctx, limit, leaseTimeout)
+}
+
+// DeleteSentOutboxMessages mocks base method.
+func (m *MockOutboxService) DeleteSentOutboxMessages(ctx context.Context, sentBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSentOutboxMessages", ctx, sentBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSentOutboxMessages indicates an expected call of DeleteSentOutboxMessages.
+func (mr *MockOutboxServiceMockRecorder) DeleteSentOutboxMessages(ctx, sentBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSentOutboxMessages", reflect.TypeOf((*MockOutboxService)(nil).DeleteSentOutboxMessages), ctx, sentBefore)
+}
+
+// MarkOutboxMessageAsFailed mocks base method.
+func (m *MockOutboxService) MarkOutboxMessageAsFailed(ctx context.Context, id uint64, lastError string, nextAttemptAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxMessageAsFailed", ctx, id, lastError, nextAttemptAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxMessageAsFailed indicates an expected call of MarkOutboxMessageAsFailed.
+func (mr *MockOutboxServiceMockRecorder) MarkOutboxMessageAsFailed(ctx, id, lastError, nextAttemptAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxMessageAsFailed", reflect.TypeOf((*MockOutboxService)(nil).MarkOutboxMessageAsFailed), ctx, id, lastError, nextAttemptAt)
+}
+
+// MarkOutboxMessageAsSent mocks base method.
+func (m *MockOutboxService) MarkOutboxMessageAsSent(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxMessageAsSent", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxMessageAsSent indicates an expected call of MarkOutboxMessageAsSent.
+func (mr *MockOutboxServiceMockRecorder) MarkOutboxMessageAsSent(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxMessageAsSent", reflect.TypeOf((*MockOutboxService)(nil).MarkOutboxMessageAsSent), ctx, id)
+}
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=services.go -destination=../../mocks/services/responds_service.go -package=mockservices -exclude_interfaces=TicketsService,ToysService
+//	mockgen -source=services.go -destination=../../mocks/services/responds_service.go -package=mockservices -exclude_interfaces=TicketsService,ToysService,OutboxService,IdempotencyKeysService
 //
 
 // Package mockservices is a generated GoMock package.
@@ -41,33 +41,87 @@ func (m *MockRespondsService) EXPECT() *MockRespondsServiceMockRecorder {
 	return m.recorder
 }
 
+// AcceptRespond mocks base method.
+func (m *MockRespondsService) AcceptRespond(ctx context.Context, id uint64, outboxMessages ...entities.CreateOutboxMessageDTO) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range outboxMessages {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AcceptRespond", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptRespond indicates an expected call of AcceptRespond.
+func (mr *MockRespondsServiceMockRecorder) AcceptRespond(ctx, id any, outboxMessages ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, outboxMessages...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptRespond", reflect.TypeOf((*MockRespondsService)(nil).AcceptRespond), varargs...)
+}
+
+// CountMasterResponds mocks base method.
+func (m *MockRespondsService) CountMasterResponds(ctx context.Context, masterID uint64, filters *entities.RespondsFilters) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountMasterResponds", ctx, masterID, filters)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMasterResponds indicates an expected call of CountMasterResponds.
+func (mr *MockRespondsServiceMockRecorder) CountMasterResponds(ctx, masterID, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMasterResponds", reflect.TypeOf((*MockRespondsService)(nil).CountMasterResponds), ctx, masterID, filters)
+}
+
+// CountTicketResponds mocks base method.
+func (m *MockRespondsService) CountTicketResponds(ctx context.Context, ticketID uint64, filters *entities.RespondsFilters) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTicketResponds", ctx, ticketID, filters)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTicketResponds indicates an expected call of CountTicketResponds.
+func (mr *MockRespondsServiceMockRecorder) CountTicketResponds(ctx, ticketID, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTicketResponds", reflect.TypeOf((*MockRespondsService)(nil).CountTicketResponds), ctx, ticketID, filters)
+}
+
 // DeleteRespond mocks base method.
-func (m *MockRespondsService) DeleteRespond(ctx context.Context, id uint64) error {
+func (m *MockRespondsService) DeleteRespond(ctx context.Context, id uint64, outboxMessages ...entities.CreateOutboxMessageDTO) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRespond", ctx, id)
+	varargs := []any{ctx, id}
+	for _, a := range outboxMessages {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRespond", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteRespond indicates an expected call of DeleteRespond.
-func (mr *MockRespondsServiceMockRecorder) DeleteRespond(ctx, id any) *gomock.Call {
+func (mr *MockRespondsServiceMockRecorder) DeleteRespond(ctx, id any, outboxMessages ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRespond", reflect.TypeOf((*MockRespondsService)(nil).DeleteRespond), ctx, id)
+	varargs := append([]any{ctx, id}, outboxMessages...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRespond", reflect.TypeOf((*MockRespondsService)(nil).DeleteRespond), varargs...)
 }
 
 // GetMasterResponds mocks base method.
-func (m *MockRespondsService) GetMasterResponds(ctx context.Context, masterID uint64) ([]entities.Respond, error) {
+func (m *MockRespondsService) GetMasterResponds(ctx context.Context, masterID uint64, pagination *entities.Pagination, filters *entities.RespondsFilters) ([]entities.Respond, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMasterResponds", ctx, masterID)
+	ret := m.ctrl.Call(m, "GetMasterResponds", ctx, masterID, pagination, filters)
 	ret0, _ := ret[0].([]entities.Respond)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMasterResponds indicates an expected call of GetMasterResponds.
-func (mr *MockRespondsServiceMockRecorder) GetMasterResponds(ctx, masterID any) *gomock.Call {
+func (mr *MockRespondsServiceMockRecorder) GetMasterResponds(ctx, masterID, pagination, filters any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterResponds", reflect.TypeOf((*MockRespondsService)(nil).GetMasterResponds), ctx, masterID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterResponds", reflect.TypeOf((*MockRespondsService)(nil).GetMasterResponds), ctx, masterID, pagination, filters)
 }
 
 // GetRespondByID mocks base method.
@@ -85,46 +139,119 @@ func (mr *MockRespondsServiceMockRecorder) GetRespondByID(ctx, id any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRespondByID", reflect.TypeOf((*MockRespondsService)(nil).GetRespondByID), ctx, id)
 }
 
+// GetRespondIDByIdempotencyKey mocks base method.
+func (m *MockRespondsService) GetRespondIDByIdempotencyKey(ctx context.Context, idempotencyKey entities.IdempotencyKeyDTO) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRespondIDByIdempotencyKey", ctx, idempotencyKey)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRespondIDByIdempotencyKey indicates an expected call of GetRespondIDByIdempotencyKey.
+func (mr *MockRespondsServiceMockRecorder) GetRespondIDByIdempotencyKey(ctx, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRespondIDByIdempotencyKey", reflect.TypeOf((*MockRespondsService)(nil).GetRespondIDByIdempotencyKey), ctx, idempotencyKey)
+}
+
+// GetRespondsByIDs mocks base method.
+func (m *MockRespondsService) GetRespondsByIDs(ctx context.Context, ids []uint64) ([]entities.Respond, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRespondsByIDs", ctx, ids)
+	ret0, _ := ret[0].([]entities.Respond)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRespondsByIDs indicates an expected call of GetRespondsByIDs.
+func (mr *MockRespondsServiceMockRecorder) GetRespondsByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRespondsByIDs", reflect.TypeOf((*MockRespondsService)(nil).GetRespondsByIDs), ctx, ids)
+}
+
 // GetTicketResponds mocks base method.
-func (m *MockRespondsService) GetTicketResponds(ctx context.Context, ticketID uint64) ([]entities.Respond, error) {
+func (m *MockRespondsService) GetTicketResponds(ctx context.Context, ticketID uint64, pagination *entities.Pagination, filters *entities.RespondsFilters) ([]entities.Respond, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketResponds", ctx, ticketID)
+	ret := m.ctrl.Call(m, "GetTicketResponds", ctx, ticketID, pagination, filters)
 	ret0, _ := ret[0].([]entities.Respond)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTicketResponds indicates an expected call of GetTicketResponds.
-func (mr *MockRespondsServiceMockRecorder) GetTicketResponds(ctx, ticketID any) *gomock.Call {
+func (mr *MockRespondsServiceMockRecorder) GetTicketResponds(ctx, ticketID, pagination, filters any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketResponds", reflect.TypeOf((*MockRespondsService)(nil).GetTicketResponds), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketResponds", reflect.TypeOf((*MockRespondsService)(nil).GetTicketResponds), ctx, ticketID, pagination, filters)
 }
 
 // RespondToTicket mocks base method.
-func (m *MockRespondsService) RespondToTicket(ctx context.Context, respondData entities.RespondToTicketDTO) (uint64, error) {
+func (m *MockRespondsService) RespondToTicket(ctx context.Context, respondData entities.RespondToTicketDTO, outboxMessageBuilders ...entities.OutboxMessageBuilder) (uint64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RespondToTicket", ctx, respondData)
+	varargs := []any{ctx, respondData}
+	for _, a := range outboxMessageBuilders {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RespondToTicket", varargs...)
 	ret0, _ := ret[0].(uint64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RespondToTicket indicates an expected call of RespondToTicket.
-func (mr *MockRespondsServiceMockRecorder) RespondToTicket(ctx, respondData any) *gomock.Call {
+func (mr *MockRespondsServiceMockRecorder) RespondToTicket(ctx, respondData any, outboxMessageBuilders ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondToTicket", reflect.TypeOf((*MockRespondsService)(nil).RespondToTicket), ctx, respondData)
+	varargs := append([]any{ctx, respondData}, outboxMessageBuilders...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondToTicket", reflect.TypeOf((*MockRespondsService)(nil).RespondToTicket), varargs...)
 }
 
 // UpdateRespond mocks base method.
-func (m *MockRespondsService) UpdateRespond(ctx context.Context, respondData entities.UpdateRespondDTO) error {
+func (m *MockRespondsService) UpdateRespond(ctx context.Context, respondData entities.UpdateRespondDTO, outboxMessages ...entities.CreateOutboxMessageDTO) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateRespond", ctx, respondData)
+	varargs := []any{ctx, respondData}
+	for _, a := range outboxMessages {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRespond", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateRespond indicates an expected call of UpdateRespond.
-func (mr *MockRespondsServiceMockRecorder) UpdateRespond(ctx, respondData any) *gomock.Call {
+func (mr *MockRespondsServiceMockRecorder) UpdateRespond(ctx, respondData any, outboxMessages ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, respondData}, outboxMessages...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRespond", reflect.TypeOf((*MockRespondsService)(nil).UpdateRespond), varargs...)
+}
+
+// UpdateRespondStatus mocks base method.
+func (m *MockRespondsService) UpdateRespondStatus(ctx context.Context, id uint64, status entities.RespondStatus, outboxMessages ...entities.CreateOutboxMessageDTO) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, status}
+	for _, a := range outboxMessages {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRespondStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRespondStatus indicates an expected call of UpdateRespondStatus.
+func (mr *MockRespondsServiceMockRecorder) UpdateRespondStatus(ctx, id, status any, outboxMessages ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, status}, outboxMessages...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRespondStatus", reflect.TypeOf((*MockRespondsService)(nil).UpdateRespondStatus), varargs...)
+}
+
+// WithdrawMasterResponds mocks base method.
+func (m *MockRespondsService) WithdrawMasterResponds(ctx context.Context, masterID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawMasterResponds", ctx, masterID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithdrawMasterResponds indicates an expected call of WithdrawMasterResponds.
+func (mr *MockRespondsServiceMockRecorder) WithdrawMasterResponds(ctx, masterID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRespond", reflect.TypeOf((*MockRespondsService)(nil).UpdateRespond), ctx, respondData)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawMasterResponds", reflect.TypeOf((*MockRespondsService)(nil).WithdrawMasterResponds), ctx, masterID)
 }
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=services.go -destination=../../mocks/services/toys_service.go -package=mockservices -exclude_interfaces=RespondsService,TicketsService
+//	mockgen -source=services.go -destination=../../mocks/services/toys_service.go -package=mockservices -exclude_interfaces=RespondsService,TicketsService,OutboxService,IdempotencyKeysService
 //
 
 // Package mockservices is a generated GoMock package.
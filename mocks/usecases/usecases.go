@@ -41,6 +41,49 @@ func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
 	return m.recorder
 }
 
+// AcceptRespond mocks base method.
+func (m *MockUseCases) AcceptRespond(ctx context.Context, id, userID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptRespond", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptRespond indicates an expected call of AcceptRespond.
+func (mr *MockUseCasesMockRecorder) AcceptRespond(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptRespond", reflect.TypeOf((*MockUseCases)(nil).AcceptRespond), ctx, id, userID)
+}
+
+// ChangeTicketStatus mocks base method.
+func (m *MockUseCases) ChangeTicketStatus(ctx context.Context, id, userID uint64, status entities.TicketStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeTicketStatus", ctx, id, userID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeTicketStatus indicates an expected call of ChangeTicketStatus.
+func (mr *MockUseCasesMockRecorder) ChangeTicketStatus(ctx, id, userID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeTicketStatus", reflect.TypeOf((*MockUseCases)(nil).ChangeTicketStatus), ctx, id, userID, status)
+}
+
+// CountTicketResponds mocks base method.
+func (m *MockUseCases) CountTicketResponds(ctx context.Context, ticketID uint64, filters *entities.RespondsFilters) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTicketResponds", ctx, ticketID, filters)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTicketResponds indicates an expected call of CountTicketResponds.
+func (mr *MockUseCasesMockRecorder) CountTicketResponds(ctx, ticketID, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTicketResponds", reflect.TypeOf((*MockUseCases)(nil).CountTicketResponds), ctx, ticketID, filters)
+}
+
 // CountTickets mocks base method.
 func (m *MockUseCases) CountTickets(ctx context.Context, filters *entities.TicketsFilters) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +99,21 @@ func (mr *MockUseCasesMockRecorder) CountTickets(ctx, filters any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTickets", reflect.TypeOf((*MockUseCases)(nil).CountTickets), ctx, filters)
 }
 
+// CountUserResponds mocks base method.
+func (m *MockUseCases) CountUserResponds(ctx context.Context, userID uint64, filters *entities.RespondsFilters) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserResponds", ctx, userID, filters)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserResponds indicates an expected call of CountUserResponds.
+func (mr *MockUseCasesMockRecorder) CountUserResponds(ctx, userID, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserResponds", reflect.TypeOf((*MockUseCases)(nil).CountUserResponds), ctx, userID, filters)
+}
+
 // CountUserTickets mocks base method.
 func (m *MockUseCases) CountUserTickets(ctx context.Context, userID uint64, filters *entities.TicketsFilters) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -87,31 +145,59 @@ func (mr *MockUseCasesMockRecorder) CreateTicket(ctx, ticketData any) *gomock.Ca
 }
 
 // DeleteRespond mocks base method.
-func (m *MockUseCases) DeleteRespond(ctx context.Context, id uint64) error {
+func (m *MockUseCases) DeleteRespond(ctx context.Context, id, userID uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRespond", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteRespond", ctx, id, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteRespond indicates an expected call of DeleteRespond.
-func (mr *MockUseCasesMockRecorder) DeleteRespond(ctx, id any) *gomock.Call {
+func (mr *MockUseCasesMockRecorder) DeleteRespond(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRespond", reflect.TypeOf((*MockUseCases)(nil).DeleteRespond), ctx, id, userID)
+}
+
+// DeleteTagFromTickets mocks base method.
+func (m *MockUseCases) DeleteTagFromTickets(ctx context.Context, tagID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagFromTickets", ctx, tagID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTagFromTickets indicates an expected call of DeleteTagFromTickets.
+func (mr *MockUseCasesMockRecorder) DeleteTagFromTickets(ctx, tagID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRespond", reflect.TypeOf((*MockUseCases)(nil).DeleteRespond), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagFromTickets", reflect.TypeOf((*MockUseCases)(nil).DeleteTagFromTickets), ctx, tagID)
 }
 
 // DeleteTicket mocks base method.
-func (m *MockUseCases) DeleteTicket(ctx context.Context, id uint64) error {
+func (m *MockUseCases) DeleteTicket(ctx context.Context, id, userID uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTicket", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteTicket", ctx, id, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteTicket indicates an expected call of DeleteTicket.
-func (mr *MockUseCasesMockRecorder) DeleteTicket(ctx, id any) *gomock.Call {
+func (mr *MockUseCasesMockRecorder) DeleteTicket(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicket", reflect.TypeOf((*MockUseCases)(nil).DeleteTicket), ctx, id, userID)
+}
+
+// DeleteUserData mocks base method.
+func (m *MockUseCases) DeleteUserData(ctx context.Context, userID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserData", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserData indicates an expected call of DeleteUserData.
+func (mr *MockUseCasesMockRecorder) DeleteUserData(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicket", reflect.TypeOf((*MockUseCases)(nil).DeleteTicket), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserData", reflect.TypeOf((*MockUseCases)(nil).DeleteUserData), ctx, userID)
 }
 
 // GetRespondByID mocks base method.
@@ -129,6 +215,22 @@ func (mr *MockUseCasesMockRecorder) GetRespondByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRespondByID", reflect.TypeOf((*MockUseCases)(nil).GetRespondByID), ctx, id)
 }
 
+// GetRespondsByIDs mocks base method.
+func (m *MockUseCases) GetRespondsByIDs(ctx context.Context, ids []uint64) ([]entities.Respond, []uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRespondsByIDs", ctx, ids)
+	ret0, _ := ret[0].([]entities.Respond)
+	ret1, _ := ret[1].([]uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRespondsByIDs indicates an expected call of GetRespondsByIDs.
+func (mr *MockUseCasesMockRecorder) GetRespondsByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRespondsByIDs", reflect.TypeOf((*MockUseCases)(nil).GetRespondsByIDs), ctx, ids)
+}
+
 // GetTicketByID mocks base method.
 func (m *MockUseCases) GetTicketByID(ctx context.Context, id uint64) (*entities.Ticket, error) {
 	m.ctrl.T.Helper()
@@ -145,18 +247,18 @@ func (mr *MockUseCasesMockRecorder) GetTicketByID(ctx, id any) *gomock.Call {
 }
 
 // GetTicketResponds mocks base method.
-func (m *MockUseCases) GetTicketResponds(ctx context.Context, ticketID uint64) ([]entities.Respond, error) {
+func (m *MockUseCases) GetTicketResponds(ctx context.Context, ticketID uint64, pagination *entities.Pagination, filters *entities.RespondsFilters) ([]entities.Respond, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketResponds", ctx, ticketID)
+	ret := m.ctrl.Call(m, "GetTicketResponds", ctx, ticketID, pagination, filters)
 	ret0, _ := ret[0].([]entities.Respond)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTicketResponds indicates an expected call of GetTicketResponds.
-func (mr *MockUseCasesMockRecorder) GetTicketResponds(ctx, ticketID any) *gomock.Call {
+func (mr *MockUseCasesMockRecorder) GetTicketResponds(ctx, ticketID, pagination, filters any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketResponds", reflect.TypeOf((*MockUseCases)(nil).GetTicketResponds), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketResponds", reflect.TypeOf((*MockUseCases)(nil).GetTicketResponds), ctx, ticketID, pagination, filters)
 }
 
 // GetTickets mocks base method.
@@ -174,19 +276,35 @@ func (mr *MockUseCasesMockRecorder) GetTickets(ctx, pagination, filters any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTickets", reflect.TypeOf((*MockUseCases)(nil).GetTickets), ctx, pagination, filters)
 }
 
+// GetTicketsByIDs mocks base method.
+func (m *MockUseCases) GetTicketsByIDs(ctx context.Context, ids []uint64) ([]entities.Ticket, []uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketsByIDs", ctx, ids)
+	ret0, _ := ret[0].([]entities.Ticket)
+	ret1, _ := ret[1].([]uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketsByIDs indicates an expected call of GetTicketsByIDs.
+func (mr *MockUseCasesMockRecorder) GetTicketsByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsByIDs", reflect.TypeOf((*MockUseCases)(nil).GetTicketsByIDs), ctx, ids)
+}
+
 // GetUserResponds mocks base method.
-func (m *MockUseCases) GetUserResponds(ctx context.Context, userID uint64) ([]entities.Respond, error) {
+func (m *MockUseCases) GetUserResponds(ctx context.Context, userID uint64, pagination *entities.Pagination, filters *entities.RespondsFilters) ([]entities.Respond, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserResponds", ctx, userID)
+	ret := m.ctrl.Call(m, "GetUserResponds", ctx, userID, pagination, filters)
 	ret0, _ := ret[0].([]entities.Respond)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserResponds indicates an expected call of GetUserResponds.
-func (mr *MockUseCasesMockRecorder) GetUserResponds(ctx, userID any) *gomock.Call {
+func (mr *MockUseCasesMockRecorder) GetUserResponds(ctx, userID, pagination, filters any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserResponds", reflect.TypeOf((*MockUseCases)(nil).GetUserResponds), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserResponds", reflect.TypeOf((*MockUseCases)(nil).GetUserResponds), ctx, userID, pagination, filters)
 }
 
 // GetUserTickets mocks base method.
@@ -204,6 +322,20 @@ func (mr *MockUseCasesMockRecorder) GetUserTickets(ctx, userID, pagination, filt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTickets", reflect.TypeOf((*MockUseCases)(nil).GetUserTickets), ctx, userID, pagination, filters)
 }
 
+// RejectRespond mocks base method.
+func (m *MockUseCases) RejectRespond(ctx context.Context, id, userID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectRespond", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectRespond indicates an expected call of RejectRespond.
+func (mr *MockUseCasesMockRecorder) RejectRespond(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectRespond", reflect.TypeOf((*MockUseCases)(nil).RejectRespond), ctx, id, userID)
+}
+
 // RespondToTicket mocks base method.
 func (m *MockUseCases) RespondToTicket(ctx context.Context, rawRespondData entities.RawRespondToTicketDTO) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -220,17 +352,17 @@ func (mr *MockUseCasesMockRecorder) RespondToTicket(ctx, rawRespondData any) *go
 }
 
 // UpdateRespond mocks base method.
-func (m *MockUseCases) UpdateRespond(ctx context.Context, respondData entities.UpdateRespondDTO) error {
+func (m *MockUseCases) UpdateRespond(ctx context.Context, rawRespondData entities.RawUpdateRespondDTO) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateRespond", ctx, respondData)
+	ret := m.ctrl.Call(m, "UpdateRespond", ctx, rawRespondData)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateRespond indicates an expected call of UpdateRespond.
-func (mr *MockUseCasesMockRecorder) UpdateRespond(ctx, respondData any) *gomock.Call {
+func (mr *MockUseCasesMockRecorder) UpdateRespond(ctx, rawRespondData any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRespond", reflect.TypeOf((*MockUseCases)(nil).UpdateRespond), ctx, respondData)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRespond", reflect.TypeOf((*MockUseCases)(nil).UpdateRespond), ctx, rawRespondData)
 }
 
 // UpdateTicket mocks base method.
@@ -246,3 +378,45 @@ func (mr *MockUseCasesMockRecorder) UpdateTicket(ctx, rawTicketData any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicket", reflect.TypeOf((*MockUseCases)(nil).UpdateTicket), ctx, rawTicketData)
 }
+
+// UpdateTicketsCategory mocks base method.
+func (m *MockUseCases) UpdateTicketsCategory(ctx context.Context, oldCategoryID, newCategoryID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTicketsCategory", ctx, oldCategoryID, newCategoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTicketsCategory indicates an expected call of UpdateTicketsCategory.
+func (mr *MockUseCasesMockRecorder) UpdateTicketsCategory(ctx, oldCategoryID, newCategoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicketsCategory", reflect.TypeOf((*MockUseCases)(nil).UpdateTicketsCategory), ctx, oldCategoryID, newCategoryID)
+}
+
+// WatchTickets mocks base method.
+func (m *MockUseCases) WatchTickets(ctx context.Context, filters *entities.TicketsFilters, snapshot bool, send func(entities.TicketEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchTickets", ctx, filters, snapshot, send)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchTickets indicates an expected call of WatchTickets.
+func (mr *MockUseCasesMockRecorder) WatchTickets(ctx, filters, snapshot, send any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchTickets", reflect.TypeOf((*MockUseCases)(nil).WatchTickets), ctx, filters, snapshot, send)
+}
+
+// WithdrawMasterResponds mocks base method.
+func (m *MockUseCases) WithdrawMasterResponds(ctx context.Context, masterID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawMasterResponds", ctx, masterID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithdrawMasterResponds indicates an expected call of WithdrawMasterResponds.
+func (mr *MockUseCasesMockRecorder) WithdrawMasterResponds(ctx, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawMasterResponds", reflect.TypeOf((*MockUseCases)(nil).WithdrawMasterResponds), ctx, masterID)
+}